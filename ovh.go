@@ -7,26 +7,39 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/config"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/compute"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/httpclient"
 	openstacktransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
 	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
 	"github.com/platform-engineering-labs/formae/pkg/plugin"
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 
 	// Import OVH REST API resources to trigger init() registration
-	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/compute"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/ai"
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/database"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/dedicated"
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/dns"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/iplb"
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/kube"
 
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/network"
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/registry"
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud/storage"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources/blockstorage"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources/compute"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources/image"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources/keymanager"
 	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources/network"
+	_ "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources/objectstorage"
 )
 
 // Plugin implements the Formae ResourcePlugin interface.
@@ -64,7 +77,7 @@ func (p *Plugin) LabelConfig() plugin.LabelConfig {
 	}
 }
 
-// augmentTargetConfig injects CloudProjectID from environment into target config.
+// augmentTargetConfig injects CloudProjectID and DefaultRegion from environment into target config.
 // This ensures serviceName (CloudProjectID) flows through to API calls via
 // extractProjectFromTargetConfig in base_resource.go.
 func (p *Plugin) augmentTargetConfig(targetConfig []byte, cfg *config.Config) ([]byte, error) {
@@ -82,6 +95,11 @@ func (p *Plugin) augmentTargetConfig(targetConfig []byte, cfg *config.Config) ([
 		configMap["serviceName"] = cfg.CloudProjectID
 	}
 
+	// Inject the default region, used only when the target sets no region
+	if cfg.DefaultRegion != "" {
+		configMap["DefaultRegion"] = cfg.DefaultRegion
+	}
+
 	return json.Marshal(configMap)
 }
 
@@ -95,29 +113,32 @@ func (p *Plugin) getProvisioner(ctx context.Context, resourceType string, target
 
 	switch transportType {
 	case registry.TransportOVH:
-		// Create OVH REST API client (go-ovh)
-		cfg, err := config.FromTargetConfig(targetConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to extract config: %w", err)
-		}
-		ovhClient, err := ovhtransport.NewClient(&ovhtransport.OVHConfig{
-			Endpoint:          cfg.OVHEndpoint,
-			ApplicationKey:    cfg.ApplicationKey,
-			ApplicationSecret: cfg.ApplicationSecret,
-			ConsumerKey:       cfg.ConsumerKey,
-		})
+		ovhClient, err := p.newOVHClient(targetConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create OVH REST API client: %w", err)
+			return nil, err
 		}
 		factory, _ := registry.GetOVHFactory(resourceType)
-		return factory(ovhClient), nil
+		provisioner := factory(ovhClient)
+		if resourceType == compute.InstanceResourceType {
+			cfg, err := config.FromTargetConfig(targetConfig)
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract config: %w", err)
+			}
+			policy := compute.MetadataPolicy{Defaults: cfg.InstanceMetadata, Required: cfg.RequiredInstanceMetadata}
+			provisioner = compute.WithServerProperties(provisioner, func() (*openstacktransport.Client, error) {
+				if openstacktransport.ConfigFromEnv().AuthURL == "" {
+					return nil, nil
+				}
+				client, _, err := p.newOpenStackClient(ctx, targetConfig)
+				return client, err
+			}, policy)
+		}
+		return provisioner, nil
 
 	case registry.TransportOpenStack:
-		// Create OpenStack client (gophercloud)
-		openstackCfg := openstacktransport.ConfigFromEnv()
-		openstackClient, err := openstacktransport.NewClient(ctx, openstackCfg)
+		openstackClient, openstackCfg, err := p.newOpenStackClient(ctx, targetConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create OpenStack client: %w", err)
+			return nil, err
 		}
 		factory, _ := registry.GetOpenStackFactory(resourceType)
 		return factory(openstackClient, openstackCfg), nil
@@ -127,6 +148,87 @@ func (p *Plugin) getProvisioner(ctx context.Context, resourceType string, target
 	}
 }
 
+// openStackConfig builds the OpenStack config from the environment, with the
+// User-Agent taken from target config
+func (p *Plugin) openStackConfig(targetConfig []byte) (*openstacktransport.Config, error) {
+	openstackCfg := openstacktransport.ConfigFromEnv()
+	if err := openstackCfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid OpenStack config: %w", err)
+	}
+	cfg, err := config.FromTargetConfig(targetConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract config: %w", err)
+	}
+	openstackCfg.UserAgent = cfg.UserAgent()
+	openstackCfg.Pool = poolConfig(cfg)
+	return openstackCfg, nil
+}
+
+// newOpenStackClient returns the shared OpenStack client (gophercloud) for
+// target config, along with the config it was built from
+func (p *Plugin) newOpenStackClient(ctx context.Context, targetConfig []byte) (*openstacktransport.Client, *openstacktransport.Config, error) {
+	openstackCfg, err := p.openStackConfig(targetConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	openstackClient, err := openstacktransport.SharedClient(ctx, openstackCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OpenStack client: %w", err)
+	}
+	return openstackClient, openstackCfg, nil
+}
+
+// newOVHClient creates an OVH REST API client (go-ovh) from target config
+func (p *Plugin) newOVHClient(targetConfig []byte) (*ovhtransport.Client, error) {
+	cfg, err := config.FromTargetConfig(targetConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract config: %w", err)
+	}
+	logLevel, err := ovhtransport.ParseLogLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract config: %w", err)
+	}
+	ovhClient, err := ovhtransport.NewClient(&ovhtransport.OVHConfig{
+		Endpoint:                cfg.OVHEndpoint,
+		ApplicationKey:          cfg.ApplicationKey,
+		ApplicationSecret:       cfg.ApplicationSecret,
+		ConsumerKey:             cfg.ConsumerKey,
+		RequestsPerSecond:       cfg.RequestsPerSecond,
+		LogLevel:                logLevel,
+		RequestTimeout:          time.Duration(cfg.RequestTimeout * float64(time.Second)),
+		UserAgent:               cfg.UserAgent(),
+		AutoActivateRegions:     cfg.AutoActivateRegions,
+		Pool:                    poolConfig(cfg),
+		ReadCache:               cfg.ReadCache,
+		ClockSkew:               clockSkew(cfg),
+		CircuitBreakerThreshold: cfg.CircuitBreakerThreshold,
+		CircuitBreakerCooldown:  time.Duration(cfg.CircuitBreakerCooldown * float64(time.Second)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OVH REST API client: %w", err)
+	}
+	return ovhClient, nil
+}
+
+// clockSkew returns the configured API clock skew, or nil to measure it
+func clockSkew(cfg *config.Config) *time.Duration {
+	if cfg.ClockSkew == nil {
+		return nil
+	}
+	skew := time.Duration(*cfg.ClockSkew * float64(time.Second))
+	return &skew
+}
+
+// poolConfig returns the HTTP connection pool settings of cfg. Both clients
+// use them, so OVH and OpenStack calls share one pool.
+func poolConfig(cfg *config.Config) httpclient.PoolConfig {
+	return httpclient.PoolConfig{
+		MaxIdleConns:        cfg.MaxIdleConns,
+		MaxIdleConnsPerHost: cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:     time.Duration(cfg.IdleConnTimeout * float64(time.Second)),
+	}
+}
+
 // prepareTargetConfig extracts config from target config bytes and returns an
 // augmented target config with CloudProjectID injected as serviceName.
 func (p *Plugin) prepareTargetConfig(targetConfig []byte) ([]byte, error) {
@@ -137,6 +239,36 @@ func (p *Plugin) prepareTargetConfig(targetConfig []byte) ([]byte, error) {
 	return p.augmentTargetConfig(targetConfig, cfg)
 }
 
+// startTrace tags the API calls of a create, update or delete with a new
+// trace ID, so they can be followed in the request log.
+func startTrace(ctx context.Context) (context.Context, string) {
+	traceID := ovhtransport.NewTraceID()
+	return ovhtransport.WithTraceID(ctx, traceID), traceID
+}
+
+// setTraceRequestID returns the trace ID as the operation's RequestID unless
+// the provisioner set its own. formae passes the RequestID to every Status
+// poll, which then logs under the same trace ID.
+func setTraceRequestID(result *resource.ProgressResult, traceID string) {
+	if result != nil && result.RequestID == "" {
+		result.RequestID = traceID
+	}
+}
+
+// addWarnings appends the operation's non-fatal warnings to its status
+// message, so formae shows them with the result
+func addWarnings(result *resource.ProgressResult, messages []string) {
+	if result != nil && len(messages) > 0 {
+		result.StatusMessage = warnings.Join(result.StatusMessage, messages)
+	}
+}
+
+// dryRun reports whether the target only validates changes
+func (p *Plugin) dryRun(targetConfig []byte) bool {
+	cfg, err := config.FromTargetConfig(targetConfig)
+	return err == nil && cfg.DryRun
+}
+
 func (p *Plugin) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
 	augmentedConfig, err := p.prepareTargetConfig(request.TargetConfig)
 	if err != nil {
@@ -148,7 +280,18 @@ func (p *Plugin) Create(ctx context.Context, request *resource.CreateRequest) (*
 	if err != nil {
 		return nil, err
 	}
-	return provisioner.Create(ctx, request)
+	if p.dryRun(request.TargetConfig) {
+		return prov.DryRunCreate(ctx, provisioner, request), nil
+	}
+
+	ctx, traceID := startTrace(ctx)
+	ctx, collected := warnings.Collect(ctx)
+	result, err := provisioner.Create(ctx, request)
+	if result != nil {
+		setTraceRequestID(result.ProgressResult, traceID)
+		addWarnings(result.ProgressResult, collected())
+	}
+	return result, err
 }
 
 func (p *Plugin) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
@@ -162,7 +305,11 @@ func (p *Plugin) Read(ctx context.Context, request *resource.ReadRequest) (*reso
 	if err != nil {
 		return nil, err
 	}
-	return provisioner.Read(ctx, request)
+	result, err := provisioner.Read(ctx, request)
+	if result != nil && request.RedactSensitive {
+		result.Properties = prov.RemoveSensitive(result.Properties, registry.SensitiveFields(request.ResourceType))
+	}
+	return result, err
 }
 
 func (p *Plugin) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
@@ -176,7 +323,18 @@ func (p *Plugin) Update(ctx context.Context, request *resource.UpdateRequest) (*
 	if err != nil {
 		return nil, err
 	}
-	return provisioner.Update(ctx, request)
+	if p.dryRun(request.TargetConfig) {
+		return prov.DryRunUpdate(ctx, provisioner, request), nil
+	}
+
+	ctx, traceID := startTrace(ctx)
+	ctx, collected := warnings.Collect(ctx)
+	result, err := provisioner.Update(ctx, request)
+	if result != nil {
+		setTraceRequestID(result.ProgressResult, traceID)
+		addWarnings(result.ProgressResult, collected())
+	}
+	return result, err
 }
 
 func (p *Plugin) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
@@ -186,11 +344,23 @@ func (p *Plugin) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 	}
 	request.TargetConfig = augmentedConfig
 
+	if p.dryRun(request.TargetConfig) {
+		return prov.DryRunDelete(request), nil
+	}
+
 	provisioner, err := p.getProvisioner(ctx, request.ResourceType, request.TargetConfig)
 	if err != nil {
 		return nil, err
 	}
-	return provisioner.Delete(ctx, request)
+
+	ctx, traceID := startTrace(ctx)
+	ctx, collected := warnings.Collect(ctx)
+	result, err := provisioner.Delete(ctx, request)
+	if result != nil {
+		setTraceRequestID(result.ProgressResult, traceID)
+		addWarnings(result.ProgressResult, collected())
+	}
+	return result, err
 }
 
 func (p *Plugin) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
@@ -204,6 +374,9 @@ func (p *Plugin) Status(ctx context.Context, request *resource.StatusRequest) (*
 	if err != nil {
 		return nil, err
 	}
+
+	// Polls are logged under the trace ID the operation started with
+	ctx = ovhtransport.WithTraceID(ctx, request.RequestID)
 	return provisioner.Status(ctx, request)
 }
 
@@ -218,5 +391,143 @@ func (p *Plugin) List(ctx context.Context, request *resource.ListRequest) (*reso
 	if err != nil {
 		return nil, err
 	}
-	return provisioner.List(ctx, request)
+	// Resources imported by name are usually not tagged yet, so the result
+	// skips the managed-by filter
+	if name := request.AdditionalProperties[prov.ImportNameProperty]; name != "" {
+		resolver, ok := provisioner.(prov.NameResolver)
+		if !ok {
+			return nil, fmt.Errorf("%s cannot be imported by name, import it by native ID", request.ResourceType)
+		}
+		return prov.ResolveByName(ctx, resolver, request, name)
+	}
+	result, err := provisioner.List(ctx, request)
+	if err != nil || result == nil {
+		return result, err
+	}
+	return p.filterManaged(ctx, request, result)
+}
+
+// managedIDLookups return the IDs of OpenStack resources carrying the
+// managed-by tag, for OVH API resources that have no tags of their own
+var managedIDLookups = map[string]func(*openstacktransport.Client, context.Context, string) (map[string]bool, error){
+	compute.InstanceResourceType: (*openstacktransport.Client).ManagedServerIDs,
+	compute.VolumeResourceType:   (*openstacktransport.Client).ManagedVolumeIDs,
+}
+
+// filterManaged drops instances and volumes that do not carry
+// OVH_MANAGED_BY_TAG, matching the tag filter OpenStack resources apply in
+// List. The tag is looked up on the Nova server or Cinder volume behind each
+// resource, in the OpenStack client's region only.
+func (p *Plugin) filterManaged(ctx context.Context, request *resource.ListRequest, result *resource.ListResult) (*resource.ListResult, error) {
+	lookup, ok := managedIDLookups[request.ResourceType]
+	if !ok || openstacktransport.ConfigFromEnv().ManagedByTag == "" {
+		return result, nil
+	}
+	openstackClient, openstackCfg, err := p.newOpenStackClient(ctx, request.TargetConfig)
+	if err != nil {
+		return nil, fmt.Errorf("OVH_MANAGED_BY_TAG needs OpenStack credentials: %w", err)
+	}
+
+	managed, err := lookup(openstackClient, ctx, openstackCfg.ManagedByTag)
+	if err != nil {
+		return nil, err
+	}
+
+	var nativeIDs []string
+	for _, nativeID := range result.NativeIDs {
+		if managed[nativeID[strings.LastIndex(nativeID, "/")+1:]] {
+			nativeIDs = append(nativeIDs, nativeID)
+		}
+	}
+	return &resource.ListResult{NativeIDs: nativeIDs, NextPageToken: result.NextPageToken}, nil
+}
+
+// DiscoverAll lists every resource type that supports List, running the
+// per-type List calls concurrently with at most registry.DefaultDiscoveryWorkers
+// in flight. Each type goes through List, so it gets the same shared clients,
+// User-Agent and managed-by filter as discovery driven one type at a time;
+// all OVH types share one rate limiter. The result maps each resource type to
+// its native IDs; types that failed are reported together in the returned error.
+func (p *Plugin) DiscoverAll(ctx context.Context, targetConfig []byte) (map[string][]string, error) {
+	list := func(ctx context.Context, resourceType string) ([]string, error) {
+		var nativeIDs []string
+		var pageToken *string
+		for {
+			result, err := p.List(ctx, &resource.ListRequest{
+				ResourceType: resourceType,
+				TargetConfig: targetConfig,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				return nativeIDs, nil
+			}
+			nativeIDs = append(nativeIDs, result.NativeIDs...)
+			if result.NextPageToken == nil || *result.NextPageToken == "" {
+				return nativeIDs, nil
+			}
+			pageToken = result.NextPageToken
+		}
+	}
+
+	discovered := make(map[string][]string)
+	var failures []error
+	for _, result := range registry.ListAll(ctx, registry.ListableResourceTypes(), registry.DefaultDiscoveryWorkers, list) {
+		if result.Err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", result.ResourceType, result.Err))
+			continue
+		}
+		discovered[result.ResourceType] = result.NativeIDs
+	}
+
+	return discovered, errors.Join(failures...)
+}
+
+// HealthCheckResult reports whether one API accepted the configured credentials
+type HealthCheckResult struct {
+	API     string
+	Healthy bool
+	Latency time.Duration
+	Error   string
+}
+
+// HealthCheck authenticates against each configured API with one cheap call,
+// so formae can surface bad credentials or endpoints when a target is
+// validated instead of partway through an apply. The OVH API is always
+// checked; OpenStack only when OS_AUTH_URL is set. The error is non-nil only
+// when the target config itself is invalid.
+func (p *Plugin) HealthCheck(ctx context.Context, targetConfig []byte) ([]HealthCheckResult, error) {
+	augmentedConfig, err := p.prepareTargetConfig(targetConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ovhResult := HealthCheckResult{API: "ovh"}
+	ovhClient, err := p.newOVHClient(augmentedConfig)
+	if err == nil {
+		ovhResult.Latency, err = ovhClient.Ping(ctx)
+	}
+	if err != nil {
+		ovhResult.Error = err.Error()
+	}
+	ovhResult.Healthy = err == nil
+	results := []HealthCheckResult{ovhResult}
+
+	if openstacktransport.ConfigFromEnv().AuthURL == "" {
+		return results, nil
+	}
+
+	openstackResult := HealthCheckResult{API: "openstack"}
+	openstackClient, _, err := p.newOpenStackClient(ctx, augmentedConfig)
+	if err == nil {
+		openstackResult.Latency, err = openstackClient.Ping(ctx)
+	}
+	if err != nil {
+		openstackResult.Error = err.Error()
+	}
+	openstackResult.Healthy = err == nil
+
+	return append(results, openstackResult), nil
 }
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/platform-engineering-labs/formae/pkg/model"
 )
@@ -18,13 +20,49 @@ import (
 // read from environment variables to avoid storing secrets.
 type Config struct {
 	// Stored in target config (non-sensitive)
-	OVHEndpoint string `json:"OVHEndpoint"` // ovh-eu, ovh-ca, ovh-us, etc.
+	OVHEndpoint       string  `json:"OVHEndpoint"`       // ovh-eu, ovh-ca, ovh-us, etc.
+	RequestsPerSecond float64 `json:"RequestsPerSecond"` // Client-side API rate limit
+	LogLevel          string  `json:"LogLevel"`          // off, debug or trace
+	RequestTimeout    float64 `json:"RequestTimeout"`    // Per-call HTTP timeout in seconds
+	UserAgentSuffix   string  `json:"UserAgentSuffix"`   // Appended to the User-Agent header
+
+	// HTTP connection pool shared by the OVH and OpenStack clients
+	MaxIdleConns        int     `json:"MaxIdleConns"`        // Idle connections kept across all hosts
+	MaxIdleConnsPerHost int     `json:"MaxIdleConnsPerHost"` // Idle connections kept per API endpoint
+	IdleConnTimeout     float64 `json:"IdleConnTimeout"`     // Seconds before an idle connection is closed
+
+	// AutoActivateRegions activates a project region on first use
+	AutoActivateRegions bool `json:"AutoActivateRegions"`
+
+	// ReadCache answers repeated catalog lookups within an operation from memory
+	ReadCache bool `json:"ReadCache"`
+
+	// DryRun validates creates and updates without changing anything
+	DryRun bool `json:"DryRun"`
+
+	// ClockSkew is how many seconds the OVH API clock is ahead of the local
+	// one. Nil measures it through /auth/time instead.
+	ClockSkew *float64 `json:"ClockSkew"`
+
+	// Consecutive server errors that pause OVH API calls for
+	// CircuitBreakerCooldown seconds. Zero disables the circuit breaker.
+	CircuitBreakerThreshold int     `json:"CircuitBreakerThreshold"`
+	CircuitBreakerCooldown  float64 `json:"CircuitBreakerCooldown"`
+
+	// InstanceMetadata is merged under the metadata every instance declares,
+	// e.g. cost-allocation keys for billing exports
+	InstanceMetadata map[string]string `json:"InstanceMetadata"`
+
+	// RequiredInstanceMetadata lists the metadata keys every instance must
+	// end up with, after InstanceMetadata is merged in
+	RequiredInstanceMetadata []string `json:"RequiredInstanceMetadata"`
 
 	// Read from environment variables only (never stored)
 	ApplicationKey    string `json:"-"` // From OVH_APPLICATION_KEY
 	ApplicationSecret string `json:"-"` // From OVH_APPLICATION_SECRET
 	ConsumerKey       string `json:"-"` // From OVH_CONSUMER_KEY
 	CloudProjectID    string `json:"-"` // From OVH_CLOUD_PROJECT_ID
+	DefaultRegion     string `json:"-"` // From OVH_DEFAULT_REGION
 }
 
 // FromTarget extracts OVH configuration from a Target
@@ -36,11 +74,22 @@ func FromTarget(target *model.Target) (*Config, error) {
 }
 
 // FromTargetConfig extracts OVH configuration from a TargetConfig JSON.
-// Only OVHEndpoint is read from the target config.
+// Only OVHEndpoint, RequestsPerSecond, LogLevel, RequestTimeout, UserAgentSuffix,
+// the connection pool settings, AutoActivateRegions, ReadCache, DryRun, ClockSkew, the
+// circuit breaker settings and the instance metadata policy are read from the target config.
 // Credentials are always read from environment variables.
 func FromTargetConfig(targetConfig json.RawMessage) (*Config, error) {
 	var cfg Config
 
+	// Reject misspelled or invalid fields before anything is resolved from them
+	target, err := ParseTargetConfig(targetConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid target config: %w", err)
+	}
+
 	// Read non-sensitive config from target
 	if len(targetConfig) > 0 {
 		if err := json.Unmarshal(targetConfig, &cfg); err != nil {
@@ -57,12 +106,162 @@ func FromTargetConfig(targetConfig json.RawMessage) (*Config, error) {
 		cfg.OVHEndpoint = "ovh-eu"
 	}
 
+	// RequestsPerSecond can fall back to environment variable
+	if cfg.RequestsPerSecond == 0 {
+		if v := os.Getenv("OVH_REQUESTS_PER_SECOND"); v != "" {
+			rps, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_REQUESTS_PER_SECOND %q: %w", v, err)
+			}
+			cfg.RequestsPerSecond = rps
+		}
+	}
+
+	// LogLevel can fall back to environment variable
+	if cfg.LogLevel == "" {
+		cfg.LogLevel = os.Getenv("OVH_LOG_LEVEL")
+	}
+
+	// RequestTimeout can fall back to environment variable
+	if cfg.RequestTimeout == 0 {
+		if v := os.Getenv("OVH_REQUEST_TIMEOUT"); v != "" {
+			timeout, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_REQUEST_TIMEOUT %q: %w", v, err)
+			}
+			cfg.RequestTimeout = timeout
+		}
+	}
+
+	// UserAgentSuffix can fall back to environment variable
+	if cfg.UserAgentSuffix == "" {
+		cfg.UserAgentSuffix = os.Getenv("OVH_USER_AGENT_SUFFIX")
+	}
+
+	// Connection pool settings can fall back to environment variables
+	if cfg.MaxIdleConns == 0 {
+		if v := os.Getenv("OVH_MAX_IDLE_CONNS"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_MAX_IDLE_CONNS %q: %w", v, err)
+			}
+			cfg.MaxIdleConns = n
+		}
+	}
+	if cfg.MaxIdleConnsPerHost == 0 {
+		if v := os.Getenv("OVH_MAX_IDLE_CONNS_PER_HOST"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_MAX_IDLE_CONNS_PER_HOST %q: %w", v, err)
+			}
+			cfg.MaxIdleConnsPerHost = n
+		}
+	}
+	if cfg.IdleConnTimeout == 0 {
+		if v := os.Getenv("OVH_IDLE_CONN_TIMEOUT"); v != "" {
+			timeout, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_IDLE_CONN_TIMEOUT %q: %w", v, err)
+			}
+			cfg.IdleConnTimeout = timeout
+		}
+	}
+
+	// AutoActivateRegions can be enabled by environment variable
+	if !cfg.AutoActivateRegions {
+		if v := os.Getenv("OVH_AUTO_ACTIVATE_REGIONS"); v != "" {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_AUTO_ACTIVATE_REGIONS %q: %w", v, err)
+			}
+			cfg.AutoActivateRegions = enabled
+		}
+	}
+
+	// ReadCache can be enabled by environment variable
+	if !cfg.ReadCache {
+		if v := os.Getenv("OVH_READ_CACHE"); v != "" {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_READ_CACHE %q: %w", v, err)
+			}
+			cfg.ReadCache = enabled
+		}
+	}
+
+	// DryRun can be enabled by environment variable, e.g. for a CI check
+	if !cfg.DryRun {
+		if v := os.Getenv("OVH_DRY_RUN"); v != "" {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_DRY_RUN %q: %w", v, err)
+			}
+			cfg.DryRun = enabled
+		}
+	}
+
+	// ClockSkew can fall back to environment variable
+	if cfg.ClockSkew == nil {
+		if v := os.Getenv("OVH_CLOCK_SKEW"); v != "" {
+			skew, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_CLOCK_SKEW %q: %w", v, err)
+			}
+			cfg.ClockSkew = &skew
+		}
+	}
+
+	// Circuit breaker settings can fall back to environment variables
+	if cfg.CircuitBreakerThreshold == 0 {
+		if v := os.Getenv("OVH_CIRCUIT_BREAKER_THRESHOLD"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_CIRCUIT_BREAKER_THRESHOLD %q: %w", v, err)
+			}
+			cfg.CircuitBreakerThreshold = n
+		}
+	}
+	if cfg.CircuitBreakerCooldown == 0 {
+		if v := os.Getenv("OVH_CIRCUIT_BREAKER_COOLDOWN"); v != "" {
+			cooldown, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_CIRCUIT_BREAKER_COOLDOWN %q: %w", v, err)
+			}
+			cfg.CircuitBreakerCooldown = cooldown
+		}
+	}
+
+	// The instance metadata policy can fall back to environment variables,
+	// as comma-separated key=value pairs and keys
+	if cfg.InstanceMetadata == nil {
+		if v := os.Getenv("OVH_INSTANCE_METADATA"); v != "" {
+			metadata, err := parseKeyValues(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OVH_INSTANCE_METADATA %q: %w", v, err)
+			}
+			cfg.InstanceMetadata = metadata
+		}
+	}
+	if cfg.RequiredInstanceMetadata == nil {
+		if v := os.Getenv("OVH_REQUIRED_INSTANCE_METADATA"); v != "" {
+			for _, key := range strings.Split(v, ",") {
+				if key = strings.TrimSpace(key); key != "" {
+					cfg.RequiredInstanceMetadata = append(cfg.RequiredInstanceMetadata, key)
+				}
+			}
+		}
+	}
+
 	// Credentials are ALWAYS read from environment variables (never stored)
 	cfg.ApplicationKey = os.Getenv("OVH_APPLICATION_KEY")
 	cfg.ApplicationSecret = os.Getenv("OVH_APPLICATION_SECRET")
 	cfg.ConsumerKey = os.Getenv("OVH_CONSUMER_KEY")
 	cfg.CloudProjectID = os.Getenv("OVH_CLOUD_PROJECT_ID")
 
+	// Regional resources fall back to this when neither they nor the target
+	// set a region
+	cfg.DefaultRegion = os.Getenv("OVH_DEFAULT_REGION")
+
 	return &cfg, nil
 }
 
@@ -87,3 +286,20 @@ func (c *Config) Validate() error {
 func (c *Config) IsConfigured() bool {
 	return c.ApplicationKey != "" && c.ApplicationSecret != "" && c.ConsumerKey != "" && c.CloudProjectID != ""
 }
+
+// parseKeyValues parses comma-separated key=value pairs
+func parseKeyValues(v string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	for _, pair := range strings.Split(v, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", pair)
+		}
+		pairs[key] = strings.TrimSpace(value)
+	}
+	return pairs, nil
+}
@@ -0,0 +1,173 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// TargetConfig is the typed form of the target config exported by the
+// ovh.Config Pkl class. Field names match the exported Pkl properties.
+type TargetConfig struct {
+	Type                     string            `json:"Type,omitempty"`
+	OVHEndpoint              string            `json:"OVHEndpoint,omitempty"`
+	RequestsPerSecond        float64           `json:"RequestsPerSecond,omitempty"`
+	LogLevel                 string            `json:"LogLevel,omitempty"`
+	RequestTimeout           float64           `json:"RequestTimeout,omitempty"`
+	UserAgentSuffix          string            `json:"UserAgentSuffix,omitempty"`
+	MaxIdleConns             int               `json:"MaxIdleConns,omitempty"`
+	MaxIdleConnsPerHost      int               `json:"MaxIdleConnsPerHost,omitempty"`
+	IdleConnTimeout          float64           `json:"IdleConnTimeout,omitempty"`
+	AutoActivateRegions      bool              `json:"AutoActivateRegions,omitempty"`
+	ReadCache                bool              `json:"ReadCache,omitempty"`
+	DryRun                   bool              `json:"DryRun,omitempty"`
+	ClockSkew                *float64          `json:"ClockSkew,omitempty"`
+	CircuitBreakerThreshold  int               `json:"CircuitBreakerThreshold,omitempty"`
+	CircuitBreakerCooldown   float64           `json:"CircuitBreakerCooldown,omitempty"`
+	InstanceMetadata         map[string]string `json:"InstanceMetadata,omitempty"`
+	RequiredInstanceMetadata []string          `json:"RequiredInstanceMetadata,omitempty"`
+	ApplicationKey           string            `json:"ApplicationKey,omitempty"`
+	ApplicationSecret        string            `json:"ApplicationSecret,omitempty"`
+	ConsumerKey              string            `json:"ConsumerKey,omitempty"`
+	Region                   string            `json:"Region,omitempty"`
+	ProjectID                string            `json:"ProjectId,omitempty"`
+}
+
+// targetConfigKeys lists every key read from a target config, including the
+// aliases accepted when resolving project and region for API paths.
+var targetConfigKeys = []string{
+	"Type", "OVHEndpoint", "RequestsPerSecond", "LogLevel", "RequestTimeout", "UserAgentSuffix",
+	"MaxIdleConns", "MaxIdleConnsPerHost", "IdleConnTimeout",
+	"AutoActivateRegions", "ReadCache", "DryRun", "ClockSkew",
+	"CircuitBreakerThreshold", "CircuitBreakerCooldown",
+	"InstanceMetadata", "RequiredInstanceMetadata",
+	"ApplicationKey", "ApplicationSecret", "ConsumerKey",
+	"Region", "region", "RegionName", "regionName", "DefaultRegion",
+	"ProjectId", "projectId", "ServiceName", "serviceName",
+}
+
+// knownEndpoints are the go-ovh endpoint names; a full https URL is also accepted
+var knownEndpoints = []string{
+	"ovh-eu", "ovh-ca", "ovh-us", "kimsufi-eu", "kimsufi-ca", "soyoustart-eu", "soyoustart-ca",
+}
+
+// ParseTargetConfig decodes a target config into a TargetConfig.
+// A key that differs from a known key only by case (e.g. "projectID") is
+// rejected, since it would otherwise be ignored and resolve to an empty value.
+func ParseTargetConfig(raw json.RawMessage) (*TargetConfig, error) {
+	var cfg TargetConfig
+	if len(raw) == 0 {
+		return &cfg, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("target config is not a JSON object: %w", err)
+	}
+	if err := checkKeyCase(fields); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid target config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// checkKeyCase reports the first key that only case-insensitively matches a
+// known key. Unrelated keys are left alone so formae can add its own.
+func checkKeyCase(fields map[string]json.RawMessage) error {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if isTargetConfigKey(key) {
+			continue
+		}
+		for _, known := range targetConfigKeys {
+			if strings.EqualFold(key, known) {
+				return fmt.Errorf("target config field %q is not recognized; did you mean %q?", key, known)
+			}
+		}
+	}
+	return nil
+}
+
+func isTargetConfigKey(key string) bool {
+	for _, known := range targetConfigKeys {
+		if key == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks field values before any API call is made
+func (t *TargetConfig) Validate() error {
+	if t.OVHEndpoint != "" && !isKnownEndpoint(t.OVHEndpoint) {
+		return fmt.Errorf("OVHEndpoint %q is invalid: expected one of %s or an https URL",
+			t.OVHEndpoint, strings.Join(knownEndpoints, ", "))
+	}
+	if t.RequestsPerSecond < 0 {
+		return fmt.Errorf("RequestsPerSecond must not be negative, got %v", t.RequestsPerSecond)
+	}
+	if t.RequestTimeout < 0 {
+		return fmt.Errorf("RequestTimeout must not be negative, got %v", t.RequestTimeout)
+	}
+	if t.MaxIdleConns < 0 {
+		return fmt.Errorf("MaxIdleConns must not be negative, got %v", t.MaxIdleConns)
+	}
+	if t.MaxIdleConnsPerHost < 0 {
+		return fmt.Errorf("MaxIdleConnsPerHost must not be negative, got %v", t.MaxIdleConnsPerHost)
+	}
+	if t.IdleConnTimeout < 0 {
+		return fmt.Errorf("IdleConnTimeout must not be negative, got %v", t.IdleConnTimeout)
+	}
+	if t.CircuitBreakerThreshold < 0 {
+		return fmt.Errorf("CircuitBreakerThreshold must not be negative, got %v", t.CircuitBreakerThreshold)
+	}
+	if t.CircuitBreakerCooldown < 0 {
+		return fmt.Errorf("CircuitBreakerCooldown must not be negative, got %v", t.CircuitBreakerCooldown)
+	}
+	for key := range t.InstanceMetadata {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("InstanceMetadata keys must not be empty")
+		}
+	}
+	for _, key := range t.RequiredInstanceMetadata {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("RequiredInstanceMetadata keys must not be empty")
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(t.LogLevel)) {
+	case "", "off", "debug", "trace":
+	default:
+		return fmt.Errorf("LogLevel %q is invalid: expected off, debug or trace", t.LogLevel)
+	}
+	if strings.TrimSpace(t.Region) != t.Region {
+		return fmt.Errorf("Region %q must not contain leading or trailing spaces", t.Region)
+	}
+	if strings.TrimSpace(t.ProjectID) != t.ProjectID {
+		return fmt.Errorf("ProjectId %q must not contain leading or trailing spaces", t.ProjectID)
+	}
+	return nil
+}
+
+func isKnownEndpoint(endpoint string) bool {
+	for _, known := range knownEndpoints {
+		if endpoint == known {
+			return true
+		}
+	}
+	u, err := url.Parse(endpoint)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
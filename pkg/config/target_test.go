@@ -0,0 +1,190 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTargetConfig(t *testing.T) {
+	cfg, err := ParseTargetConfig([]byte(`{"Type":"OVH","Region":"GRA7","ProjectId":"p1","RequestTimeout":30,"formaeInternal":true}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "GRA7", cfg.Region)
+	assert.Equal(t, "p1", cfg.ProjectID)
+	assert.Equal(t, float64(30), cfg.RequestTimeout)
+}
+
+func TestParseTargetConfig_MiscasedKey(t *testing.T) {
+	_, err := ParseTargetConfig([]byte(`{"Region":"GRA7","projectID":"p1"}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"projectID"`)
+	assert.Contains(t, err.Error(), `did you mean "ProjectId"`)
+}
+
+func TestParseTargetConfig_Aliases(t *testing.T) {
+	_, err := ParseTargetConfig([]byte(`{"region":"GRA7","serviceName":"p1","projectId":"p1"}`))
+	assert.NoError(t, err)
+}
+
+func TestTargetConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     TargetConfig
+		wantErr string
+	}{
+		{"empty", TargetConfig{}, ""},
+		{"named endpoint", TargetConfig{OVHEndpoint: "ovh-ca"}, ""},
+		{"url endpoint", TargetConfig{OVHEndpoint: "https://eu.api.ovh.com/1.0"}, ""},
+		{"unknown endpoint", TargetConfig{OVHEndpoint: "ovh-eu1"}, "OVHEndpoint"},
+		{"negative rate", TargetConfig{RequestsPerSecond: -1}, "RequestsPerSecond"},
+		{"negative timeout", TargetConfig{RequestTimeout: -5}, "RequestTimeout"},
+		{"negative idle conns", TargetConfig{MaxIdleConnsPerHost: -1}, "MaxIdleConnsPerHost"},
+		{"negative idle timeout", TargetConfig{IdleConnTimeout: -1}, "IdleConnTimeout"},
+		{"bad log level", TargetConfig{LogLevel: "verbose"}, "LogLevel"},
+		{"padded region", TargetConfig{Region: "GRA7 "}, "Region"},
+		{"empty metadata key", TargetConfig{InstanceMetadata: map[string]string{"": "x"}}, "InstanceMetadata"},
+		{"empty required key", TargetConfig{RequiredInstanceMetadata: []string{" "}}, "RequiredInstanceMetadata"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestFromTargetConfig_RejectsInvalidConfig(t *testing.T) {
+	_, err := FromTargetConfig([]byte(`{"LogLevel":"loud"}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid target config")
+}
+
+func TestFromTargetConfig_AutoActivateRegions(t *testing.T) {
+	cfg, err := FromTargetConfig([]byte(`{"AutoActivateRegions":true}`))
+	require.NoError(t, err)
+	assert.True(t, cfg.AutoActivateRegions)
+
+	t.Setenv("OVH_AUTO_ACTIVATE_REGIONS", "yes")
+	_, err = FromTargetConfig(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OVH_AUTO_ACTIVATE_REGIONS")
+}
+
+func TestFromTargetConfig_ReadCache(t *testing.T) {
+	cfg, err := FromTargetConfig([]byte(`{"ReadCache":true}`))
+	require.NoError(t, err)
+	assert.True(t, cfg.ReadCache)
+
+	t.Setenv("OVH_READ_CACHE", "yes")
+	_, err = FromTargetConfig(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OVH_READ_CACHE")
+}
+
+func TestFromTargetConfig_DryRun(t *testing.T) {
+	cfg, err := FromTargetConfig([]byte(`{"DryRun":true}`))
+	require.NoError(t, err)
+	assert.True(t, cfg.DryRun)
+
+	t.Setenv("OVH_DRY_RUN", "true")
+	cfg, err = FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.DryRun)
+}
+
+func TestFromTargetConfig_ConnectionPool(t *testing.T) {
+	cfg, err := FromTargetConfig([]byte(`{"MaxIdleConnsPerHost":64,"IdleConnTimeout":30}`))
+	require.NoError(t, err)
+	assert.Equal(t, 64, cfg.MaxIdleConnsPerHost)
+	assert.Equal(t, float64(30), cfg.IdleConnTimeout)
+
+	t.Setenv("OVH_MAX_IDLE_CONNS", "200")
+	cfg, err = FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 200, cfg.MaxIdleConns)
+
+	t.Setenv("OVH_MAX_IDLE_CONNS_PER_HOST", "many")
+	_, err = FromTargetConfig(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OVH_MAX_IDLE_CONNS_PER_HOST")
+}
+
+func TestFromTargetConfig_ClockSkew(t *testing.T) {
+	cfg, err := FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.Nil(t, cfg.ClockSkew)
+
+	cfg, err = FromTargetConfig([]byte(`{"ClockSkew":0}`))
+	require.NoError(t, err)
+	require.NotNil(t, cfg.ClockSkew)
+	assert.Equal(t, float64(0), *cfg.ClockSkew)
+
+	t.Setenv("OVH_CLOCK_SKEW", "-2.5")
+	cfg, err = FromTargetConfig(nil)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.ClockSkew)
+	assert.Equal(t, -2.5, *cfg.ClockSkew)
+
+	t.Setenv("OVH_CLOCK_SKEW", "soon")
+	_, err = FromTargetConfig(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OVH_CLOCK_SKEW")
+}
+
+func TestFromTargetConfig_CircuitBreaker(t *testing.T) {
+	cfg, err := FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.Zero(t, cfg.CircuitBreakerThreshold)
+
+	cfg, err = FromTargetConfig([]byte(`{"CircuitBreakerThreshold":5,"CircuitBreakerCooldown":45}`))
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.CircuitBreakerThreshold)
+	assert.Equal(t, float64(45), cfg.CircuitBreakerCooldown)
+
+	t.Setenv("OVH_CIRCUIT_BREAKER_THRESHOLD", "3")
+	t.Setenv("OVH_CIRCUIT_BREAKER_COOLDOWN", "10")
+	cfg, err = FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.CircuitBreakerThreshold)
+	assert.Equal(t, float64(10), cfg.CircuitBreakerCooldown)
+
+	_, err = FromTargetConfig([]byte(`{"CircuitBreakerThreshold":-1}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "CircuitBreakerThreshold")
+
+	t.Setenv("OVH_CIRCUIT_BREAKER_THRESHOLD", "often")
+	_, err = FromTargetConfig(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OVH_CIRCUIT_BREAKER_THRESHOLD")
+}
+
+func TestFromTargetConfig_InstanceMetadata(t *testing.T) {
+	cfg, err := FromTargetConfig([]byte(`{"InstanceMetadata":{"team":"infra"},"RequiredInstanceMetadata":["cost-center"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "infra"}, cfg.InstanceMetadata)
+	assert.Equal(t, []string{"cost-center"}, cfg.RequiredInstanceMetadata)
+
+	t.Setenv("OVH_INSTANCE_METADATA", "team=infra, environment=prod")
+	t.Setenv("OVH_REQUIRED_INSTANCE_METADATA", "cost-center,team")
+	cfg, err = FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "infra", "environment": "prod"}, cfg.InstanceMetadata)
+	assert.Equal(t, []string{"cost-center", "team"}, cfg.RequiredInstanceMetadata)
+
+	t.Setenv("OVH_INSTANCE_METADATA", "team")
+	_, err = FromTargetConfig(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OVH_INSTANCE_METADATA")
+}
@@ -0,0 +1,21 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package config
+
+import "strings"
+
+// Version is the plugin version reported in the User-Agent header.
+// Release builds set it with -ldflags "-X .../pkg/config.Version=<version>".
+var Version = "dev"
+
+// UserAgent returns the User-Agent sent with every OVH and OpenStack API call,
+// e.g. "formae-plugin-ovh/0.1.0 ci-pipeline" when UserAgentSuffix is set.
+func (c *Config) UserAgent() string {
+	ua := "formae-plugin-ovh/" + Version
+	if suffix := strings.TrimSpace(c.UserAgentSuffix); suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
@@ -0,0 +1,29 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAgent(t *testing.T) {
+	assert.Equal(t, "formae-plugin-ovh/"+Version, (&Config{}).UserAgent())
+	assert.Equal(t, "formae-plugin-ovh/"+Version+" ci-pipeline", (&Config{UserAgentSuffix: " ci-pipeline "}).UserAgent())
+}
+
+func TestFromTargetConfig_UserAgentSuffix(t *testing.T) {
+	t.Setenv("OVH_USER_AGENT_SUFFIX", "from-env")
+
+	cfg, err := FromTargetConfig([]byte(`{"UserAgentSuffix":"from-target"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "from-target", cfg.UserAgentSuffix)
+
+	cfg, err = FromTargetConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", cfg.UserAgentSuffix)
+}
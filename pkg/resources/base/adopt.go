@@ -0,0 +1,91 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// mayHaveCreated reports whether a failed POST could still have created the
+// resource. An API error with a 4xx status is a definite rejection.
+func mayHaveCreated(err error) bool {
+	var apiErr *ovhtransport.Error
+	if errors.As(err, &apiErr) && apiErr.HTTPCode >= 400 && apiErr.HTTPCode < 500 {
+		return false
+	}
+	return true
+}
+
+// findExisting looks for the resource an earlier, lost attempt of this
+// Create made (see prov.LostCreates), matching props on every property in
+// ResourceConfig.AdoptExistingBy. The keys must cover the identifying body,
+// not only the name.
+// It returns nil when there was no lost attempt for attemptKey, adoption is
+// not configured, a property is unset, the lookup fails, or the match is not
+// unique, leaving Create to POST as usual.
+func (b *BaseResource) findExisting(ctx context.Context, url string, props map[string]interface{}, attemptKey string) map[string]interface{} {
+	keys := b.ResourceConfig.AdoptExistingBy
+	if len(keys) == 0 {
+		return nil
+	}
+	attempted, ok := prov.LostCreates.Since(attemptKey)
+	if !ok {
+		return nil
+	}
+	for _, key := range keys {
+		if v, ok := props[key]; !ok || v == nil || v == "" {
+			return nil
+		}
+	}
+
+	response, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return nil
+	}
+
+	var match map[string]interface{}
+	for _, item := range response.BodyArray {
+		existing, ok := item.(map[string]interface{})
+		if !ok || !matchesAll(existing, props, keys) || b.createdBefore(existing, attempted) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = existing
+	}
+	return match
+}
+
+// createdBefore reports whether existing was created before the lost
+// attempt, so it cannot be that attempt's resource. Resources without a
+// readable creation time are not excluded.
+func (b *BaseResource) createdBefore(existing map[string]interface{}, attempted time.Time) bool {
+	property := b.ResourceConfig.AdoptCreatedAt
+	if property == "" {
+		return false
+	}
+	value, _ := existing[property].(string)
+	created, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+	return created.Before(attempted.Add(-prov.AdoptClockMargin))
+}
+
+// matchesAll reports whether existing and props agree on every key
+func matchesAll(existing, props map[string]interface{}, keys []string) bool {
+	for _, key := range keys {
+		if !reflect.DeepEqual(existing[key], props[key]) {
+			return false
+		}
+	}
+	return true
+}
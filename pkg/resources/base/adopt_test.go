@@ -0,0 +1,233 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdoptTestResource(client TransportClient) *BaseResource {
+	b := newListTestResource(client, nil)
+	b.ResourceConfig.AdoptExistingBy = []string{"name", "region"}
+	return b
+}
+
+func createRequest(props string) *resource.CreateRequest {
+	return &resource.CreateRequest{
+		ResourceType: "OVH::Cloud::Instance",
+		Label:        "web-1",
+		Properties:   json.RawMessage(props),
+		TargetConfig: json.RawMessage(`{"ProjectId": "my-project"}`),
+	}
+}
+
+// loseCreate records a lost attempt of request, as a POST that timed out does
+func loseCreate(t *testing.T, request *resource.CreateRequest) {
+	t.Helper()
+	key := prov.CreateAttemptKey(request, "/cloud/project/my-project/instance")
+	prov.LostCreates.Record(key)
+	t.Cleanup(func() { prov.LostCreates.Clear(key) })
+}
+
+// lossyClient fails the first POST the way a timed out request does
+type lossyClient struct {
+	fakeClient
+	postErr error
+}
+
+func (c *lossyClient) Do(ctx context.Context, opts ovhtransport.RequestOptions) (*ovhtransport.Response, error) {
+	if opts.Method == "POST" && c.postErr != nil {
+		c.requests = append(c.requests, opts)
+		err := c.postErr
+		c.postErr = nil
+		return nil, err
+	}
+	return c.fakeClient.Do(ctx, opts)
+}
+
+func TestCreate_AdoptsAfterLostResponse(t *testing.T) {
+	client := &lossyClient{
+		fakeClient: fakeClient{response: &ovhtransport.Response{
+			StatusCode: 200,
+			BodyArray: []interface{}{
+				map[string]interface{}{"id": "inst-1", "name": "web", "region": "GRA11"},
+				map[string]interface{}{"id": "inst-2", "name": "web", "region": "DE1"},
+			},
+		}},
+		postErr: errors.New("context deadline exceeded"),
+	}
+	b := newAdoptTestResource(client)
+	request := createRequest(`{"name": "web", "region": "DE1"}`)
+	t.Cleanup(func() { prov.LostCreates.Clear(prov.CreateAttemptKey(request, "/cloud/project/my-project/instance")) })
+
+	result, err := b.Create(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+
+	result, err = b.Create(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "my-project/inst-2", result.ProgressResult.NativeID)
+
+	require.Len(t, client.requests, 2)
+	assert.Equal(t, "POST", client.requests[0].Method)
+	assert.Equal(t, "GET", client.requests[1].Method)
+
+	_, lost := prov.LostCreates.Since(prov.CreateAttemptKey(request, "/cloud/project/my-project/instance"))
+	assert.False(t, lost, "the attempt is forgotten once adopted")
+}
+
+func TestCreate_DoesNotAdoptWithoutLostAttempt(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{
+		StatusCode: 200,
+		Body:       map[string]interface{}{"id": "inst-2"},
+		BodyArray: []interface{}{
+			map[string]interface{}{"id": "inst-1", "name": "web", "region": "DE1"},
+		},
+	}}
+	b := newAdoptTestResource(client)
+
+	// A second resource identical to one already created gets its own
+	result, err := b.Create(context.Background(), createRequest(`{"name": "web", "region": "DE1"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "my-project/inst-2", result.ProgressResult.NativeID)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "POST", client.requests[0].Method)
+}
+
+func TestCreate_DoesNotAdoptForAnotherResource(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{
+		StatusCode: 200,
+		Body:       map[string]interface{}{"id": "inst-2"},
+		BodyArray: []interface{}{
+			map[string]interface{}{"id": "inst-1", "name": "web", "region": "DE1"},
+		},
+	}}
+	b := newAdoptTestResource(client)
+	loseCreate(t, createRequest(`{"name": "web", "region": "DE1"}`))
+
+	other := createRequest(`{"name": "web", "region": "DE1"}`)
+	other.Label = "web-2"
+	result, err := b.Create(context.Background(), other)
+	require.NoError(t, err)
+	assert.Equal(t, "my-project/inst-2", result.ProgressResult.NativeID)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "POST", client.requests[0].Method)
+}
+
+func TestCreate_RejectedPostIsNotRetried(t *testing.T) {
+	client := &lossyClient{postErr: &ovhtransport.Error{Code: ovhtransport.ErrorCodeInvalidInput, HTTPCode: 400}}
+	b := newAdoptTestResource(client)
+	request := createRequest(`{"name": "web", "region": "DE1"}`)
+
+	_, err := b.Create(context.Background(), request)
+	require.NoError(t, err)
+
+	_, lost := prov.LostCreates.Since(prov.CreateAttemptKey(request, "/cloud/project/my-project/instance"))
+	assert.False(t, lost, "a 4xx rejection created nothing")
+}
+
+func TestCreate_SkipsResourcesOlderThanLostAttempt(t *testing.T) {
+	old := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+	client := &fakeClient{response: &ovhtransport.Response{
+		StatusCode: 200,
+		Body:       map[string]interface{}{"id": "inst-2"},
+		BodyArray: []interface{}{
+			map[string]interface{}{"id": "inst-1", "name": "web", "region": "DE1", "created": old},
+		},
+	}}
+	b := newAdoptTestResource(client)
+	b.ResourceConfig.AdoptCreatedAt = "created"
+	request := createRequest(`{"name": "web", "region": "DE1"}`)
+	loseCreate(t, request)
+
+	result, err := b.Create(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "my-project/inst-2", result.ProgressResult.NativeID)
+
+	require.Len(t, client.requests, 2)
+	assert.Equal(t, "POST", client.requests[1].Method)
+}
+
+func TestCreate_PostsWithoutUniqueMatch(t *testing.T) {
+	tests := map[string][]interface{}{
+		"no match": {
+			map[string]interface{}{"id": "inst-1", "name": "api", "region": "DE1"},
+		},
+		"ambiguous": {
+			map[string]interface{}{"id": "inst-1", "name": "web", "region": "DE1"},
+			map[string]interface{}{"id": "inst-2", "name": "web", "region": "DE1"},
+		},
+	}
+
+	for name, existing := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeClient{response: &ovhtransport.Response{
+				StatusCode: 200,
+				Body:       map[string]interface{}{"id": "inst-3"},
+				BodyArray:  existing,
+			}}
+			b := newAdoptTestResource(client)
+			request := createRequest(`{"name": "web", "region": "DE1"}`)
+			loseCreate(t, request)
+
+			result, err := b.Create(context.Background(), request)
+			require.NoError(t, err)
+			assert.Equal(t, "my-project/inst-3", result.ProgressResult.NativeID)
+
+			require.Len(t, client.requests, 2)
+			assert.Equal(t, "POST", client.requests[1].Method)
+		})
+	}
+}
+
+func TestCreate_SkipsLookupWithoutIdentity(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{
+		StatusCode: 200,
+		Body:       map[string]interface{}{"id": "inst-3"},
+	}}
+	b := newAdoptTestResource(client)
+	request := createRequest(`{"name": "web"}`)
+	loseCreate(t, request)
+
+	_, err := b.Create(context.Background(), request)
+	require.NoError(t, err)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "POST", client.requests[0].Method)
+}
+
+func TestCreate_DoesNotAdoptOnNameAlone(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{
+		StatusCode: 200,
+		Body:       map[string]interface{}{"id": "key-2"},
+		BodyArray: []interface{}{
+			map[string]interface{}{"id": "key-1", "name": "deploy", "publicKey": "ssh-ed25519 AAAA other"},
+		},
+	}}
+	b := newListTestResource(client, nil)
+	b.ResourceConfig.AdoptExistingBy = []string{"name", "publicKey"}
+	request := createRequest(`{"name": "deploy", "publicKey": "ssh-ed25519 AAAA mine"}`)
+	loseCreate(t, request)
+
+	result, err := b.Create(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "my-project/key-2", result.ProgressResult.NativeID)
+
+	require.Len(t, client.requests, 2)
+	assert.Equal(t, "POST", client.requests[1].Method)
+}
@@ -3,9 +3,13 @@ package base
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 )
@@ -24,6 +28,10 @@ type BaseResource struct {
 	RequestTransformer  RequestTransformer
 	ResponseTransformer ResponseTransformer
 	StatusChecker       StatusChecker
+	StatusBackoff       *StatusBackoff
+	QuotaCheck          QuotaCheck
+	UpdateAction        UpdateAction
+	ReferenceCheck      ReferenceCheck
 	Client              TransportClient
 }
 
@@ -47,6 +55,16 @@ func (b *BaseResource) Create(ctx context.Context, request *resource.CreateReque
 		return b.createFailureResult(resource.OperationErrorCodeInvalidRequest,
 			fmt.Sprintf("parent resource ID required: property %q is empty or not a valid ID", propName)), nil
 	}
+	if b.missingRegion(pathCtx) {
+		return b.createFailureResult(resource.OperationErrorCodeInvalidRequest, errMissingRegion.Error()), nil
+	}
+
+	// Fail fast with a clear reason instead of an opaque API error
+	if b.QuotaCheck != nil {
+		if err := b.QuotaCheck(props, b.buildTransformContext(ctx, pathCtx, resource.OperationCreate)); err != nil {
+			return b.createFailureResult(resource.OperationErrorCodeServiceLimitExceeded, err.Error()), nil
+		}
+	}
 
 	body := props
 	if b.RequestTransformer != nil {
@@ -65,54 +83,66 @@ func (b *BaseResource) Create(ctx context.Context, request *resource.CreateReque
 	// Filter nil values - OVH API rejects null for optional fields
 	filteredBody := filterNilValues(body)
 
-	response, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
-		Method: "POST",
-		Path:   url,
-		Body:   filteredBody,
-	})
-	if err != nil {
-		return b.handleTransportError(err, resource.OperationCreate, ""), nil
-	}
-
-	// Handle async operations if configured
-	responseBody := response.Body
-	if !b.OperationConfig.Synchronous && b.OperationConfig.OperationIDExtractor != nil {
-		operationID := b.OperationConfig.OperationIDExtractor(response.Body)
-		if operationID != "" {
-			// This is an async operation - poll until complete
-			completedOperation, err := b.pollOperation(ctx, pathCtx, operationID)
-			if err != nil {
-				return b.createFailureResult(resource.OperationErrorCodeServiceInternalError,
-					fmt.Sprintf("operation failed: %v", err)), nil
+	// A retried Create adopts the resource an earlier attempt created, when
+	// that attempt's response was lost, instead of creating a duplicate
+	attemptKey := prov.CreateAttemptKey(request, url)
+	responseBody := b.findExisting(ctx, url, props, attemptKey)
+	if responseBody == nil {
+		response, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "POST",
+			Path:   url,
+			Body:   filteredBody,
+		})
+		if err != nil {
+			if mayHaveCreated(err) {
+				prov.LostCreates.Record(attemptKey)
 			}
+			return b.handleTransportError(err, resource.OperationCreate, ""), nil
+		}
 
-			// Extract the resource ID from the completed operation
-			resourceID, _ := completedOperation["resourceId"].(string)
-			if resourceID != "" {
-				// Fetch the actual resource to get its properties
-				resourceURL := b.APIConfig.PathBuilder(PathContext{
-					Project:      pathCtx.Project,
-					Region:       pathCtx.Region,
-					ResourceType: pathCtx.ResourceType,
-					ResourceName: resourceID,
-				})
-				resourceResponse, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
-					Method: "GET",
-					Path:   resourceURL,
-				})
-				if err == nil {
-					responseBody = resourceResponse.Body
+		// Handle async operations if configured
+		responseBody = response.Body
+		if !b.OperationConfig.Synchronous && b.OperationConfig.OperationIDExtractor != nil {
+			operationID := b.OperationConfig.OperationIDExtractor(response.Body)
+			if operationID != "" {
+				// This is an async operation - poll until complete
+				completedOperation, err := b.pollOperation(ctx, pathCtx, operationID)
+				if err != nil {
+					prov.LostCreates.Record(attemptKey)
+					return b.createFailureResult(resource.OperationErrorCodeServiceInternalError,
+						fmt.Sprintf("operation failed: %v", err)), nil
+				}
+
+				// Extract the resource ID from the completed operation
+				resourceID, _ := completedOperation["resourceId"].(string)
+				if resourceID != "" {
+					// Fetch the actual resource to get its properties
+					resourceURL := b.APIConfig.PathBuilder(PathContext{
+						Project:      pathCtx.Project,
+						Region:       pathCtx.Region,
+						ResourceType: pathCtx.ResourceType,
+						ResourceName: resourceID,
+					})
+					resourceResponse, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
+						Method: "GET",
+						Path:   resourceURL,
+					})
+					if err == nil {
+						responseBody = resourceResponse.Body
+					} else {
+						// Fall back to operation response if fetch fails
+						responseBody = completedOperation
+					}
 				} else {
-					// Fall back to operation response if fetch fails
+					// No resourceId, use operation response
 					responseBody = completedOperation
 				}
-			} else {
-				// No resourceId, use operation response
-				responseBody = completedOperation
 			}
 		}
 	}
 
+	prov.LostCreates.Clear(attemptKey)
+
 	// Extract native ID
 	nativeID := ""
 	if b.OperationConfig.NativeIDExtractor != nil {
@@ -178,10 +208,10 @@ func (b *BaseResource) Read(ctx context.Context, request *resource.ReadRequest)
 	}
 
 	// Extract region from target config for regional resources
-	if b.ResourceConfig.Scope != nil && b.ResourceConfig.Scope.Type == ScopeRegional {
-		if len(request.TargetConfig) > 0 {
-			pathCtx.Region = extractRegionFromTargetConfig(request.TargetConfig)
-		}
+	if err := b.resolveRegion(&pathCtx, request.TargetConfig); err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
 	}
 
 	urlBuilder := NewURLBuilder(b.APIConfig, pathCtx)
@@ -253,10 +283,8 @@ func (b *BaseResource) Update(ctx context.Context, request *resource.UpdateReque
 	}
 
 	// Extract region from target config for regional resources
-	if b.ResourceConfig.Scope != nil && b.ResourceConfig.Scope.Type == ScopeRegional {
-		if len(request.TargetConfig) > 0 {
-			pathCtx.Region = extractRegionFromTargetConfig(request.TargetConfig)
-		}
+	if err := b.resolveRegion(&pathCtx, request.TargetConfig); err != nil {
+		return b.updateFailureResult(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
 	}
 
 	body := props
@@ -280,6 +308,17 @@ func (b *BaseResource) Update(ctx context.Context, request *resource.UpdateReque
 	// Filter nil values - OVH API rejects null for optional fields
 	filteredBody := filterNilValues(body)
 
+	// Merge-patch endpoints apply every key they receive, so resending
+	// unchanged keys can clobber values the server changed since
+	if method == "PATCH" && b.ResourceConfig.PatchChangedOnly && len(request.PriorProperties) > 0 {
+		priorBody, err := b.priorUpdateBody(ctx, request.PriorProperties, pathCtx)
+		if err != nil {
+			return b.updateFailureResult(request.NativeID, resource.OperationErrorCodeInvalidRequest,
+				fmt.Sprintf("failed to parse prior properties: %v", err)), nil
+		}
+		filteredBody = changedKeys(filteredBody, priorBody)
+	}
+
 	response, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
 		Method: method,
 		Path:   url,
@@ -294,6 +333,22 @@ func (b *BaseResource) Update(ctx context.Context, request *resource.UpdateReque
 		_ = b.OperationConfig.PostMutationHook(pathCtx)
 	}
 
+	operationStatus := resource.OperationStatusSuccess
+	if b.UpdateAction != nil {
+		var prior map[string]interface{}
+		if len(request.PriorProperties) > 0 {
+			_ = json.Unmarshal(request.PriorProperties, &prior)
+		}
+		started, err := b.UpdateAction(props, prior, url, b.buildTransformContext(ctx, pathCtx, resource.OperationUpdate))
+		if err != nil {
+			return b.partialUpdateFailure(err, request.NativeID), nil
+		}
+		// Status polls the StatusChecker until the action has completed
+		if started && b.StatusChecker != nil {
+			operationStatus = resource.OperationStatusInProgress
+		}
+	}
+
 	responseProps := response.Body
 	if b.ResponseTransformer != nil {
 		transformCtx := b.buildTransformContext(ctx, pathCtx, resource.OperationUpdate)
@@ -305,13 +360,47 @@ func (b *BaseResource) Update(ctx context.Context, request *resource.UpdateReque
 	return &resource.UpdateResult{
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
-			OperationStatus:    resource.OperationStatusSuccess,
+			OperationStatus:    operationStatus,
 			NativeID:           request.NativeID,
 			ResourceProperties: propsJSON,
 		},
 	}, nil
 }
 
+// priorUpdateBody renders the prior properties the same way as the update body,
+// so the two can be compared key by key
+func (b *BaseResource) priorUpdateBody(ctx context.Context, priorProperties json.RawMessage, pathCtx PathContext) (map[string]interface{}, error) {
+	var prior map[string]interface{}
+	if err := json.Unmarshal(priorProperties, &prior); err != nil {
+		return nil, err
+	}
+
+	if b.RequestTransformer != nil {
+		transformCtx := b.buildTransformContext(ctx, pathCtx, resource.OperationUpdate)
+		transformed, err := b.RequestTransformer.Transform(prior, transformCtx)
+		if err != nil {
+			return nil, err
+		}
+		prior = transformed
+	}
+
+	return filterNilValues(prior), nil
+}
+
+// changedKeys returns the keys of desired whose values differ from prior.
+// Keys only present in prior are left out: OVH rejects null, so a merge
+// patch cannot unset them anyway.
+func changedKeys(desired, prior map[string]interface{}) map[string]interface{} {
+	changed := make(map[string]interface{}, len(desired))
+	for k, v := range desired {
+		if priorValue, ok := prior[k]; ok && reflect.DeepEqual(v, priorValue) {
+			continue
+		}
+		changed[k] = v
+	}
+	return changed
+}
+
 // Delete performs a DELETE operation
 func (b *BaseResource) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
 	pathCtx, err := ParseNativeID(b.NativeIDConfig, request.NativeID)
@@ -333,10 +422,8 @@ func (b *BaseResource) Delete(ctx context.Context, request *resource.DeleteReque
 	}
 
 	// Extract region from target config for regional resources
-	if b.ResourceConfig.Scope != nil && b.ResourceConfig.Scope.Type == ScopeRegional {
-		if len(request.TargetConfig) > 0 {
-			pathCtx.Region = extractRegionFromTargetConfig(request.TargetConfig)
-		}
+	if err := b.resolveRegion(&pathCtx, request.TargetConfig); err != nil {
+		return b.deleteFailureResult(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
 	}
 
 	urlBuilder := NewURLBuilder(b.APIConfig, pathCtx)
@@ -379,10 +466,26 @@ func (b *BaseResource) Delete(ctx context.Context, request *resource.DeleteReque
 	}, nil
 }
 
-// List performs a LIST operation
+// List performs a LIST operation. Resources with ListDetailed enabled list
+// through the detailed collection endpoint instead.
 func (b *BaseResource) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	if b.SupportsListDetailed() {
+		detailed, err := b.ListDetailed(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		nativeIDs := make([]string, 0, len(detailed.Resources))
+		for _, listed := range detailed.Resources {
+			nativeIDs = append(nativeIDs, listed.NativeID)
+		}
+		return &resource.ListResult{NativeIDs: nativeIDs}, nil
+	}
+
 	pathCtx := b.buildPathContextFromAdditionalProps(request.TargetConfig, request.AdditionalProperties)
 	pathCtx.ResourceType = b.ResourceConfig.ResourceType
+	if b.missingRegion(pathCtx) {
+		return nil, errMissingRegion
+	}
 
 	urlBuilder := NewURLBuilder(b.APIConfig, pathCtx)
 	url := urlBuilder.CollectionURL()
@@ -398,28 +501,7 @@ func (b *BaseResource) List(ctx context.Context, request *resource.ListRequest)
 	// OVH API returns either array of IDs or array of objects for list operations
 	var nativeIDs []string
 	for _, item := range response.BodyArray {
-		var id string
-		switch v := item.(type) {
-		case string:
-			// Direct ID string
-			id = v
-		case map[string]interface{}:
-			// Object with id field (e.g., SWIFT storage containers)
-			if idVal, ok := v["id"].(string); ok {
-				id = idVal
-			} else {
-				// Fallback to string representation
-				id = fmt.Sprintf("%v", item)
-			}
-		default:
-			id = fmt.Sprintf("%v", item)
-		}
-		nativeID := BuildNativeID(b.NativeIDConfig, PathContext{
-			Zone:         pathCtx.Zone,
-			Project:      pathCtx.Project,
-			ResourceName: id,
-		})
-		nativeIDs = append(nativeIDs, nativeID)
+		nativeIDs = append(nativeIDs, b.listNativeID(pathCtx, item))
 	}
 
 	return &resource.ListResult{
@@ -427,6 +509,33 @@ func (b *BaseResource) List(ctx context.Context, request *resource.ListRequest)
 	}, nil
 }
 
+// listNativeID builds the native ID of a list response item
+func (b *BaseResource) listNativeID(pathCtx PathContext, item interface{}) string {
+	return BuildNativeID(b.NativeIDConfig, PathContext{
+		Zone:         pathCtx.Zone,
+		Project:      pathCtx.Project,
+		ResourceName: listItemID(item),
+	})
+}
+
+// listItemID extracts the resource ID from a list response item
+func listItemID(item interface{}) string {
+	switch v := item.(type) {
+	case string:
+		// Direct ID string
+		return v
+	case map[string]interface{}:
+		// Object with id field (e.g., SWIFT storage containers)
+		if idVal, ok := v["id"].(string); ok {
+			return idVal
+		}
+		// Fallback to string representation
+		return fmt.Sprintf("%v", item)
+	default:
+		return fmt.Sprintf("%v", item)
+	}
+}
+
 // Status checks operation status
 func (b *BaseResource) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
 	// If no StatusChecker is configured, resource is immediately ready
@@ -441,6 +550,11 @@ func (b *BaseResource) Status(ctx context.Context, request *resource.StatusReque
 		}, nil
 	}
 
+	// Skip the API call while a slow resource's next check is not yet due
+	if due, result := b.StatusBackoff.Due(request); !due {
+		return result, nil
+	}
+
 	// Parse native ID to read the resource
 	pathCtx, err := ParseNativeID(b.NativeIDConfig, request.NativeID)
 	if err != nil {
@@ -469,10 +583,17 @@ func (b *BaseResource) Status(ctx context.Context, request *resource.StatusReque
 	}
 
 	// Extract region from target config for regional resources
-	if b.ResourceConfig.Scope != nil && b.ResourceConfig.Scope.Type == ScopeRegional {
-		if len(request.TargetConfig) > 0 {
-			pathCtx.Region = extractRegionFromTargetConfig(request.TargetConfig)
-		}
+	if err := b.resolveRegion(&pathCtx, request.TargetConfig); err != nil {
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusFailure,
+				ErrorCode:       resource.OperationErrorCodeInvalidRequest,
+				StatusMessage:   err.Error(),
+				RequestID:       request.RequestID,
+				NativeID:        request.NativeID,
+			},
+		}, nil
 	}
 
 	// Read the resource
@@ -498,6 +619,7 @@ func (b *BaseResource) Status(ctx context.Context, request *resource.StatusReque
 		Path:   url,
 	})
 	if err != nil {
+		b.StatusBackoff.Done(request)
 		if transportErr, ok := err.(*ovhtransport.Error); ok {
 			return &resource.StatusResult{
 				ProgressResult: &resource.ProgressResult{
@@ -525,6 +647,7 @@ func (b *BaseResource) Status(ctx context.Context, request *resource.StatusReque
 	// Check if resource is ready using the StatusChecker
 	ready, err := b.StatusChecker(response.Body)
 	if err != nil {
+		b.StatusBackoff.Done(request)
 		return &resource.StatusResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCheckStatus,
@@ -538,6 +661,7 @@ func (b *BaseResource) Status(ctx context.Context, request *resource.StatusReque
 	}
 
 	if !ready {
+		b.StatusBackoff.Pending(request)
 		return &resource.StatusResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCheckStatus,
@@ -550,6 +674,7 @@ func (b *BaseResource) Status(ctx context.Context, request *resource.StatusReque
 	}
 
 	// Resource is ready
+	b.StatusBackoff.Done(request)
 	propsJSON, _ := json.Marshal(response.Body)
 	return &resource.StatusResult{
 		ProgressResult: &resource.ProgressResult{
@@ -648,10 +773,13 @@ func (b *BaseResource) buildPathContextFromAdditionalProps(targetConfig json.Raw
 		ctx.Project = extractProjectFromTargetConfig(targetConfig)
 	}
 
-	// Extract region from target config only for regional resources
+	// Extract region for regional resources
 	// ScopeRegional resources need region in the URL path
 	if b.ResourceConfig.Scope != nil && b.ResourceConfig.Scope.Type == ScopeRegional {
-		if len(targetConfig) > 0 {
+		// A region in additional properties lists another region than the target's
+		if region, ok := additionalProps["region"]; ok && region != "" {
+			ctx.Region = region
+		} else if len(targetConfig) > 0 {
 			ctx.Region = extractRegionFromTargetConfig(targetConfig)
 		}
 	}
@@ -670,6 +798,9 @@ func (b *BaseResource) buildPathContextFromAdditionalProps(targetConfig json.Raw
 
 // filterNilValues removes nil values from a map recursively.
 // OVH API rejects null values for optional fields - they should be omitted entirely.
+// Explicitly empty maps and slices are kept, since clearing a block is
+// meaningful to some endpoints. Only containers that become empty because
+// all their values were nil are dropped.
 func filterNilValues(m map[string]interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
 	for k, v := range m {
@@ -679,14 +810,14 @@ func filterNilValues(m map[string]interface{}) map[string]interface{} {
 		// Recursively filter nested maps
 		if nested, ok := v.(map[string]interface{}); ok {
 			filtered := filterNilValues(nested)
-			if len(filtered) > 0 {
+			if len(filtered) > 0 || len(nested) == 0 {
 				result[k] = filtered
 			}
 			continue
 		}
 		// Filter nil values from slices of maps
 		if slice, ok := v.([]interface{}); ok {
-			var filtered []interface{}
+			filtered := make([]interface{}, 0, len(slice))
 			for _, item := range slice {
 				if item == nil {
 					continue
@@ -697,7 +828,7 @@ func filterNilValues(m map[string]interface{}) map[string]interface{} {
 					filtered = append(filtered, item)
 				}
 			}
-			if len(filtered) > 0 {
+			if len(filtered) > 0 || len(slice) == 0 {
 				result[k] = filtered
 			}
 			continue
@@ -725,8 +856,35 @@ func extractProjectFromTargetConfig(targetConfig json.RawMessage) string {
 	return ""
 }
 
+// errMissingRegion is returned for a regional resource when no region is
+// found in its properties, the target config or the default region
+var errMissingRegion = errors.New("region is required: set the region property, Region in the target config, or OVH_DEFAULT_REGION")
+
+// resolveRegion sets the region of a regional resource from the target
+// config. A region already taken from the native ID is kept when the target
+// config has none; with neither, errMissingRegion is returned instead of
+// building a URL without a region.
+func (b *BaseResource) resolveRegion(pathCtx *PathContext, targetConfig json.RawMessage) error {
+	if b.ResourceConfig.Scope == nil || b.ResourceConfig.Scope.Type != ScopeRegional {
+		return nil
+	}
+	if region := extractRegionFromTargetConfig(targetConfig); region != "" {
+		pathCtx.Region = region
+	}
+	if pathCtx.Region == "" {
+		return errMissingRegion
+	}
+	return nil
+}
+
+// missingRegion reports whether a regional resource's path has no region
+func (b *BaseResource) missingRegion(pathCtx PathContext) bool {
+	return b.ResourceConfig.Scope != nil && b.ResourceConfig.Scope.Type == ScopeRegional && pathCtx.Region == ""
+}
+
 // extractRegionFromTargetConfig extracts region from target config JSON.
-// Checks multiple field names to support different naming conventions.
+// Checks multiple field names to support different naming conventions, then
+// DefaultRegion, injected by the plugin from OVH_DEFAULT_REGION.
 func extractRegionFromTargetConfig(targetConfig json.RawMessage) string {
 	var cfg map[string]interface{}
 	if err := json.Unmarshal(targetConfig, &cfg); err != nil {
@@ -734,7 +892,7 @@ func extractRegionFromTargetConfig(targetConfig json.RawMessage) string {
 	}
 
 	// Check various field names for region (PascalCase and camelCase)
-	regionFields := []string{"Region", "region", "RegionName", "regionName"}
+	regionFields := []string{"Region", "region", "RegionName", "regionName", "DefaultRegion"}
 	for _, field := range regionFields {
 		if val, ok := cfg[field].(string); ok && val != "" {
 			return val
@@ -821,6 +979,25 @@ func (b *BaseResource) updateFailureResult(nativeID string, errorCode resource.O
 	}
 }
 
+// partialUpdateFailure reports an UpdateAction failure. The update request
+// itself was already applied, so the message names every step that completed
+// and the one that failed; retrying the update re-applies only what is left.
+func (b *BaseResource) partialUpdateFailure(err error, nativeID string) *resource.UpdateResult {
+	applied := []string{"properties"}
+	failed := "update action"
+	var partial *PartialUpdateError
+	if errors.As(err, &partial) {
+		applied = append(applied, partial.Applied...)
+		failed = partial.Failed
+		err = partial.Err
+	}
+
+	result := b.handleTransportErrorUpdate(err, nativeID)
+	result.ProgressResult.StatusMessage = fmt.Sprintf("partially updated: applied %s; %s failed: %s",
+		strings.Join(applied, ", "), failed, result.ProgressResult.StatusMessage)
+	return result
+}
+
 func (b *BaseResource) deleteFailureResult(nativeID string, errorCode resource.OperationErrorCode, message string) *resource.DeleteResult {
 	return &resource.DeleteResult{
 		ProgressResult: &resource.ProgressResult{
@@ -0,0 +1,133 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// ListedResource is a resource returned by ListDetailed with its properties
+type ListedResource struct {
+	NativeID   string
+	Properties json.RawMessage
+}
+
+// ListDetailedResult holds the resources returned by a detailed list
+type ListDetailedResult struct {
+	Resources []ListedResource
+}
+
+// DetailedLister is implemented by provisioners that can return properties
+// alongside native IDs, letting discovery skip the per-resource Read.
+type DetailedLister interface {
+	SupportsListDetailed() bool
+	ListDetailed(ctx context.Context, request *resource.ListRequest) (*ListDetailedResult, error)
+}
+
+// SupportsListDetailed reports whether ListDetailed is enabled for this resource
+func (b *BaseResource) SupportsListDetailed() bool {
+	return b.ResourceConfig.ListDetailed != nil && b.ResourceConfig.ListDetailed.Enabled
+}
+
+// ListDetailed performs a LIST operation returning properties for each resource.
+// Items that are not objects are skipped, since they carry no properties.
+func (b *BaseResource) ListDetailed(ctx context.Context, request *resource.ListRequest) (*ListDetailedResult, error) {
+	if !b.SupportsListDetailed() {
+		return nil, fmt.Errorf("detailed list is not supported for %s", b.ResourceConfig.ResourceType)
+	}
+
+	pathCtx := b.buildPathContextFromAdditionalProps(request.TargetConfig, request.AdditionalProperties)
+	pathCtx.ResourceType = b.ResourceConfig.ResourceType
+	if b.missingRegion(pathCtx) {
+		return nil, errMissingRegion
+	}
+
+	urlBuilder := NewURLBuilder(b.APIConfig, pathCtx)
+	url := urlBuilder.CollectionURL() + encodeQueryParams(b.ResourceConfig.ListDetailed.QueryParams)
+
+	response, err := b.Client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+
+	transformCtx := b.buildTransformContext(ctx, pathCtx, resource.OperationList)
+
+	var resources []ListedResource
+	for _, item := range response.BodyArray {
+		props, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		nativeID := b.listNativeID(pathCtx, item)
+
+		if b.ResponseTransformer != nil {
+			props = b.ResponseTransformer.Transform(props, transformCtx)
+		}
+
+		propsJSON, err := json.Marshal(props)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal properties for %s: %w", nativeID, err)
+		}
+
+		resources = append(resources, ListedResource{
+			NativeID:   nativeID,
+			Properties: propsJSON,
+		})
+	}
+
+	return &ListDetailedResult{Resources: resources}, nil
+}
+
+// encodeQueryParams builds a "?k=v" query string with keys in sorted order
+func encodeQueryParams(params map[string]string) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(params[k]))
+	}
+	return "?" + strings.Join(parts, "&")
+}
+
+// ResolveName returns the native IDs of the resources whose name property is
+// name. Only resources with ListDetailed enabled can be resolved, since the
+// plain listing holds IDs alone.
+func (b *BaseResource) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	if !b.SupportsListDetailed() {
+		return nil, fmt.Errorf("%s cannot be imported by name, import it by native ID", b.ResourceConfig.ResourceType)
+	}
+
+	listed, err := b.ListDetailed(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var nativeIDs []string
+	for _, r := range listed.Resources {
+		var props struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(r.Properties, &props); err == nil && props.Name == name {
+			nativeIDs = append(nativeIDs, r.NativeID)
+		}
+	}
+	return nativeIDs, nil
+}
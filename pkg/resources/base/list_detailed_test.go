@@ -0,0 +1,193 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient records requests and returns a canned response
+type fakeClient struct {
+	requests []ovhtransport.RequestOptions
+	response *ovhtransport.Response
+}
+
+func (c *fakeClient) Do(ctx context.Context, opts ovhtransport.RequestOptions) (*ovhtransport.Response, error) {
+	c.requests = append(c.requests, opts)
+	return c.response, nil
+}
+
+func newListTestResource(client TransportClient, listDetailed *ListDetailedConfig) *BaseResource {
+	return &BaseResource{
+		APIConfig: APIConfig{
+			PathBuilder: func(ctx PathContext) string {
+				path := fmt.Sprintf("/cloud/project/%s/%s", ctx.Project, ctx.ResourceType)
+				if ctx.ResourceName != "" {
+					path += "/" + ctx.ResourceName
+				}
+				return path
+			},
+		},
+		ResourceConfig: ResourceConfig{
+			ResourceType: "instance",
+			Scope:        &ScopeConfig{Type: ScopeProject},
+			ListDetailed: listDetailed,
+		},
+		NativeIDConfig: NativeIDConfig{Format: ProjectHierarchicalFormat},
+		Client:         client,
+	}
+}
+
+func TestListDetailed(t *testing.T) {
+	client := &fakeClient{
+		response: &ovhtransport.Response{
+			StatusCode: 200,
+			BodyArray: []interface{}{
+				map[string]interface{}{"id": "inst-1", "name": "web-1"},
+				map[string]interface{}{"id": "inst-2", "name": "web-2"},
+			},
+		},
+	}
+	b := newListTestResource(client, &ListDetailedConfig{
+		Enabled:     true,
+		QueryParams: map[string]string{"detailed": "true"},
+	})
+
+	result, err := b.ListDetailed(context.Background(), &resource.ListRequest{
+		TargetConfig: json.RawMessage(`{"ProjectId": "my-project"}`),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Resources, 2)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "GET", client.requests[0].Method)
+	assert.Equal(t, "/cloud/project/my-project/instance?detailed=true", client.requests[0].Path)
+
+	assert.Equal(t, "my-project/inst-1", result.Resources[0].NativeID)
+	var props map[string]interface{}
+	require.NoError(t, json.Unmarshal(result.Resources[0].Properties, &props))
+	assert.Equal(t, "web-1", props["name"])
+}
+
+func TestListDetailed_SkipsIDOnlyItems(t *testing.T) {
+	client := &fakeClient{
+		response: &ovhtransport.Response{
+			StatusCode: 200,
+			BodyArray:  []interface{}{"inst-1", map[string]interface{}{"id": "inst-2"}},
+		},
+	}
+	b := newListTestResource(client, &ListDetailedConfig{Enabled: true})
+
+	result, err := b.ListDetailed(context.Background(), &resource.ListRequest{
+		TargetConfig: json.RawMessage(`{"ProjectId": "my-project"}`),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Resources, 1)
+	assert.Equal(t, "my-project/inst-2", result.Resources[0].NativeID)
+}
+
+func TestListDetailed_NotEnabled(t *testing.T) {
+	client := &fakeClient{}
+	b := newListTestResource(client, nil)
+
+	assert.False(t, b.SupportsListDetailed())
+
+	_, err := b.ListDetailed(context.Background(), &resource.ListRequest{})
+	assert.Error(t, err)
+	assert.Empty(t, client.requests)
+}
+
+func TestEncodeQueryParams(t *testing.T) {
+	assert.Equal(t, "", encodeQueryParams(nil))
+	assert.Equal(t, "?a=1&b=x+y", encodeQueryParams(map[string]string{"b": "x y", "a": "1"}))
+}
+
+func TestList_RoutesThroughListDetailed(t *testing.T) {
+	client := &fakeClient{
+		response: &ovhtransport.Response{
+			StatusCode: 200,
+			BodyArray:  []interface{}{map[string]interface{}{"id": "inst-1"}},
+		},
+	}
+	b := newListTestResource(client, &ListDetailedConfig{
+		Enabled:     true,
+		QueryParams: map[string]string{"detailed": "true"},
+	})
+
+	result, err := b.List(context.Background(), &resource.ListRequest{
+		TargetConfig: json.RawMessage(`{"ProjectId": "my-project"}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-project/inst-1"}, result.NativeIDs)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "/cloud/project/my-project/instance?detailed=true", client.requests[0].Path)
+}
+
+func TestListDetailed_NativeIDsMatchList(t *testing.T) {
+	response := &ovhtransport.Response{
+		StatusCode: 200,
+		BodyArray: []interface{}{
+			map[string]interface{}{"id": "inst-1"},
+			map[string]interface{}{"id": "inst-2"},
+		},
+	}
+	request := &resource.ListRequest{
+		TargetConfig: json.RawMessage(`{"ProjectId": "my-project", "Region": "GRA11"}`),
+	}
+
+	regional := func(listDetailed *ListDetailedConfig) *BaseResource {
+		b := newListTestResource(&fakeClient{response: response}, listDetailed)
+		b.ResourceConfig.Scope = &ScopeConfig{Type: ScopeRegional}
+		b.NativeIDConfig = NativeIDConfig{Format: ProjectRegionalFormat}
+		return b
+	}
+
+	listed, err := regional(nil).List(context.Background(), request)
+	require.NoError(t, err)
+
+	detailed, err := regional(&ListDetailedConfig{Enabled: true}).ListDetailed(context.Background(), request)
+	require.NoError(t, err)
+
+	var detailedIDs []string
+	for _, r := range detailed.Resources {
+		detailedIDs = append(detailedIDs, r.NativeID)
+	}
+	assert.Equal(t, listed.NativeIDs, detailedIDs)
+}
+
+func TestResolveName(t *testing.T) {
+	client := &fakeClient{
+		response: &ovhtransport.Response{
+			StatusCode: 200,
+			BodyArray: []interface{}{
+				map[string]interface{}{"id": "inst-1", "name": "web"},
+				map[string]interface{}{"id": "inst-2", "name": "db"},
+				map[string]interface{}{"id": "inst-3", "name": "web"},
+			},
+		},
+	}
+	b := newListTestResource(client, &ListDetailedConfig{Enabled: true})
+	request := &resource.ListRequest{TargetConfig: json.RawMessage(`{"ProjectId": "my-project"}`)}
+
+	nativeIDs, err := b.ResolveName(context.Background(), request, "web")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-project/inst-1", "my-project/inst-3"}, nativeIDs)
+
+	nativeIDs, err = b.ResolveName(context.Background(), request, "cache")
+	require.NoError(t, err)
+	assert.Empty(t, nativeIDs)
+
+	_, err = newListTestResource(&fakeClient{}, nil).ResolveName(context.Background(), request, "web")
+	assert.Error(t, err)
+}
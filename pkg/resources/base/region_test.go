@@ -51,3 +51,67 @@ func TestDeriveShortRegion(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildPathContextFromAdditionalProps_RegionOverride(t *testing.T) {
+	b := &BaseResource{
+		ResourceConfig: ResourceConfig{
+			ResourceType: "network/private",
+			Scope:        &ScopeConfig{Type: ScopeRegional},
+		},
+	}
+	targetConfig := []byte(`{"project":"p1","region":"GRA11"}`)
+
+	ctx := b.buildPathContextFromAdditionalProps(targetConfig, map[string]string{})
+	if ctx.Region != "GRA11" {
+		t.Errorf("Region = %q, want target region GRA11", ctx.Region)
+	}
+
+	ctx = b.buildPathContextFromAdditionalProps(targetConfig, map[string]string{"region": "BHS5"})
+	if ctx.Region != "BHS5" {
+		t.Errorf("Region = %q, want override BHS5", ctx.Region)
+	}
+}
+
+func TestResolveRegion_DefaultRegion(t *testing.T) {
+	b := &BaseResource{
+		ResourceConfig: ResourceConfig{
+			ResourceType: "network/private",
+			Scope:        &ScopeConfig{Type: ScopeRegional},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		targetConfig string
+		nativeRegion string
+		expected     string
+	}{
+		{"target region wins over default", `{"Region":"GRA11","DefaultRegion":"BHS5"}`, "", "GRA11"},
+		{"default region when target sets none", `{"DefaultRegion":"BHS5"}`, "", "BHS5"},
+		{"native ID region kept without target region", `{}`, "SBG5", "SBG5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathCtx := PathContext{Region: tt.nativeRegion}
+			if err := b.resolveRegion(&pathCtx, []byte(tt.targetConfig)); err != nil {
+				t.Fatalf("resolveRegion() error = %v", err)
+			}
+			if pathCtx.Region != tt.expected {
+				t.Errorf("Region = %q, want %q", pathCtx.Region, tt.expected)
+			}
+		})
+	}
+
+	pathCtx := PathContext{}
+	if err := b.resolveRegion(&pathCtx, []byte(`{"serviceName":"p1"}`)); err != errMissingRegion {
+		t.Errorf("resolveRegion() error = %v, want errMissingRegion", err)
+	}
+	if !b.missingRegion(pathCtx) {
+		t.Error("missingRegion() = false, want true for a regional resource without region")
+	}
+
+	global := &BaseResource{ResourceConfig: ResourceConfig{ResourceType: "sshkey"}}
+	if err := global.resolveRegion(&PathContext{}, nil); err != nil {
+		t.Errorf("resolveRegion() error = %v for a resource without regional scope", err)
+	}
+}
@@ -46,6 +46,13 @@ type CustomSegmentsConfig struct {
 	PropertyNames []string // Property names to extract into CustomSegments, in order
 }
 
+// ListDetailedConfig enables returning full properties from the collection endpoint.
+// Only valid for resources whose collection endpoint returns objects rather than IDs.
+type ListDetailedConfig struct {
+	Enabled     bool
+	QueryParams map[string]string // Extra query parameters, e.g. {"detailed": "true"}
+}
+
 // ResourceConfig defines the resource metadata and behavior
 type ResourceConfig struct {
 	ResourceType         string
@@ -54,7 +61,21 @@ type ResourceConfig struct {
 	CustomSegmentsConfig *CustomSegmentsConfig
 	SupportsUpdate       bool
 	UpdateMethod         UpdateMethod
+	PatchChangedOnly     bool // With UpdateMethodPatch, send only keys that differ from PriorProperties
 	UpdateQueryParams    map[string]string
 	OptimisticLocking    *OptimisticLockingConfig
 	RequestWrapper       string
+	ListDetailed         *ListDetailedConfig
+	// AdoptExistingBy names properties that identify a resource. When set,
+	// the retry of a Create whose response was lost adopts the single
+	// resource matching all of them instead of creating a duplicate. A name
+	// alone is not enough: list the properties that make up the resource's
+	// body (e.g. an SSH key's publicKey), so a same-named resource the stack
+	// does not own is never taken over. Adoption is skipped when any of them
+	// is unset. The collection endpoint must return objects.
+	AdoptExistingBy []string
+	// AdoptCreatedAt names the RFC 3339 creation time property, when the
+	// resource has one. Resources created before the lost attempt are not
+	// adopted.
+	AdoptCreatedAt string
 }
@@ -15,6 +15,36 @@ import (
 // Returns true if the resource is ready, false if still pending.
 type StatusChecker func(resourceData map[string]interface{}) (ready bool, err error)
 
+// QuotaCheck runs before Create and returns an error only when the request
+// would exceed a project limit. Checks that cannot fetch usage should return
+// nil and let the API decide.
+type QuotaCheck func(props map[string]interface{}, ctx TransformContext) error
+
+// UpdateAction runs after a successful Update to apply changes the update
+// endpoint cannot, through a dedicated action endpoint under resourceURL.
+// It returns true when it started an asynchronous change Status should wait for.
+type UpdateAction func(desired, prior map[string]interface{}, resourceURL string, ctx TransformContext) (started bool, err error)
+
+// PartialUpdateError is returned by an UpdateAction made of several steps
+// when one fails after others were applied, so Update can report both.
+type PartialUpdateError struct {
+	Applied []string // Steps that completed, in order
+	Failed  string   // Step that failed
+	Err     error
+}
+
+func (e *PartialUpdateError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Failed, e.Err)
+}
+
+func (e *PartialUpdateError) Unwrap() error {
+	return e.Err
+}
+
+// ReferenceCheck reports properties that refer to resources which do not
+// exist. It runs only when a request is validated, never before Create.
+type ReferenceCheck func(props map[string]interface{}, ctx TransformContext) ([]prov.ValidationError, error)
+
 // ResourceDefinition defines a complete resource registration
 type ResourceDefinition struct {
 	ResourceType        string
@@ -24,7 +54,11 @@ type ResourceDefinition struct {
 	NativeIDConfig      NativeIDConfig
 	RequestTransformer  RequestTransformer
 	ResponseTransformer ResponseTransformer
-	StatusChecker       StatusChecker // Optional: checks if resource is ready after creation
+	StatusChecker       StatusChecker  // Optional: checks if resource is ready after creation
+	StatusBackoff       *StatusBackoff // Optional: spaces out status checks for slow resources
+	QuotaCheck          QuotaCheck     // Optional: fails Create early when quota is exhausted
+	UpdateAction        UpdateAction   // Optional: triggers action endpoints after Update
+	ReferenceCheck      ReferenceCheck // Optional: checks referenced resources exist when validating
 	Operations          []resource.Operation
 }
 
@@ -119,6 +153,10 @@ func (r *ResourceRegistry) CreateProvisioner(client *ovhtransport.Client, resour
 		RequestTransformer:  def.RequestTransformer,
 		ResponseTransformer: def.ResponseTransformer,
 		StatusChecker:       def.StatusChecker,
+		StatusBackoff:       def.StatusBackoff,
+		QuotaCheck:          def.QuotaCheck,
+		UpdateAction:        def.UpdateAction,
+		ReferenceCheck:      def.ReferenceCheck,
 		Client:              client,
 	}
 
@@ -131,6 +169,8 @@ type UnifiedProvisioner struct {
 }
 
 var _ prov.Provisioner = &UnifiedProvisioner{}
+var _ DetailedLister = &UnifiedProvisioner{}
+var _ prov.Validator = &UnifiedProvisioner{}
 
 func (p *UnifiedProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
 	return p.base.Create(ctx, request)
@@ -155,3 +195,15 @@ func (p *UnifiedProvisioner) List(ctx context.Context, request *resource.ListReq
 func (p *UnifiedProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
 	return p.base.Status(ctx, request)
 }
+
+func (p *UnifiedProvisioner) Validate(ctx context.Context, request *resource.CreateRequest) ([]prov.ValidationError, error) {
+	return p.base.Validate(ctx, request)
+}
+
+func (p *UnifiedProvisioner) SupportsListDetailed() bool {
+	return p.base.SupportsListDetailed()
+}
+
+func (p *UnifiedProvisioner) ListDetailed(ctx context.Context, request *resource.ListRequest) (*ListDetailedResult, error) {
+	return p.base.ListDetailed(ctx, request)
+}
@@ -0,0 +1,140 @@
+package base
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// StatusBackoff spaces out the API calls made while polling a slow resource.
+// The engine re-polls Status at a fixed interval; polls arriving before the
+// next check is due are answered InProgress without calling the API. The gap
+// between real checks starts at Initial and doubles up to Max.
+//
+// Each gap is shortened by a random amount of up to statusJitter of it, so
+// resources created together spread their checks instead of calling the API
+// in step. Jitter only shortens a gap, so no check waits longer than Max.
+// StatusResult has no field to suggest a poll delay to the engine, which is
+// why the spacing is applied here.
+//
+// Polls are tracked per operation, keyed by request and native ID, so a new
+// operation on the same resource starts over at Initial. Entries are dropped
+// on a terminal result, and those the engine stopped polling expire after
+// statusPollExpiry.
+type StatusBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+
+	mu     sync.Mutex
+	polls  map[string]statusPoll
+	now    func() time.Time
+	jitter func(delay time.Duration) time.Duration
+}
+
+// statusJitter is the largest fraction of a gap that jitter removes
+const statusJitter = 0.2
+
+// statusPollExpiry is how long past its next check a poll is kept before it
+// is treated as abandoned
+const statusPollExpiry = time.Hour
+
+type statusPoll struct {
+	next  time.Time
+	delay time.Duration
+}
+
+// NewStatusBackoff creates a StatusBackoff with the given bounds
+func NewStatusBackoff(initial, max time.Duration) *StatusBackoff {
+	return &StatusBackoff{
+		Initial: initial,
+		Max:     max,
+		polls:   make(map[string]statusPoll),
+		now:     time.Now,
+		jitter:  randomJitter,
+	}
+}
+
+// randomJitter returns a random part of up to statusJitter of delay
+func randomJitter(delay time.Duration) time.Duration {
+	return time.Duration(rand.Float64() * statusJitter * float64(delay))
+}
+
+// Due reports whether the resource should be checked now. When it is not,
+// the result to return instead is non-nil.
+func (s *StatusBackoff) Due(request *resource.StatusRequest) (bool, *resource.StatusResult) {
+	if s == nil {
+		return true, nil
+	}
+	s.mu.Lock()
+	poll, ok := s.polls[pollKey(request)]
+	s.mu.Unlock()
+
+	if !ok {
+		return true, nil
+	}
+	wait := poll.next.Sub(s.now())
+	if wait <= 0 {
+		return true, nil
+	}
+	return false, &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusInProgress,
+			StatusMessage:   fmt.Sprintf("Resource is not yet ready, next check in %s", wait.Round(time.Second)),
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}
+}
+
+// Pending records a check that found the resource not ready and schedules
+// the next one.
+func (s *StatusBackoff) Pending(request *resource.StatusRequest) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.expire(now)
+
+	key := pollKey(request)
+	delay := s.Initial
+	if poll, ok := s.polls[key]; ok {
+		delay = poll.delay * 2
+	}
+	if s.Max > 0 && delay > s.Max {
+		delay = s.Max
+	}
+	// The undoubled delay is kept so jitter does not compound across checks
+	s.polls[key] = statusPoll{next: now.Add(delay - s.jitter(delay)), delay: delay}
+}
+
+// Done forgets an operation once the resource is ready or has failed.
+func (s *StatusBackoff) Done(request *resource.StatusRequest) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	delete(s.polls, pollKey(request))
+	s.mu.Unlock()
+}
+
+// expire drops polls the engine stopped making, such as those of cancelled
+// operations. Callers must hold s.mu.
+func (s *StatusBackoff) expire(now time.Time) {
+	for key, poll := range s.polls {
+		if now.Sub(poll.next) > statusPollExpiry {
+			delete(s.polls, key)
+		}
+	}
+}
+
+// pollKey identifies the operation a status request belongs to
+func pollKey(request *resource.StatusRequest) string {
+	return request.RequestID + "|" + request.NativeID
+}
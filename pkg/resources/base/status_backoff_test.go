@@ -0,0 +1,105 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package base
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusBackoff_DoublesUpToMax(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	backoff := NewStatusBackoff(10*time.Second, 30*time.Second)
+	backoff.now = func() time.Time { return now }
+	backoff.jitter = func(time.Duration) time.Duration { return 0 }
+	request := &resource.StatusRequest{NativeID: "project/db-1", RequestID: "req-1"}
+
+	due, _ := backoff.Due(request)
+	assert.True(t, due, "first check is always due")
+
+	for _, wait := range []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second} {
+		backoff.Pending(request)
+
+		now = now.Add(wait - time.Second)
+		due, result := backoff.Due(request)
+		assert.False(t, due, "check before %s", wait)
+		require.NotNil(t, result)
+		assert.Equal(t, resource.OperationStatusInProgress, result.ProgressResult.OperationStatus)
+		assert.Equal(t, "req-1", result.ProgressResult.RequestID)
+
+		now = now.Add(time.Second)
+		due, _ = backoff.Due(request)
+		assert.True(t, due, "check after %s", wait)
+	}
+}
+
+func TestStatusBackoff_DoneResets(t *testing.T) {
+	backoff := NewStatusBackoff(time.Minute, time.Minute)
+	request := &resource.StatusRequest{NativeID: "project/instance-1"}
+
+	backoff.Pending(request)
+	due, _ := backoff.Due(request)
+	assert.False(t, due)
+
+	backoff.Done(request)
+	due, _ = backoff.Due(request)
+	assert.True(t, due)
+}
+
+func TestStatusBackoff_NilIsAlwaysDue(t *testing.T) {
+	var backoff *StatusBackoff
+	request := &resource.StatusRequest{NativeID: "id"}
+	backoff.Pending(request)
+	due, result := backoff.Due(request)
+	assert.True(t, due)
+	assert.Nil(t, result)
+}
+
+func TestStatusBackoff_KeyedByRequest(t *testing.T) {
+	backoff := NewStatusBackoff(time.Minute, time.Minute)
+	create := &resource.StatusRequest{NativeID: "project/instance-1", RequestID: "create"}
+	update := &resource.StatusRequest{NativeID: "project/instance-1", RequestID: "update"}
+
+	backoff.Pending(create)
+	due, _ := backoff.Due(update)
+	assert.True(t, due, "a new operation on the same resource is not delayed")
+}
+
+func TestStatusBackoff_ExpiresAbandonedPolls(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	backoff := NewStatusBackoff(time.Minute, time.Minute)
+	backoff.now = func() time.Time { return now }
+
+	backoff.Pending(&resource.StatusRequest{NativeID: "project/db-1", RequestID: "cancelled"})
+	now = now.Add(time.Minute + statusPollExpiry + time.Second)
+	backoff.Pending(&resource.StatusRequest{NativeID: "project/db-2", RequestID: "req-2"})
+
+	assert.Len(t, backoff.polls, 1)
+}
+
+func TestStatusBackoff_JitterSpreadsChecks(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	backoff := NewStatusBackoff(10*time.Second, 30*time.Second)
+	backoff.now = func() time.Time { return now }
+
+	nexts := make(map[time.Time]bool)
+	for i := 0; i < 20; i++ {
+		request := &resource.StatusRequest{NativeID: "project/instance-1", RequestID: fmt.Sprintf("req-%d", i)}
+		backoff.Pending(request)
+		poll := backoff.polls[pollKey(request)]
+
+		assert.Equal(t, 10*time.Second, poll.delay, "jitter does not compound")
+		wait := poll.next.Sub(now)
+		assert.LessOrEqual(t, wait, 10*time.Second)
+		assert.GreaterOrEqual(t, wait, 8*time.Second)
+		nexts[poll.next] = true
+	}
+	assert.Greater(t, len(nexts), 1, "checks of resources created together are spread")
+}
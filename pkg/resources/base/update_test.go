@@ -0,0 +1,205 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPatchTestResource(client TransportClient, changedOnly bool) *BaseResource {
+	b := newListTestResource(client, nil)
+	b.ResourceConfig.SupportsUpdate = true
+	b.ResourceConfig.UpdateMethod = UpdateMethodPatch
+	b.ResourceConfig.PatchChangedOnly = changedOnly
+	return b
+}
+
+func updateBody(t *testing.T, client *fakeClient) map[string]interface{} {
+	t.Helper()
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "PATCH", client.requests[0].Method)
+	body, ok := client.requests[0].Body.(map[string]interface{})
+	require.True(t, ok)
+	return body
+}
+
+func TestUpdate_PatchChangedOnly(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newPatchTestResource(client, true)
+
+	result, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		PriorProperties:   json.RawMessage(`{"name": "web", "flavor": "b2-7", "labels": {"env": "dev"}}`),
+		DesiredProperties: json.RawMessage(`{"name": "web", "flavor": "b2-15", "labels": {"env": "dev"}, "monthly": true}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+
+	assert.Equal(t, map[string]interface{}{"flavor": "b2-15", "monthly": true}, updateBody(t, client))
+}
+
+func TestUpdate_PatchChangedOnly_NoPriorSendsFullBody(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newPatchTestResource(client, true)
+
+	_, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		DesiredProperties: json.RawMessage(`{"name": "web", "flavor": "b2-15"}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"name": "web", "flavor": "b2-15"}, updateBody(t, client))
+}
+
+func TestUpdate_PatchFullBodyByDefault(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newPatchTestResource(client, false)
+
+	_, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		PriorProperties:   json.RawMessage(`{"name": "web", "flavor": "b2-7"}`),
+		DesiredProperties: json.RawMessage(`{"name": "web", "flavor": "b2-15"}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"name": "web", "flavor": "b2-15"}, updateBody(t, client))
+}
+
+func TestFilterNilValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string]interface{}
+		want  map[string]interface{}
+	}{
+		{
+			name:  "strips nil values",
+			input: map[string]interface{}{"a": nil, "b": "x"},
+			want:  map[string]interface{}{"b": "x"},
+		},
+		{
+			name:  "keeps explicitly empty object",
+			input: map[string]interface{}{"x": map[string]interface{}{}},
+			want:  map[string]interface{}{"x": map[string]interface{}{}},
+		},
+		{
+			name:  "keeps explicitly empty array",
+			input: map[string]interface{}{"x": []interface{}{}},
+			want:  map[string]interface{}{"x": []interface{}{}},
+		},
+		{
+			name:  "drops object whose values were all nil",
+			input: map[string]interface{}{"x": map[string]interface{}{"a": nil}},
+			want:  map[string]interface{}{},
+		},
+		{
+			name:  "drops array whose items were all nil",
+			input: map[string]interface{}{"x": []interface{}{nil}},
+			want:  map[string]interface{}{},
+		},
+		{
+			name: "filters nested objects inside arrays",
+			input: map[string]interface{}{
+				"x": []interface{}{map[string]interface{}{"a": nil, "b": 1}},
+			},
+			want: map[string]interface{}{
+				"x": []interface{}{map[string]interface{}{"b": 1}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filterNilValues(tt.input))
+		})
+	}
+}
+
+func TestUpdate_KeepsEmptyObject(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newPatchTestResource(client, false)
+
+	_, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		DesiredProperties: json.RawMessage(`{"name": "web", "config": {}, "tags": []}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":   "web",
+		"config": map[string]interface{}{},
+		"tags":   []interface{}{},
+	}, updateBody(t, client))
+}
+
+func TestUpdate_UpdateActionStartsStatusPolling(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newListTestResource(client, nil)
+	b.ResourceConfig.SupportsUpdate = true
+	b.StatusChecker = func(map[string]interface{}) (bool, error) { return true, nil }
+
+	var gotURL string
+	var gotPrior map[string]interface{}
+	b.UpdateAction = func(desired, prior map[string]interface{}, resourceURL string, ctx TransformContext) (bool, error) {
+		gotURL, gotPrior = resourceURL, prior
+		return true, nil
+	}
+
+	result, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		PriorProperties:   json.RawMessage(`{"rescue": false}`),
+		DesiredProperties: json.RawMessage(`{"rescue": true}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "/cloud/project/my-project/instance/inst-1", gotURL)
+	assert.Equal(t, map[string]interface{}{"rescue": false}, gotPrior)
+}
+
+func TestUpdate_UpdateActionError(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newListTestResource(client, nil)
+	b.ResourceConfig.SupportsUpdate = true
+	b.UpdateAction = func(desired, prior map[string]interface{}, resourceURL string, ctx TransformContext) (bool, error) {
+		return false, &ovhtransport.Error{Code: ovhtransport.ErrorCodeResourceNotFound, Message: "instance not found"}
+	}
+
+	result, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		DesiredProperties: json.RawMessage(`{"rescue": true}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+	assert.Equal(t, resource.OperationErrorCodeNotFound, result.ProgressResult.ErrorCode)
+	assert.Equal(t, "partially updated: applied properties; update action failed: instance not found", result.ProgressResult.StatusMessage)
+}
+
+func TestUpdate_UpdateActionPartialFailure(t *testing.T) {
+	client := &fakeClient{response: &ovhtransport.Response{StatusCode: 200}}
+	b := newListTestResource(client, nil)
+	b.ResourceConfig.SupportsUpdate = true
+	b.UpdateAction = func(desired, prior map[string]interface{}, resourceURL string, ctx TransformContext) (bool, error) {
+		return false, &PartialUpdateError{
+			Applied: []string{"networks"},
+			Failed:  "rescue",
+			Err:     &ovhtransport.Error{Code: ovhtransport.ErrorCodeInvalidInput, Message: "image not found"},
+		}
+	}
+
+	result, err := b.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "my-project/inst-1",
+		DesiredProperties: json.RawMessage(`{"rescue": true}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+	assert.Equal(t, resource.OperationErrorCodeInvalidRequest, result.ProgressResult.ErrorCode)
+	assert.Equal(t, "partially updated: applied properties, networks; rescue failed: image not found", result.ProgressResult.StatusMessage)
+}
@@ -0,0 +1,61 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// Validate runs the checks Create would run before calling the API - property
+// parsing, path context and the request transformer - then the ReferenceCheck.
+// Nothing is created, and quota is not checked.
+func (b *BaseResource) Validate(ctx context.Context, request *resource.CreateRequest) ([]prov.ValidationError, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return []prov.ValidationError{{Message: fmt.Sprintf("failed to parse properties: %v", err)}}, nil
+	}
+
+	pathCtx := b.buildPathContext(request.TargetConfig, props)
+
+	var errs []prov.ValidationError
+	if pathCtx.Project == "" {
+		errs = append(errs, prov.ValidationError{
+			Property: "serviceName",
+			Message:  "project/serviceName is required but not found in target config or properties",
+		})
+	}
+	if b.ResourceConfig.ParentResource != nil && b.ResourceConfig.ParentResource.RequiresParent && pathCtx.ParentResource == "" {
+		errs = append(errs, prov.ValidationError{
+			Property: b.ResourceConfig.ParentResource.PropertyName,
+			Message:  "parent resource ID is empty or not a valid ID",
+		})
+	}
+	if b.missingRegion(pathCtx) {
+		errs = append(errs, prov.ValidationError{
+			Property: "region",
+			Message:  errMissingRegion.Error(),
+		})
+	}
+	if len(errs) > 0 {
+		return errs, nil
+	}
+
+	transformCtx := b.buildTransformContext(ctx, pathCtx, resource.OperationCreate)
+	if b.RequestTransformer != nil {
+		if _, err := b.RequestTransformer.Transform(props, transformCtx); err != nil {
+			errs = append(errs, prov.ValidationError{Message: err.Error()})
+		}
+	}
+
+	if b.ReferenceCheck != nil {
+		refErrs, err := b.ReferenceCheck(props, transformCtx)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, refErrs...)
+	}
+	return errs, nil
+}
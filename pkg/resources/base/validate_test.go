@@ -0,0 +1,63 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	client := &fakeClient{}
+	b := newListTestResource(client, nil)
+	b.RequestTransformer = RequestTransformerFunc(func(props map[string]interface{}, ctx TransformContext) (map[string]interface{}, error) {
+		if props["name"] == "" {
+			return nil, fmt.Errorf("name must not be empty")
+		}
+		return props, nil
+	})
+	b.ReferenceCheck = func(props map[string]interface{}, ctx TransformContext) ([]prov.ValidationError, error) {
+		if props["flavorId"] == "missing" {
+			return []prov.ValidationError{{Property: "flavorId", Message: "does not exist"}}, nil
+		}
+		return nil, nil
+	}
+
+	validate := func(props string) []prov.ValidationError {
+		errs, err := b.Validate(context.Background(), &resource.CreateRequest{
+			Properties:   json.RawMessage(props),
+			TargetConfig: json.RawMessage(`{"serviceName": "my-project"}`),
+		})
+		require.NoError(t, err)
+		return errs
+	}
+
+	assert.Empty(t, validate(`{"name": "web", "flavorId": "b2-7"}`))
+	assert.Equal(t, []prov.ValidationError{
+		{Message: "name must not be empty"},
+		{Property: "flavorId", Message: "does not exist"},
+	}, validate(`{"name": "", "flavorId": "missing"}`))
+
+	// Validation never calls the create endpoint
+	assert.Empty(t, client.requests)
+}
+
+func TestValidate_MissingProject(t *testing.T) {
+	b := newListTestResource(&fakeClient{}, nil)
+
+	errs, err := b.Validate(context.Background(), &resource.CreateRequest{
+		Properties: json.RawMessage(`{"name": "web"}`),
+	})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "serviceName", errs[0].Property)
+}
@@ -0,0 +1,159 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package ai
+
+import (
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// Resource type constants for OVH AI Training resources.
+const (
+	NotebookResourceType = "OVH::AI::Notebook"
+	JobResourceType      = "OVH::AI::Job"
+)
+
+var cloudAIRegistry *base.ResourceRegistry
+
+// aiState returns status.state from an AI notebook or job response.
+func aiState(resourceData map[string]interface{}) string {
+	status, _ := resourceData["status"].(map[string]interface{})
+	state, _ := status["state"].(string)
+	return state
+}
+
+// notebookStatusChecker waits for the notebook to reach RUNNING.
+// Notebooks go through STARTING -> RUNNING (or FAILED/ERROR).
+func notebookStatusChecker(resourceData map[string]interface{}) (bool, error) {
+	switch state := aiState(resourceData); state {
+	case "RUNNING":
+		return true, nil
+	case "FAILED", "ERROR":
+		return false, fmt.Errorf("notebook is in %s state", state)
+	default:
+		return false, nil
+	}
+}
+
+// jobStatusChecker waits for the job to start. A job that already finished
+// successfully (DONE) is also considered ready.
+// Jobs go through QUEUED -> INITIALIZING -> PENDING -> RUNNING -> DONE.
+func jobStatusChecker(resourceData map[string]interface{}) (bool, error) {
+	switch state := aiState(resourceData); state {
+	case "RUNNING", "DONE":
+		return true, nil
+	case "FAILED", "ERROR", "INTERRUPTED", "TIMEOUT":
+		return false, fmt.Errorf("job is in %s state", state)
+	default:
+		return false, nil
+	}
+}
+
+// aiResponseTransformer flattens the spec/status envelope of AI responses.
+// Spec fields are returned at the top level so they compare with the desired
+// properties; state and url (the notebook or job access URL) are surfaced too.
+var aiResponseTransformer = base.ResponseTransformerFunc(func(apiResponse map[string]interface{}, ctx base.TransformContext) map[string]interface{} {
+	result := make(map[string]interface{})
+	if spec, ok := apiResponse["spec"].(map[string]interface{}); ok {
+		for k, v := range spec {
+			result[k] = v
+		}
+	}
+
+	for _, field := range []string{"id", "createdAt", "updatedAt", "user"} {
+		if v, ok := apiResponse[field]; ok {
+			result[field] = v
+		}
+	}
+
+	if status, ok := apiResponse["status"].(map[string]interface{}); ok {
+		if state, ok := status["state"]; ok {
+			result["state"] = state
+		}
+		if url, ok := status["url"]; ok {
+			result["url"] = url
+		}
+	}
+
+	return result
+})
+
+// aiRequestTransformer removes the project (it's in the URL) and the output
+// fields added by aiResponseTransformer, which the API rejects in a spec.
+var aiRequestTransformer = base.RequestTransformerFunc(func(props map[string]interface{}, ctx base.TransformContext) (map[string]interface{}, error) {
+	body := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		switch k {
+		case "serviceName", "id", "createdAt", "updatedAt", "user", "state", "url":
+			continue
+		}
+		body[k] = v
+	}
+	return body, nil
+})
+
+func init() {
+	cloudAIRegistry = base.NewResourceRegistry(cloud.CloudAPI, cloud.CloudOperations, cloud.CloudNativeID)
+
+	err := cloudAIRegistry.RegisterAll([]base.ResourceDefinition{
+		// Notebook (OVH AI Notebooks)
+		// List:   GET /cloud/project/{serviceName}/ai/notebook
+		// Create: POST /cloud/project/{serviceName}/ai/notebook
+		// Read:   GET /cloud/project/{serviceName}/ai/notebook/{notebookId}
+		// Update: PUT /cloud/project/{serviceName}/ai/notebook/{notebookId}
+		// Delete: DELETE /cloud/project/{serviceName}/ai/notebook/{notebookId}
+		{
+			ResourceType: NotebookResourceType,
+			ResourceConfig: base.ResourceConfig{
+				ResourceType:   "ai/notebook",
+				Scope:          &base.ScopeConfig{Type: base.ScopeProject},
+				SupportsUpdate: true,
+				UpdateMethod:   base.UpdateMethodPut,
+			},
+			RequestTransformer:  aiRequestTransformer,
+			ResponseTransformer: aiResponseTransformer,
+			StatusChecker:       notebookStatusChecker,
+			Operations: []resource.Operation{
+				resource.OperationCreate,
+				resource.OperationRead,
+				resource.OperationUpdate,
+				resource.OperationDelete,
+				resource.OperationList,
+				resource.OperationCheckStatus,
+			},
+		},
+		// Job (OVH AI Training)
+		// List:   GET /cloud/project/{serviceName}/ai/job
+		// Create: POST /cloud/project/{serviceName}/ai/job
+		// Read:   GET /cloud/project/{serviceName}/ai/job/{jobId}
+		// Delete: DELETE /cloud/project/{serviceName}/ai/job/{jobId}
+		// No Update support - jobs are immutable once submitted
+		{
+			ResourceType: JobResourceType,
+			ResourceConfig: base.ResourceConfig{
+				ResourceType:   "ai/job",
+				Scope:          &base.ScopeConfig{Type: base.ScopeProject},
+				SupportsUpdate: false,
+			},
+			RequestTransformer:  aiRequestTransformer,
+			ResponseTransformer: aiResponseTransformer,
+			StatusChecker:       jobStatusChecker,
+			Operations: []resource.Operation{
+				resource.OperationCreate,
+				resource.OperationRead,
+				resource.OperationDelete,
+				resource.OperationList,
+				resource.OperationCheckStatus,
+			},
+		},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,74 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package ai
+
+import (
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withState(state string) map[string]interface{} {
+	return map[string]interface{}{"status": map[string]interface{}{"state": state}}
+}
+
+func TestNotebookStatusChecker(t *testing.T) {
+	ready, err := notebookStatusChecker(withState("STARTING"))
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	ready, err = notebookStatusChecker(withState("RUNNING"))
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	_, err = notebookStatusChecker(withState("FAILED"))
+	assert.Error(t, err)
+}
+
+func TestJobStatusChecker(t *testing.T) {
+	ready, err := jobStatusChecker(withState("QUEUED"))
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	ready, err = jobStatusChecker(withState("DONE"))
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	_, err = jobStatusChecker(withState("TIMEOUT"))
+	assert.Error(t, err)
+}
+
+func TestAIResponseTransformer(t *testing.T) {
+	result := aiResponseTransformer(map[string]interface{}{
+		"id":   "nb-1",
+		"spec": map[string]interface{}{"name": "lab", "region": "GRA"},
+		"status": map[string]interface{}{
+			"state": "RUNNING",
+			"url":   "https://nb-1.notebook.gra.ai.cloud.ovh.net",
+			"info":  map[string]interface{}{"code": "OK"},
+		},
+	}, base.TransformContext{})
+
+	assert.Equal(t, map[string]interface{}{
+		"id":     "nb-1",
+		"name":   "lab",
+		"region": "GRA",
+		"state":  "RUNNING",
+		"url":    "https://nb-1.notebook.gra.ai.cloud.ovh.net",
+	}, result)
+}
+
+func TestAIRequestTransformer(t *testing.T) {
+	body, err := aiRequestTransformer(map[string]interface{}{
+		"serviceName": "project",
+		"name":        "lab",
+		"state":       "RUNNING",
+		"url":         "https://example",
+	}, base.TransformContext{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "lab"}, body)
+}
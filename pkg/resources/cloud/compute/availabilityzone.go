@@ -0,0 +1,249 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// AvailabilityZoneDataResourceType is the read-only resource listing the availability zones of a region.
+const AvailabilityZoneDataResourceType = "OVH::Compute::AvailabilityZoneData"
+
+// Availability zones are reported by the region itself:
+// - Create: GET /cloud/project/{serviceName}/region/{regionName}
+// - Read:   GET /cloud/project/{serviceName}/region/{regionName}
+// - List:   not supported, lookups are never discovered
+// Compute and block storage share the region's zones. Like the catalog data
+// resources, nothing is created and Delete only forgets the lookup.
+
+// availabilityZoneProvisioner reads the availability zones of a region.
+type availabilityZoneProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &availabilityZoneProvisioner{}
+
+func (p *availabilityZoneProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project := extractProject(request.TargetConfig)
+	if serviceName, ok := props["serviceName"].(string); ok && serviceName != "" {
+		project = serviceName
+	}
+	if project == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "serviceName is required"), nil
+	}
+
+	region, _ := props["region"].(string)
+	if region == "" {
+		region = extractRegion(request.TargetConfig)
+	}
+	if region == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "region is required"), nil
+	}
+
+	zones, err := fetchAvailabilityZones(ctx, p.client, project, region)
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return dataCreateFailure(ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message), nil
+		}
+		return dataCreateFailure(resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	propsJSON, _ := json.Marshal(availabilityZoneProperties(region, zones))
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           fmt.Sprintf("%s/%s", project, region),
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *availabilityZoneProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	project, region, err := parseDataNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	zones, err := fetchAvailabilityZones(ctx, p.client, project, region)
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+
+	propsJSON, _ := json.Marshal(availabilityZoneProperties(region, zones))
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+// Update is not supported: change region to look up a different one.
+func (p *availabilityZoneProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       resource.OperationErrorCodeNotUpdatable,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Delete succeeds without calling the API.
+func (p *availabilityZoneProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// List is not supported: lookups are declared in a stack, not discovered.
+func (p *availabilityZoneProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	return &resource.ListResult{}, nil
+}
+
+// Status returns success immediately (lookups are synchronous).
+func (p *availabilityZoneProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// availabilityZoneProperties builds the properties of a lookup
+func availabilityZoneProperties(region string, zones []string) map[string]interface{} {
+	return map[string]interface{}{
+		"region":            region,
+		"availabilityZones": zones,
+	}
+}
+
+// fetchAvailabilityZones returns the availability zones of region. Regions
+// with a single zone report none.
+func fetchAvailabilityZones(ctx context.Context, client base.TransportClient, project, region string) ([]string, error) {
+	response, err := client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/region/%s", project, region),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := response.Body["availabilityZones"].([]interface{})
+	zones := make([]string, 0, len(items))
+	for _, item := range items {
+		if zone, ok := item.(string); ok && zone != "" {
+			zones = append(zones, zone)
+		}
+	}
+	return zones, nil
+}
+
+// hostPattern matches a compute host or hypervisor node name
+var hostPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// parseAvailabilityZone splits an availabilityZone into its zone, and the
+// host and node of a dedicated host when it targets one. Nova accepts
+// "zone", "zone:host", "zone:host:node" and "zone::node".
+func parseAvailabilityZone(value string) (zone, host, node string, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return "", "", "", fmt.Errorf("availabilityZone %q has too many parts; use zone, zone:host or zone:host:node", value)
+	}
+	zone = parts[0]
+	if zone == "" {
+		return "", "", "", fmt.Errorf("availabilityZone %q has no zone; use zone, zone:host or zone:host:node", value)
+	}
+	if len(parts) > 1 {
+		host = parts[1]
+	}
+	if len(parts) > 2 {
+		node = parts[2]
+		if node == "" {
+			return "", "", "", fmt.Errorf("availabilityZone %q has an empty node; drop the trailing colon or name the node", value)
+		}
+	}
+	if len(parts) == 2 && host == "" {
+		return "", "", "", fmt.Errorf("availabilityZone %q has an empty host; drop the trailing colon or name the host", value)
+	}
+	for _, name := range []string{host, node} {
+		if name != "" && !hostPattern.MatchString(name) {
+			return "", "", "", fmt.Errorf("availabilityZone %q: %q is not a valid host name", value, name)
+		}
+	}
+	return zone, host, node, nil
+}
+
+// validateAvailabilityZone checks the zone:host:node syntax of
+// availabilityZone and rejects a zone the region does not offer, listing the
+// valid zones, instead of failing late with "no valid host". Like the quota
+// checks, it lets the API decide when the zones cannot be fetched or the
+// region reports none. Hosts are left to Nova: listing hypervisors needs an
+// admin role the OVH API does not grant.
+func validateAvailabilityZone(props map[string]interface{}, ctx base.TransformContext) error {
+	value, _ := props["availabilityZone"].(string)
+	if value == "" {
+		return nil
+	}
+	zone, _, _, err := parseAvailabilityZone(value)
+	if err != nil {
+		return err
+	}
+
+	region, _ := props["region"].(string)
+	if region == "" || ctx.Client == nil {
+		return nil
+	}
+
+	zones, err := fetchAvailabilityZones(ctx.Ctx, ctx.Client, ctx.Project, region)
+	if err != nil || len(zones) == 0 {
+		return nil
+	}
+	for _, z := range zones {
+		if z == zone {
+			return nil
+		}
+	}
+	return fmt.Errorf("availabilityZone %q is not in region %s; valid zones: %s", zone, region, strings.Join(zones, ", "))
+}
+
+func init() {
+	registry.Register(AvailabilityZoneDataResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &availabilityZoneProvisioner{client: client}
+		},
+	)
+}
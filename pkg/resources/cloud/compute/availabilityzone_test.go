@@ -0,0 +1,73 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAvailabilityZone(t *testing.T) {
+	client := &fakeQuotaClient{responses: map[string]map[string]interface{}{
+		"/cloud/project/p1/region/EU-WEST-PAR": {
+			"name":              "EU-WEST-PAR",
+			"availabilityZones": []interface{}{"eu-west-par-a", "eu-west-par-b", "eu-west-par-c"},
+		},
+		"/cloud/project/p1/region/GRA11": {"name": "GRA11"},
+	}}
+	ctx := quotaContext(client)
+
+	assert.NoError(t, validateAvailabilityZone(map[string]interface{}{"region": "EU-WEST-PAR", "availabilityZone": "eu-west-par-b"}, ctx))
+	assert.NoError(t, validateAvailabilityZone(map[string]interface{}{"region": "EU-WEST-PAR"}, ctx))
+
+	err := validateAvailabilityZone(map[string]interface{}{"region": "EU-WEST-PAR", "availabilityZone": "eu-west-par-1"}, ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "valid zones: eu-west-par-a, eu-west-par-b, eu-west-par-c")
+
+	// Regions without zones, and lookups that fail, are left to the API
+	assert.NoError(t, validateAvailabilityZone(map[string]interface{}{"region": "GRA11", "availabilityZone": "gra-a"}, ctx))
+	assert.NoError(t, validateAvailabilityZone(map[string]interface{}{"region": "BHS5", "availabilityZone": "bhs-a"}, ctx))
+
+	// A dedicated host is checked by its zone
+	assert.NoError(t, validateAvailabilityZone(map[string]interface{}{"region": "EU-WEST-PAR", "availabilityZone": "eu-west-par-a:host-12"}, ctx))
+	err = validateAvailabilityZone(map[string]interface{}{"region": "EU-WEST-PAR", "availabilityZone": "eu-west-par-1:host-12"}, ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `availabilityZone "eu-west-par-1" is not in region`)
+
+	// Syntax errors are reported even when the region has no zones
+	err = validateAvailabilityZone(map[string]interface{}{"region": "GRA11", "availabilityZone": "nova:"}, ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "empty host")
+}
+
+func TestParseAvailabilityZone(t *testing.T) {
+	tests := []struct {
+		value            string
+		zone, host, node string
+	}{
+		{value: "nova", zone: "nova"},
+		{value: "nova:compute-12", zone: "nova", host: "compute-12"},
+		{value: "nova:compute-12:node-1.example", zone: "nova", host: "compute-12", node: "node-1.example"},
+		{value: "nova::node-1", zone: "nova", node: "node-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			zone, host, node, err := parseAvailabilityZone(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.zone, zone)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.node, node)
+		})
+	}
+
+	for _, value := range []string{":compute-12", "nova:", "nova:compute-12:", "nova:a:b:c", "nova:compute 12"} {
+		t.Run(value, func(t *testing.T) {
+			_, _, _, err := parseAvailabilityZone(value)
+			assert.Error(t, err)
+		})
+	}
+}
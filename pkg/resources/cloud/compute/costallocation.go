@@ -0,0 +1,62 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// CostAllocationKeys are the instance metadata keys OVH billing exports
+// group costs by
+var CostAllocationKeys = []string{"cost-center", "team", "environment"}
+
+// MetadataPolicy is the metadata a target applies to all its instances
+type MetadataPolicy struct {
+	// Defaults are merged under the metadata each instance declares
+	Defaults map[string]string
+	// Required keys must be set on every instance once Defaults are merged
+	Required []string
+}
+
+// apply merges the defaults into spec and checks the required and
+// cost-allocation keys. An instance that declares no metadata gets the
+// defaults alone.
+func (p MetadataPolicy) apply(spec *serverSpec) error {
+	if len(p.Defaults) > 0 {
+		merged := maps.Clone(p.Defaults)
+		maps.Copy(merged, spec.metadata)
+		spec.metadata = merged
+	}
+
+	for _, key := range p.Required {
+		if spec.metadata[key] == "" {
+			return fmt.Errorf("metadata %q is required by the target's RequiredInstanceMetadata", key)
+		}
+	}
+	for _, key := range CostAllocationKeys {
+		value, ok := spec.metadata[key]
+		if ok && (value == "" || strings.TrimSpace(value) != value) {
+			return fmt.Errorf("cost-allocation metadata %q must be non-empty without leading or trailing spaces, got %q", key, value)
+		}
+	}
+	return nil
+}
+
+// strip removes the keys still holding their default value, so instances
+// do not drift from a desired state that leaves them to the target
+func (p MetadataPolicy) strip(metadata map[string]string) map[string]string {
+	if len(p.Defaults) == 0 || metadata == nil {
+		return metadata
+	}
+	stripped := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if def, ok := p.Defaults[key]; !ok || def != value {
+			stripped[key] = value
+		}
+	}
+	return stripped
+}
@@ -0,0 +1,66 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataPolicy_Apply(t *testing.T) {
+	policy := MetadataPolicy{
+		Defaults: map[string]string{"team": "infra", "environment": "prod"},
+		Required: []string{"cost-center"},
+	}
+
+	spec := serverSpec{metadata: map[string]string{"cost-center": "cc-42", "environment": "dev"}}
+	require.NoError(t, policy.apply(&spec))
+	assert.Equal(t, map[string]string{"cost-center": "cc-42", "team": "infra", "environment": "dev"}, spec.metadata)
+
+	spec = serverSpec{}
+	err := policy.apply(&spec)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cost-center")
+
+	spec = serverSpec{metadata: map[string]string{"cost-center": " cc-42"}}
+	assert.Error(t, policy.apply(&spec))
+}
+
+func TestMetadataPolicy_Strip(t *testing.T) {
+	policy := MetadataPolicy{Defaults: map[string]string{"team": "infra", "environment": "prod"}}
+
+	got := policy.strip(map[string]string{"team": "infra", "environment": "dev", "role": "web"})
+	assert.Equal(t, map[string]string{"environment": "dev", "role": "web"}, got)
+	assert.Nil(t, MetadataPolicy{}.strip(nil))
+}
+
+func TestServerProvisioner_AppliesTargetDefaults(t *testing.T) {
+	nova, openStack := newFakeNova(t)
+	inner := &fakeInstance{created: &resource.ProgressResult{
+		Operation:          resource.OperationCreate,
+		OperationStatus:    resource.OperationStatusSuccess,
+		NativeID:           "p1/srv-defaults",
+		ResourceProperties: json.RawMessage(`{"name":"web","region":"GRA11"}`),
+	}}
+	policy := MetadataPolicy{Defaults: map[string]string{"team": "infra"}, Required: []string{"team"}}
+	p := WithServerProperties(inner, openStack, policy)
+
+	// No metadata declared: the instance still gets the defaults
+	result, err := p.Create(context.Background(), &resource.CreateRequest{
+		Properties: instanceProps(t, map[string]interface{}{"name": "web", "region": "GRA11"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, map[string]string{"team": "infra"}, nova.metadata["srv-defaults"])
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(result.ProgressResult.ResourceProperties, &got))
+	assert.NotContains(t, got, "metadata", "defaults are not part of the instance's own properties")
+}
@@ -0,0 +1,240 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// Read-only data resources resolving catalog entries to their region-specific IDs.
+const (
+	FlavorDataResourceType = "OVH::Compute::FlavorData"
+	ImageDataResourceType  = "OVH::Compute::ImageData"
+)
+
+// Data resources look up an existing flavor or image by name and region:
+// - Create: GET /cloud/project/{serviceName}/{flavor|image}?region={region}, matched by name
+// - Read:   GET /cloud/project/{serviceName}/{flavor|image}/{id}
+// - List:   not supported, lookups are never discovered
+// Nothing is created in OVH, so Delete only forgets the lookup and Update is not supported.
+
+// dataProvisioner resolves catalog entries from a project collection.
+type dataProvisioner struct {
+	client     *ovhtransport.Client
+	collection string // "flavor" or "image"
+}
+
+var _ prov.Provisioner = &dataProvisioner{}
+
+func (p *dataProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project := extractProject(request.TargetConfig)
+	if serviceName, ok := props["serviceName"].(string); ok && serviceName != "" {
+		project = serviceName
+	}
+	if project == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "serviceName is required"), nil
+	}
+
+	name, _ := props["name"].(string)
+	if name == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "name is required"), nil
+	}
+
+	region, _ := props["region"].(string)
+	if region == "" {
+		region = extractRegion(request.TargetConfig)
+	}
+	if region == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "region is required"), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/%s?region=%s", project, p.collection, url.QueryEscape(region)),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return dataCreateFailure(ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message), nil
+		}
+		return dataCreateFailure(resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	match, err := matchCatalogEntry(response.BodyArray, name, region)
+	if err != nil {
+		return dataCreateFailure(resource.OperationErrorCodeNotFound, fmt.Sprintf("%s: %v", p.collection, err)), nil
+	}
+
+	id, _ := match["id"].(string)
+	propsJSON, _ := json.Marshal(match)
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           fmt.Sprintf("%s/%s", project, id),
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *dataProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	project, id, err := parseDataNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/%s/%s", project, p.collection, id),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+
+	propsJSON, _ := json.Marshal(response.Body)
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+// Update is not supported: change name or region to resolve a different entry.
+func (p *dataProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       resource.OperationErrorCodeNotUpdatable,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Delete succeeds without calling the API; the flavor or image is not owned by the stack.
+func (p *dataProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// List is not supported: data resources are lookups declared in a stack, and
+// discovering them would import the whole flavor or image catalog
+func (p *dataProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	return &resource.ListResult{}, nil
+}
+
+// Status returns success immediately (lookups are synchronous).
+func (p *dataProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// matchCatalogEntry finds the single entry named name in region.
+// Names are compared case-insensitively; several matches are an error so a
+// stack never silently picks a different flavor or image.
+func matchCatalogEntry(items []interface{}, name, region string) (map[string]interface{}, error) {
+	var matches []map[string]interface{}
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entryName, _ := entry["name"].(string)
+		entryRegion, _ := entry["region"].(string)
+		if strings.EqualFold(entryName, name) && (entryRegion == "" || entryRegion == region) {
+			matches = append(matches, entry)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no entry named %q in region %s", name, region)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d entries named %q in region %s", len(matches), name, region)
+	}
+}
+
+// extractRegion reads the default region from the target config.
+func extractRegion(targetConfig json.RawMessage) string {
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(targetConfig, &cfg); err != nil {
+		return ""
+	}
+
+	for _, field := range []string{"Region", "region"} {
+		if val, ok := cfg[field].(string); ok && val != "" {
+			return val
+		}
+	}
+	return ""
+}
+
+// parseDataNativeID parses "project/id" format
+func parseDataNativeID(nativeID string) (project, id string, err error) {
+	parts := strings.SplitN(nativeID, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], nil
+}
+
+func dataCreateFailure(errorCode resource.OperationErrorCode, message string) *resource.CreateResult {
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+		},
+	}
+}
+
+func init() {
+	operations := []resource.Operation{
+		resource.OperationCreate,
+		resource.OperationRead,
+		resource.OperationDelete,
+	}
+
+	registry.Register(FlavorDataResourceType, operations,
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &dataProvisioner{client: client, collection: "flavor"}
+		},
+	)
+	registry.Register(ImageDataResourceType, operations,
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &dataProvisioner{client: client, collection: "image"}
+		},
+	)
+}
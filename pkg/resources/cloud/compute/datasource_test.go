@@ -0,0 +1,55 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchCatalogEntry(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"id": "f-gra-b2-7", "name": "b2-7", "region": "GRA11"},
+		map[string]interface{}{"id": "f-de-b2-7", "name": "b2-7", "region": "DE1"},
+		map[string]interface{}{"id": "f-gra-b2-15", "name": "b2-15", "region": "GRA11"},
+		"not-an-object",
+	}
+
+	match, err := matchCatalogEntry(items, "B2-7", "DE1")
+	require.NoError(t, err)
+	assert.Equal(t, "f-de-b2-7", match["id"])
+
+	_, err = matchCatalogEntry(items, "b2-30", "GRA11")
+	assert.Error(t, err)
+}
+
+func TestMatchCatalogEntry_Ambiguous(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"id": "img-1", "name": "Ubuntu 24.04", "region": "GRA11"},
+		map[string]interface{}{"id": "img-2", "name": "Ubuntu 24.04", "region": "GRA11"},
+	}
+
+	_, err := matchCatalogEntry(items, "Ubuntu 24.04", "GRA11")
+	assert.Error(t, err)
+}
+
+func TestExtractRegion(t *testing.T) {
+	assert.Equal(t, "GRA11", extractRegion(json.RawMessage(`{"Region": "GRA11"}`)))
+	assert.Equal(t, "", extractRegion(json.RawMessage(`{}`)))
+}
+
+func TestDataProvisionerList_Empty(t *testing.T) {
+	p := &dataProvisioner{collection: "flavor"}
+	result, err := p.List(context.Background(), &resource.ListRequest{
+		TargetConfig: json.RawMessage(`{"ServiceName": "p1", "Region": "GRA11"}`),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.NativeIDs)
+}
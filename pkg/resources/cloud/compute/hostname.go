@@ -0,0 +1,68 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// cloudConfigHeader starts a cloud-init cloud-config document
+const cloudConfigHeader = "#cloud-config"
+
+// hostnameLabel is one RFC 1123 DNS label
+var hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// cloudConfigHostnameKey matches a top-level hostname or fqdn key
+var cloudConfigHostnameKey = regexp.MustCompile(`(?m)^(hostname|fqdn)\s*:`)
+
+// withHostname returns userData with cloud-config directives that set the
+// guest hostname. The OVH instance API has no hostname field and Nova derives
+// one from the instance name, so the hostname is applied by cloud-init on
+// first boot. A dotted hostname sets the FQDN, and its first label the short
+// hostname. userData must be empty or a cloud-config document that does not
+// set the hostname itself.
+func withHostname(userData, hostname string) (string, error) {
+	if err := validateHostname(hostname); err != nil {
+		return "", err
+	}
+
+	directives := "hostname: " + hostname + "\n"
+	if short, _, dotted := strings.Cut(hostname, "."); dotted {
+		directives = "hostname: " + short + "\nfqdn: " + hostname + "\nprefer_fqdn_over_hostname: true\n"
+	}
+
+	if userData == "" {
+		return cloudConfigHeader + "\n" + directives, nil
+	}
+	header, rest, _ := strings.Cut(userData, "\n")
+	if strings.TrimSpace(header) != cloudConfigHeader {
+		return "", fmt.Errorf("hostname needs userData to be empty or a %s document", cloudConfigHeader)
+	}
+	if cloudConfigHostnameKey.MatchString(rest) {
+		return "", fmt.Errorf("hostname conflicts with the hostname or fqdn set in userData")
+	}
+
+	merged := header + "\n" + directives + rest
+	if size := base64.StdEncoding.EncodedLen(len(merged)); size > maxUserDataSize {
+		return "", fmt.Errorf("user data is %d bytes once base64-encoded with the hostname, over the %d byte OpenStack limit", size, maxUserDataSize)
+	}
+	return merged, nil
+}
+
+// validateHostname checks hostname is a valid DNS name
+func validateHostname(hostname string) error {
+	if len(hostname) > 253 {
+		return fmt.Errorf("hostname %q is longer than 253 characters", hostname)
+	}
+	for _, label := range strings.Split(hostname, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return fmt.Errorf("hostname %q is not a valid DNS name: labels are 1-63 letters, digits or hyphens, not starting or ending with a hyphen", hostname)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithHostname(t *testing.T) {
+	tests := []struct {
+		name     string
+		userData string
+		hostname string
+		want     string
+	}{
+		{
+			name:     "no user data",
+			hostname: "web-01",
+			want:     "#cloud-config\nhostname: web-01\n",
+		},
+		{
+			name:     "fqdn",
+			hostname: "web-01.prod.example.com",
+			want:     "#cloud-config\nhostname: web-01\nfqdn: web-01.prod.example.com\nprefer_fqdn_over_hostname: true\n",
+		},
+		{
+			name:     "cloud-config",
+			userData: cloudConfig,
+			hostname: "web-01",
+			want:     "#cloud-config\nhostname: web-01\npackages:\n  - nginx\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withHostname(tt.userData, tt.hostname)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithHostname_Rejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		userData string
+		hostname string
+		want     string
+	}{
+		{name: "underscore", hostname: "web_01", want: "not a valid DNS name"},
+		{name: "leading hyphen", hostname: "-web", want: "not a valid DNS name"},
+		{name: "empty label", hostname: "web..example.com", want: "not a valid DNS name"},
+		{name: "shell script", userData: "#!/bin/sh\necho hi\n", hostname: "web-01", want: "empty or a #cloud-config document"},
+		{name: "hostname in user data", userData: "#cloud-config\nhostname: other\n", hostname: "web-01", want: "conflicts"},
+		{name: "fqdn in user data", userData: "#cloud-config\nfqdn: other.example.com\n", hostname: "web-01", want: "conflicts"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := withHostname(tt.userData, tt.hostname)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestInstanceRequestTransformer_Hostname(t *testing.T) {
+	props := map[string]interface{}{"name": "frontend", "imageId": "img-1", "hostname": "web-01"}
+
+	body, err := instanceRequestTransformer.Transform(props, base.TransformContext{Operation: resource.OperationCreate})
+	require.NoError(t, err)
+	assert.Equal(t, "frontend", body["name"])
+	assert.Equal(t, "#cloud-config\nhostname: web-01\n", body["userData"])
+	assert.NotContains(t, body, "hostname")
+
+	// The hostname is create-only and never reaches an update body
+	body, err = instanceRequestTransformer.Transform(props, base.TransformContext{Operation: resource.OperationUpdate})
+	require.NoError(t, err)
+	assert.NotContains(t, body, "hostname")
+	assert.NotContains(t, body, "userData")
+}
@@ -0,0 +1,202 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// Interface attach and detach are asynchronous on the OpenStack side; the
+// interface list is polled until the change shows up.
+var (
+	interfacePollInterval = 2 * time.Second
+	interfacePollTimeout  = 2 * time.Minute
+)
+
+// instanceUpdateAction applies the instance changes the PUT endpoint cannot:
+// network interfaces first, then rescue mode. A failing step is reported
+// along with the steps already applied.
+func instanceUpdateAction(desired, prior map[string]interface{}, resourceURL string, ctx base.TransformContext) (bool, error) {
+	if err := instanceInterfacesAction(desired, prior, resourceURL, ctx); err != nil {
+		return false, &base.PartialUpdateError{Failed: "networks", Err: err}
+	}
+	started, err := instanceRescueAction(desired, prior, resourceURL, ctx)
+	if err != nil {
+		return false, &base.PartialUpdateError{Applied: []string{"networks"}, Failed: "rescue", Err: err}
+	}
+	return started, nil
+}
+
+// instanceInterfacesAction attaches and detaches network interfaces so the
+// instance is connected to exactly the desired networks. It only runs when
+// networks changed, so interfaces never declared by the stack are left alone.
+// GET    /cloud/project/{serviceName}/instance/{instanceId}/interface
+// POST   /cloud/project/{serviceName}/instance/{instanceId}/interface
+// DELETE /cloud/project/{serviceName}/instance/{instanceId}/interface/{interfaceId}
+func instanceInterfacesAction(desired, prior map[string]interface{}, resourceURL string, ctx base.TransformContext) error {
+	wanted, _ := desired["networks"].([]interface{})
+	if len(wanted) == 0 || reflect.DeepEqual(desired["networks"], prior["networks"]) {
+		return nil
+	}
+
+	current, err := listInstanceInterfaces(resourceURL, ctx)
+	if err != nil {
+		return err
+	}
+
+	toAttach, toDetach := diffInterfaces(wanted, current)
+
+	// Detach first so a network can be moved to a new static IP
+	for _, interfaceID := range toDetach {
+		_, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{
+			Method: "DELETE",
+			Path:   fmt.Sprintf("%s/interface/%s", resourceURL, interfaceID),
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForInterface(resourceURL, ctx, interfaceID, false); err != nil {
+			return err
+		}
+	}
+
+	for _, network := range toAttach {
+		body := map[string]interface{}{"networkId": network["networkId"]}
+		if ip, _ := network["ip"].(string); ip != "" {
+			body["ip"] = ip
+		}
+		response, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{
+			Method: "POST",
+			Path:   resourceURL + "/interface",
+			Body:   body,
+		})
+		if err != nil {
+			return err
+		}
+		interfaceID, _ := response.Body["id"].(string)
+		if interfaceID == "" {
+			continue
+		}
+		if err := waitForInterface(resourceURL, ctx, interfaceID, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffInterfaces returns the desired networks without an interface, and the
+// IDs of interfaces on networks no longer desired. A network whose static ip
+// changed is detached and attached again.
+func diffInterfaces(wanted []interface{}, current []map[string]interface{}) (toAttach []map[string]interface{}, toDetach []string) {
+	matched := make(map[int]bool, len(current))
+
+	for _, item := range wanted {
+		network, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		networkID, _ := network["networkId"].(string)
+		if networkID == "" {
+			continue
+		}
+		ip, _ := network["ip"].(string)
+
+		found := false
+		for i, iface := range current {
+			if matched[i] || iface["networkId"] != networkID {
+				continue
+			}
+			if ip != "" && !interfaceHasIP(iface, ip) {
+				continue
+			}
+			matched[i] = true
+			found = true
+			break
+		}
+		if !found {
+			toAttach = append(toAttach, network)
+		}
+	}
+
+	for i, iface := range current {
+		if matched[i] {
+			continue
+		}
+		if id, _ := iface["id"].(string); id != "" {
+			toDetach = append(toDetach, id)
+		}
+	}
+	return toAttach, toDetach
+}
+
+// interfaceHasIP reports whether one of the interface's fixed IPs is ip
+func interfaceHasIP(iface map[string]interface{}, ip string) bool {
+	fixedIPs, _ := iface["fixedIps"].([]interface{})
+	for _, item := range fixedIPs {
+		if fixedIP, ok := item.(map[string]interface{}); ok && fixedIP["ip"] == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// listInstanceInterfaces returns the instance's current network interfaces
+func listInstanceInterfaces(resourceURL string, ctx base.TransformContext) ([]map[string]interface{}, error) {
+	response, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   resourceURL + "/interface",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make([]map[string]interface{}, 0, len(response.BodyArray))
+	for _, item := range response.BodyArray {
+		if iface, ok := item.(map[string]interface{}); ok {
+			interfaces = append(interfaces, iface)
+		}
+	}
+	return interfaces, nil
+}
+
+// waitForInterface polls until the interface is present or gone
+func waitForInterface(resourceURL string, ctx base.TransformContext, interfaceID string, present bool) error {
+	deadline := time.Now().Add(interfacePollTimeout)
+	for {
+		interfaces, err := listInstanceInterfaces(resourceURL, ctx)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, iface := range interfaces {
+			if iface["id"] == interfaceID {
+				found = true
+				break
+			}
+		}
+		if found == present {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			action := "detached"
+			if present {
+				action = "attached"
+			}
+			return fmt.Errorf("interface %s was not %s after %v", interfaceID, action, interfacePollTimeout)
+		}
+
+		select {
+		case <-ctx.Ctx.Done():
+			return ctx.Ctx.Err()
+		case <-time.After(interfacePollInterval):
+		}
+	}
+}
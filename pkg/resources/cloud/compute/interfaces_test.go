@@ -0,0 +1,137 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testInstanceURL = "/cloud/project/p1/instance/i1"
+
+// fakeInterfaceClient keeps an in-memory interface list for one instance
+type fakeInterfaceClient struct {
+	interfaces []interface{}
+	calls      []string
+	nextID     int
+}
+
+func (f *fakeInterfaceClient) Do(ctx context.Context, opts ovhtransport.RequestOptions) (*ovhtransport.Response, error) {
+	f.calls = append(f.calls, opts.Method+" "+opts.Path)
+	switch {
+	case opts.Method == "GET" && opts.Path == testInstanceURL+"/interface":
+		return &ovhtransport.Response{StatusCode: 200, BodyArray: f.interfaces}, nil
+	case opts.Method == "POST" && opts.Path == testInstanceURL+"/interface":
+		body := opts.Body.(map[string]interface{})
+		f.nextID++
+		iface := map[string]interface{}{"id": fmt.Sprintf("new-%d", f.nextID), "networkId": body["networkId"]}
+		f.interfaces = append(f.interfaces, iface)
+		return &ovhtransport.Response{StatusCode: 200, Body: iface}, nil
+	case opts.Method == "DELETE" && strings.HasPrefix(opts.Path, testInstanceURL+"/interface/"):
+		id := strings.TrimPrefix(opts.Path, testInstanceURL+"/interface/")
+		var kept []interface{}
+		for _, item := range f.interfaces {
+			if item.(map[string]interface{})["id"] != id {
+				kept = append(kept, item)
+			}
+		}
+		f.interfaces = kept
+		return &ovhtransport.Response{StatusCode: 200}, nil
+	}
+	return nil, &ovhtransport.Error{Code: ovhtransport.ErrorCodeResourceNotFound, Message: "not found"}
+}
+
+func networks(ids ...string) []interface{} {
+	list := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, map[string]interface{}{"networkId": id})
+	}
+	return list
+}
+
+func TestInstanceInterfacesAction_AttachAndDetach(t *testing.T) {
+	client := &fakeInterfaceClient{interfaces: []interface{}{
+		map[string]interface{}{"id": "if-a", "networkId": "net-a"},
+		map[string]interface{}{"id": "if-b", "networkId": "net-b"},
+	}}
+
+	err := instanceInterfacesAction(
+		map[string]interface{}{"networks": networks("net-a", "net-c")},
+		map[string]interface{}{"networks": networks("net-a", "net-b")},
+		testInstanceURL, quotaContext(client))
+	require.NoError(t, err)
+
+	assert.Contains(t, client.calls, "DELETE "+testInstanceURL+"/interface/if-b")
+	assert.Contains(t, client.calls, "POST "+testInstanceURL+"/interface")
+
+	var attached []interface{}
+	for _, item := range client.interfaces {
+		attached = append(attached, item.(map[string]interface{})["networkId"])
+	}
+	assert.ElementsMatch(t, []interface{}{"net-a", "net-c"}, attached)
+}
+
+func TestInstanceInterfacesAction_UnchangedNetworks(t *testing.T) {
+	client := &fakeInterfaceClient{}
+
+	err := instanceInterfacesAction(
+		map[string]interface{}{"networks": networks("net-a")},
+		map[string]interface{}{"networks": networks("net-a")},
+		testInstanceURL, quotaContext(client))
+	require.NoError(t, err)
+	assert.Empty(t, client.calls)
+
+	// Dropping networks from the stack leaves the interfaces alone
+	err = instanceInterfacesAction(
+		map[string]interface{}{},
+		map[string]interface{}{"networks": networks("net-a")},
+		testInstanceURL, quotaContext(client))
+	require.NoError(t, err)
+	assert.Empty(t, client.calls)
+}
+
+func TestDiffInterfaces_StaticIPChange(t *testing.T) {
+	current := []map[string]interface{}{
+		{"id": "if-a", "networkId": "net-a", "fixedIps": []interface{}{map[string]interface{}{"ip": "10.0.0.5"}}},
+	}
+
+	toAttach, toDetach := diffInterfaces([]interface{}{
+		map[string]interface{}{"networkId": "net-a", "ip": "10.0.0.6"},
+	}, current)
+	assert.Equal(t, []string{"if-a"}, toDetach)
+	require.Len(t, toAttach, 1)
+	assert.Equal(t, "10.0.0.6", toAttach[0]["ip"])
+
+	toAttach, toDetach = diffInterfaces([]interface{}{
+		map[string]interface{}{"networkId": "net-a", "ip": "10.0.0.5"},
+	}, current)
+	assert.Empty(t, toAttach)
+	assert.Empty(t, toDetach)
+}
+
+func TestInstanceUpdateAction_ReportsAppliedSteps(t *testing.T) {
+	// The fake client has no rescueMode endpoint, so rescue fails after the
+	// interfaces were changed
+	client := &fakeInterfaceClient{interfaces: []interface{}{
+		map[string]interface{}{"id": "if-a", "networkId": "net-a"},
+	}}
+
+	_, err := instanceUpdateAction(
+		map[string]interface{}{"networks": networks("net-b"), "rescue": true},
+		map[string]interface{}{"networks": networks("net-a")},
+		testInstanceURL, quotaContext(client))
+
+	var partial *base.PartialUpdateError
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, []string{"networks"}, partial.Applied)
+	assert.Equal(t, "rescue", partial.Failed)
+}
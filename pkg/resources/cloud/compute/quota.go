@@ -0,0 +1,263 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// QuotaResourceType is the read-only resource exposing project quota per region.
+const QuotaResourceType = "OVH::Billing::Quota"
+
+// Quota reports limits and current usage for one region:
+// - Create: GET /cloud/project/{serviceName}/region/{regionName}/quota
+// - Read:   GET /cloud/project/{serviceName}/region/{regionName}/quota
+// - List:   GET /cloud/project/{serviceName}/quota
+// Like the catalog data resources, nothing is created and Delete only forgets the lookup.
+
+// quotaProvisioner reads project quota.
+type quotaProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &quotaProvisioner{}
+
+func (p *quotaProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project := extractProject(request.TargetConfig)
+	if serviceName, ok := props["serviceName"].(string); ok && serviceName != "" {
+		project = serviceName
+	}
+	if project == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "serviceName is required"), nil
+	}
+
+	region, _ := props["region"].(string)
+	if region == "" {
+		region = extractRegion(request.TargetConfig)
+	}
+	if region == "" {
+		return dataCreateFailure(resource.OperationErrorCodeInvalidRequest, "region is required"), nil
+	}
+
+	quota, err := fetchRegionQuota(ctx, p.client, project, region)
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return dataCreateFailure(ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message), nil
+		}
+		return dataCreateFailure(resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	propsJSON, _ := json.Marshal(quota)
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           fmt.Sprintf("%s/%s", project, region),
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *quotaProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	project, region, err := parseDataNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	quota, err := fetchRegionQuota(ctx, p.client, project, region)
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+
+	propsJSON, _ := json.Marshal(quota)
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+// Update is not supported: quota is raised through OVH support, not the API.
+func (p *quotaProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       resource.OperationErrorCodeNotUpdatable,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Delete succeeds without calling the API.
+func (p *quotaProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+func (p *quotaProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	project := extractProject(request.TargetConfig)
+	if serviceName := request.AdditionalProperties["serviceName"]; serviceName != "" {
+		project = serviceName
+	}
+	if project == "" {
+		return &resource.ListResult{NativeIDs: nil}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/quota", project),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quotas: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, item := range response.BodyArray {
+		if entry, ok := item.(map[string]interface{}); ok {
+			if region, ok := entry["region"].(string); ok && region != "" {
+				nativeIDs = append(nativeIDs, fmt.Sprintf("%s/%s", project, region))
+			}
+		}
+	}
+
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
+
+// Status returns success immediately (lookups are synchronous).
+func (p *quotaProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// fetchRegionQuota returns the quota of project in region
+func fetchRegionQuota(ctx context.Context, client base.TransportClient, project, region string) (map[string]interface{}, error) {
+	response, err := client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/region/%s/quota", project, region),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// instanceQuotaCheck rejects an instance when the region has no instance,
+// core or RAM quota left for the requested flavor.
+func instanceQuotaCheck(props map[string]interface{}, ctx base.TransformContext) error {
+	region, _ := props["region"].(string)
+	if region == "" || ctx.Client == nil {
+		return nil
+	}
+	quota, err := fetchRegionQuota(ctx.Ctx, ctx.Client, ctx.Project, region)
+	if err != nil {
+		return nil
+	}
+	usage, _ := quota["instance"].(map[string]interface{})
+
+	var vcpus, ram float64
+	if flavorID, ok := props["flavorId"].(string); ok && flavorID != "" {
+		response, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{
+			Method: "GET",
+			Path:   fmt.Sprintf("/cloud/project/%s/flavor/%s", ctx.Project, flavorID),
+		})
+		if err == nil {
+			vcpus, _ = response.Body["vcpus"].(float64)
+			ram, _ = response.Body["ram"].(float64)
+		}
+	}
+
+	return checkQuota(region, usage, []quotaRequest{
+		{"instances", "usedInstances", "maxInstances", 1},
+		{"cores", "usedCores", "maxCores", vcpus},
+		{"RAM (MB)", "usedRAM", "maxRam", ram},
+	})
+}
+
+// volumeQuotaCheck rejects a volume when the region has no volume count or
+// storage quota left for the requested size.
+func volumeQuotaCheck(props map[string]interface{}, ctx base.TransformContext) error {
+	region, _ := props["region"].(string)
+	if region == "" || ctx.Client == nil {
+		return nil
+	}
+	quota, err := fetchRegionQuota(ctx.Ctx, ctx.Client, ctx.Project, region)
+	if err != nil {
+		return nil
+	}
+	usage, _ := quota["volume"].(map[string]interface{})
+	size, _ := props["size"].(float64)
+
+	return checkQuota(region, usage, []quotaRequest{
+		{"volumes", "volumeCount", "maxVolumeCount", 1},
+		{"storage (GB)", "usedGigabytes", "maxGigabytes", size},
+	})
+}
+
+// quotaRequest is an amount to add to one usage counter
+type quotaRequest struct {
+	name    string
+	usedKey string
+	maxKey  string
+	amount  float64
+}
+
+// checkQuota returns an error naming the first limit the requests would exceed.
+// Counters missing from usage, and non-positive limits, are not checked.
+func checkQuota(region string, usage map[string]interface{}, requests []quotaRequest) error {
+	for _, req := range requests {
+		used, usedOK := usage[req.usedKey].(float64)
+		limit, limitOK := usage[req.maxKey].(float64)
+		if !usedOK || !limitOK || limit <= 0 || req.amount <= 0 {
+			continue
+		}
+		if used+req.amount > limit {
+			return fmt.Errorf("quota exceeded in region %s: %s would reach %.0f of %.0f (%.0f in use); "+
+				"delete unused resources or request a quota increase", region, req.name, used+req.amount, limit, used)
+		}
+	}
+	return nil
+}
+
+func init() {
+	registry.Register(QuotaResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &quotaProvisioner{client: client}
+		},
+	)
+}
@@ -0,0 +1,96 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuotaClient serves canned GET responses keyed by path
+type fakeQuotaClient struct {
+	responses map[string]map[string]interface{}
+}
+
+func (f *fakeQuotaClient) Do(ctx context.Context, opts ovhtransport.RequestOptions) (*ovhtransport.Response, error) {
+	body, ok := f.responses[opts.Path]
+	if !ok {
+		return nil, &ovhtransport.Error{Code: ovhtransport.ErrorCodeResourceNotFound, Message: "not found"}
+	}
+	return &ovhtransport.Response{StatusCode: 200, Body: body}, nil
+}
+
+func quotaContext(client base.TransportClient) base.TransformContext {
+	return base.TransformContext{Project: "p1", Client: client, Ctx: context.Background()}
+}
+
+func TestCheckQuota(t *testing.T) {
+	usage := map[string]interface{}{
+		"usedInstances": float64(9), "maxInstances": float64(10),
+		"usedCores": float64(30), "maxCores": float64(32),
+	}
+
+	assert.NoError(t, checkQuota("GRA11", usage, []quotaRequest{
+		{"instances", "usedInstances", "maxInstances", 1},
+		{"cores", "usedCores", "maxCores", 2},
+	}))
+
+	err := checkQuota("GRA11", usage, []quotaRequest{
+		{"instances", "usedInstances", "maxInstances", 1},
+		{"cores", "usedCores", "maxCores", 4},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cores would reach 34 of 32")
+	assert.Contains(t, err.Error(), "GRA11")
+
+	// Unknown counters are not checked
+	assert.NoError(t, checkQuota("GRA11", usage, []quotaRequest{{"RAM (MB)", "usedRAM", "maxRam", 8000}}))
+}
+
+func TestInstanceQuotaCheck(t *testing.T) {
+	client := &fakeQuotaClient{responses: map[string]map[string]interface{}{
+		"/cloud/project/p1/region/GRA11/quota": {
+			"region": "GRA11",
+			"instance": map[string]interface{}{
+				"usedInstances": float64(1), "maxInstances": float64(20),
+				"usedCores": float64(2), "maxCores": float64(8),
+				"usedRAM": float64(7000), "maxRam": float64(30000),
+			},
+		},
+		"/cloud/project/p1/flavor/b2-30": {"vcpus": float64(8), "ram": float64(30000)},
+		"/cloud/project/p1/flavor/b2-7":  {"vcpus": float64(2), "ram": float64(7000)},
+	}}
+
+	assert.NoError(t, instanceQuotaCheck(map[string]interface{}{"region": "GRA11", "flavorId": "b2-7"}, quotaContext(client)))
+
+	err := instanceQuotaCheck(map[string]interface{}{"region": "GRA11", "flavorId": "b2-30"}, quotaContext(client))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cores")
+
+	// Quota that cannot be fetched lets the API decide
+	assert.NoError(t, instanceQuotaCheck(map[string]interface{}{"region": "BHS5", "flavorId": "b2-30"}, quotaContext(client)))
+}
+
+func TestVolumeQuotaCheck(t *testing.T) {
+	client := &fakeQuotaClient{responses: map[string]map[string]interface{}{
+		"/cloud/project/p1/region/GRA11/quota": {
+			"volume": map[string]interface{}{
+				"volumeCount": float64(3), "maxVolumeCount": float64(100),
+				"usedGigabytes": float64(900), "maxGigabytes": float64(1000),
+			},
+		},
+	}}
+
+	for size, wantErr := range map[float64]bool{100: false, 101: true} {
+		err := volumeQuotaCheck(map[string]interface{}{"region": "GRA11", "size": size}, quotaContext(client))
+		assert.Equal(t, wantErr, err != nil, fmt.Sprintf("size %.0f: %v", size, err))
+	}
+}
@@ -0,0 +1,109 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// instanceReferences maps instance properties to the project collection holding the referenced resource
+var instanceReferences = map[string]string{
+	"flavorId": "flavor",
+	"imageId":  "image",
+	"sshKeyId": "sshkey",
+}
+
+// volumeReferences maps volume properties to the project collection holding the referenced resource
+var volumeReferences = map[string]string{
+	"imageId":    "image",
+	"snapshotId": "volume/snapshot",
+}
+
+// instanceReferenceCheck reports a flavor, image, SSH key or network the
+// instance refers to that does not exist. Networks are looked up in the
+// instance's region, since their IDs are regional.
+func instanceReferenceCheck(props map[string]interface{}, ctx base.TransformContext) ([]prov.ValidationError, error) {
+	errs, err := checkProjectReferences(props, ctx, instanceReferences)
+	if err != nil {
+		return nil, err
+	}
+
+	region, _ := props["region"].(string)
+	networks, _ := props["networks"].([]interface{})
+	if region == "" {
+		return errs, nil
+	}
+	for i, item := range networks {
+		network, _ := item.(map[string]interface{})
+		networkID, _ := network["networkId"].(string)
+		if networkID == "" {
+			continue
+		}
+		exists, err := referenceExists(ctx, fmt.Sprintf("/cloud/project/%s/region/%s/network/%s", ctx.Project, region, networkID))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			errs = append(errs, prov.ValidationError{
+				Property: fmt.Sprintf("networks[%d].networkId", i),
+				Message:  fmt.Sprintf("network %q does not exist in region %s", networkID, region),
+			})
+		}
+	}
+	return errs, nil
+}
+
+// volumeReferenceCheck reports an image or snapshot the volume is created from that does not exist.
+func volumeReferenceCheck(props map[string]interface{}, ctx base.TransformContext) ([]prov.ValidationError, error) {
+	return checkProjectReferences(props, ctx, volumeReferences)
+}
+
+// checkProjectReferences looks up each ID property in its project collection.
+// Properties are checked in name order so errors are reported deterministically.
+func checkProjectReferences(props map[string]interface{}, ctx base.TransformContext, references map[string]string) ([]prov.ValidationError, error) {
+	names := make([]string, 0, len(references))
+	for name := range references {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []prov.ValidationError
+	for _, name := range names {
+		id, _ := props[name].(string)
+		if id == "" {
+			continue
+		}
+		collection := references[name]
+		exists, err := referenceExists(ctx, fmt.Sprintf("/cloud/project/%s/%s/%s", ctx.Project, collection, id))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			errs = append(errs, prov.ValidationError{
+				Property: name,
+				Message:  fmt.Sprintf("%s %q does not exist", collection, id),
+			})
+		}
+	}
+	return errs, nil
+}
+
+// referenceExists reports whether GET path finds a resource. Errors other
+// than not found are returned, since existence could not be decided.
+func referenceExists(ctx base.TransformContext, path string) (bool, error) {
+	_, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{Method: "GET", Path: path})
+	if err == nil {
+		return true, nil
+	}
+	if transportErr, ok := err.(*ovhtransport.Error); ok && transportErr.Code == ovhtransport.ErrorCodeResourceNotFound {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,57 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceReferenceCheck(t *testing.T) {
+	client := &fakeQuotaClient{responses: map[string]map[string]interface{}{
+		"/cloud/project/p1/flavor/b2-7":                {"id": "b2-7"},
+		"/cloud/project/p1/image/debian":               {"id": "debian"},
+		"/cloud/project/p1/region/GRA11/network/net-a": {"id": "net-a"},
+	}}
+
+	errs, err := instanceReferenceCheck(map[string]interface{}{
+		"region":   "GRA11",
+		"flavorId": "b2-7",
+		"imageId":  "debian",
+		"networks": []interface{}{map[string]interface{}{"networkId": "net-a"}},
+	}, quotaContext(client))
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	errs, err = instanceReferenceCheck(map[string]interface{}{
+		"region":   "GRA11",
+		"flavorId": "b2-9000",
+		"imageId":  "debian",
+		"sshKeyId": "gone",
+		"networks": []interface{}{
+			map[string]interface{}{"networkId": "net-a"},
+			map[string]interface{}{"networkId": "net-b"},
+		},
+	}, quotaContext(client))
+	require.NoError(t, err)
+	assert.Equal(t, []prov.ValidationError{
+		{Property: "flavorId", Message: `flavor "b2-9000" does not exist`},
+		{Property: "sshKeyId", Message: `sshkey "gone" does not exist`},
+		{Property: "networks[1].networkId", Message: `network "net-b" does not exist in region GRA11`},
+	}, errs)
+}
+
+func TestVolumeReferenceCheck(t *testing.T) {
+	client := &fakeQuotaClient{responses: map[string]map[string]interface{}{}}
+
+	errs, err := volumeReferenceCheck(map[string]interface{}{"snapshotId": "snap-1"}, quotaContext(client))
+	require.NoError(t, err)
+	assert.Equal(t, []prov.ValidationError{
+		{Property: "snapshotId", Message: `volume/snapshot "snap-1" does not exist`},
+	}, errs)
+}
@@ -0,0 +1,51 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// instanceRescueStatus is the status of an instance booted in rescue mode
+const instanceRescueStatus = "RESCUE"
+
+// instanceRescueAction enters or leaves rescue mode when the rescue property
+// changes. Rescue boots the instance from rescueImageId (or the OVH default
+// rescue image) with its original disk attached for repair.
+// POST /cloud/project/{serviceName}/instance/{instanceId}/rescueMode
+func instanceRescueAction(desired, prior map[string]interface{}, resourceURL string, ctx base.TransformContext) (bool, error) {
+	want, _ := desired["rescue"].(bool)
+	current, _ := prior["rescue"].(bool)
+	if want == current {
+		return false, nil
+	}
+
+	body := map[string]interface{}{"rescue": want}
+	if imageID, _ := desired["rescueImageId"].(string); want && imageID != "" {
+		body["imageId"] = imageID
+	}
+
+	_, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   resourceURL + "/rescueMode",
+		Body:   body,
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// instanceResponseTransformer reports rescue mode from the instance status,
+// since the API has no rescue field to read back.
+var instanceResponseTransformer = base.ResponseTransformerFunc(func(apiResponse map[string]interface{}, ctx base.TransformContext) map[string]interface{} {
+	if apiResponse == nil {
+		return apiResponse
+	}
+	status, _ := apiResponse["status"].(string)
+	apiResponse["rescue"] = status == instanceRescueStatus
+	return apiResponse
+})
@@ -0,0 +1,86 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingClient records requests and returns an empty response
+type recordingClient struct {
+	requests []ovhtransport.RequestOptions
+}
+
+func (c *recordingClient) Do(ctx context.Context, opts ovhtransport.RequestOptions) (*ovhtransport.Response, error) {
+	c.requests = append(c.requests, opts)
+	return &ovhtransport.Response{StatusCode: 200}, nil
+}
+
+const instanceURL = "/cloud/project/p1/instance/inst-1"
+
+func TestInstanceRescueAction_Enter(t *testing.T) {
+	client := &recordingClient{}
+	started, err := instanceRescueAction(
+		map[string]interface{}{"rescue": true, "rescueImageId": "img-rescue"},
+		map[string]interface{}{"rescue": false},
+		instanceURL, base.TransformContext{Client: client, Ctx: context.Background()})
+	require.NoError(t, err)
+	assert.True(t, started)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "POST", client.requests[0].Method)
+	assert.Equal(t, instanceURL+"/rescueMode", client.requests[0].Path)
+	assert.Equal(t, map[string]interface{}{"rescue": true, "imageId": "img-rescue"}, client.requests[0].Body)
+}
+
+func TestInstanceRescueAction_Leave(t *testing.T) {
+	client := &recordingClient{}
+	started, err := instanceRescueAction(
+		map[string]interface{}{"rescueImageId": "img-rescue"},
+		map[string]interface{}{"rescue": true},
+		instanceURL, base.TransformContext{Client: client, Ctx: context.Background()})
+	require.NoError(t, err)
+	assert.True(t, started)
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, map[string]interface{}{"rescue": false}, client.requests[0].Body)
+}
+
+func TestInstanceRescueAction_Unchanged(t *testing.T) {
+	client := &recordingClient{}
+	started, err := instanceRescueAction(
+		map[string]interface{}{"rescue": true},
+		map[string]interface{}{"rescue": true},
+		instanceURL, base.TransformContext{Client: client, Ctx: context.Background()})
+	require.NoError(t, err)
+	assert.False(t, started)
+	assert.Empty(t, client.requests)
+}
+
+func TestInstanceResponseTransformer_Rescue(t *testing.T) {
+	props := instanceResponseTransformer.Transform(map[string]interface{}{"status": "RESCUE"}, base.TransformContext{})
+	assert.Equal(t, true, props["rescue"])
+
+	props = instanceResponseTransformer.Transform(map[string]interface{}{"status": "ACTIVE"}, base.TransformContext{})
+	assert.Equal(t, false, props["rescue"])
+}
+
+func TestInstanceRequestTransformer_StripsRescue(t *testing.T) {
+	body, err := instanceRequestTransformer.Transform(map[string]interface{}{
+		"name":          "web-1",
+		"imageId":       "img-1",
+		"rescue":        true,
+		"rescueImageId": "img-rescue",
+	}, base.TransformContext{})
+	require.NoError(t, err)
+	assert.NotContains(t, body, "rescue")
+	assert.NotContains(t, body, "rescueImageId")
+}
@@ -5,6 +5,9 @@
 package compute
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud"
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
@@ -19,15 +22,28 @@ const (
 
 var cloudComputeRegistry *base.ResourceRegistry
 
+// instanceSoftDeletedStatus is the status of an instance deleted outside the
+// stack while the region keeps deleted instances for a reclaim window
+const instanceSoftDeletedStatus = "SOFT_DELETED"
+
 // instanceStatusChecker verifies the instance has reached ACTIVE status.
-// OVH instances go through BUILD -> ACTIVE (or ERROR) states.
+// OVH instances go through BUILD -> ACTIVE (or ERROR) states, and through
+// RESCUING -> RESCUE when rescue mode is entered.
+//
+// A soft-deleted instance never becomes ready, so polling it fails at once.
+// The OVH API can neither restore nor force-delete it: it is restored with
+// the OpenStack API ("openstack server restore") within the reclaim window,
+// or reclaimed by OpenStack once the window ends.
 func instanceStatusChecker(resourceData map[string]interface{}) (bool, error) {
 	status, ok := resourceData["status"].(string)
 	if !ok {
 		// No status field - consider not ready
 		return false, nil
 	}
-	return status == "ACTIVE", nil
+	if status == instanceSoftDeletedStatus {
+		return false, fmt.Errorf("instance is %s; restore it with \"openstack server restore\" before the reclaim window ends, or remove it from the stack", instanceSoftDeletedStatus)
+	}
+	return status == "ACTIVE" || status == instanceRescueStatus, nil
 }
 
 func init() {
@@ -40,6 +56,8 @@ func init() {
 		// Read:   GET /cloud/project/{serviceName}/instance/{instanceId}
 		// Update: PUT /cloud/project/{serviceName}/instance/{instanceId}
 		// Delete: DELETE /cloud/project/{serviceName}/instance/{instanceId}
+		// Rescue: POST /cloud/project/{serviceName}/instance/{instanceId}/rescueMode
+		// NICs:   POST/DELETE /cloud/project/{serviceName}/instance/{instanceId}/interface
 		{
 			ResourceType: InstanceResourceType,
 			ResourceConfig: base.ResourceConfig{
@@ -47,9 +65,21 @@ func init() {
 				Scope:          &base.ScopeConfig{Type: base.ScopeProject},
 				SupportsUpdate: true,
 				UpdateMethod:   base.UpdateMethodPut,
+				// Collection endpoint returns full objects
+				ListDetailed: &base.ListDetailedConfig{Enabled: true},
+				// Retried creates adopt the resource instead of duplicating it.
+				// Matching on the boot source and flavor, not just the name,
+				// keeps an unrelated instance of the same name from being adopted.
+				AdoptExistingBy: []string{"name", "region", "flavorId", "imageId"},
+				AdoptCreatedAt:  "created",
 			},
-			//ResponseTransformer: instanceTransformer,
-			StatusChecker: instanceStatusChecker,
+			ResponseTransformer: instanceResponseTransformer,
+			RequestTransformer:  instanceRequestTransformer,
+			StatusChecker:       instanceStatusChecker,
+			StatusBackoff:       base.NewStatusBackoff(10*time.Second, time.Minute),
+			QuotaCheck:          instanceQuotaCheck,
+			UpdateAction:        instanceUpdateAction,
+			ReferenceCheck:      instanceReferenceCheck,
 			Operations: []resource.Operation{
 				resource.OperationCreate,
 				resource.OperationRead,
@@ -71,7 +101,12 @@ func init() {
 				ResourceType:   "sshkey",
 				Scope:          &base.ScopeConfig{Type: base.ScopeProject},
 				SupportsUpdate: false,
+				// Collection endpoint returns full objects
+				ListDetailed: &base.ListDetailedConfig{Enabled: true},
+				// Retried creates adopt the key only if it holds the same public key
+				AdoptExistingBy: []string{"name", "publicKey"},
 			},
+			RequestTransformer: sshKeyRequestTransformer,
 			Operations: []resource.Operation{
 				resource.OperationCreate,
 				resource.OperationRead,
@@ -92,7 +127,18 @@ func init() {
 				Scope:          &base.ScopeConfig{Type: base.ScopeProject},
 				SupportsUpdate: true,
 				UpdateMethod:   base.UpdateMethodPut,
+				// Collection endpoint returns full objects
+				ListDetailed: &base.ListDetailedConfig{Enabled: true},
+				// Retried creates adopt the resource instead of duplicating it,
+				// provided the size matches too. volumeType is not compared:
+				// it is optional and the API returns it as "type".
+				AdoptExistingBy: []string{"name", "region", "size"},
+				AdoptCreatedAt:  "createdAt",
 			},
+			QuotaCheck:          volumeQuotaCheck,
+			RequestTransformer:  volumeRequestTransformer,
+			ResponseTransformer: volumeResponseTransformer,
+			ReferenceCheck:      volumeReferenceCheck,
 			Operations: []resource.Operation{
 				resource.OperationCreate,
 				resource.OperationRead,
@@ -0,0 +1,27 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceStatusChecker(t *testing.T) {
+	for status, want := range map[string]bool{"ACTIVE": true, "RESCUE": true, "BUILD": false, "RESCUING": false} {
+		ready, err := instanceStatusChecker(map[string]interface{}{"status": status})
+		assert.NoError(t, err, status)
+		assert.Equal(t, want, ready, status)
+	}
+
+	ready, err := instanceStatusChecker(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	// A soft-deleted instance fails at once instead of being polled until timeout
+	_, err = instanceStatusChecker(map[string]interface{}{"status": "SOFT_DELETED"})
+	assert.ErrorContains(t, err, "openstack server restore")
+}
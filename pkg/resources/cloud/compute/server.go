@@ -0,0 +1,445 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/tags"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	openstacktransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// Nova limits on server metadata and tags
+const (
+	maxMetadataLength = 255
+	maxServerTags     = 50
+	maxServerTagLen   = 60
+)
+
+// pendingServerExpiry is how long the Nova properties of a new instance are
+// kept for a status poll that never comes, such as a cancelled create's
+const pendingServerExpiry = time.Hour
+
+// OpenStackClientFunc returns the OpenStack client of the target, or nil when
+// the target has no OpenStack credentials
+type OpenStackClientFunc func() (*openstacktransport.Client, error)
+
+// WithServerProperties wraps the OVH API instance provisioner to manage the
+// instance properties only Nova can set, since OVH instance IDs are Nova
+// server IDs: metadata and tags. Instances that declare neither, on a
+// target without a metadata policy, never need OpenStack credentials.
+func WithServerProperties(instance prov.Provisioner, openStack OpenStackClientFunc, policy MetadataPolicy) prov.Provisioner {
+	return &serverProvisioner{Provisioner: instance, openStack: openStack, policy: policy}
+}
+
+type serverProvisioner struct {
+	prov.Provisioner
+	openStack OpenStackClientFunc
+	policy    MetadataPolicy
+}
+
+// serverSpec is the Nova side of an instance as declared in its properties
+type serverSpec struct {
+	region   string
+	metadata map[string]string // nil when not declared
+	tags     []string          // nil when not declared
+}
+
+// declared reports whether the instance declares any Nova property
+func (s serverSpec) declared() bool {
+	return s.metadata != nil || s.tags != nil
+}
+
+// parseServerSpec reads and validates the Nova properties of an instance
+func parseServerSpec(props map[string]interface{}) (serverSpec, error) {
+	spec := serverSpec{}
+	spec.region, _ = props["region"].(string)
+
+	if raw, ok := props["metadata"].(map[string]interface{}); ok {
+		spec.metadata = make(map[string]string, len(raw))
+		for key, v := range raw {
+			value, ok := v.(string)
+			if !ok {
+				return spec, fmt.Errorf("metadata %q must be a string", key)
+			}
+			if key == "" || len(key) > maxMetadataLength {
+				return spec, fmt.Errorf("metadata key %q must be 1 to %d characters", key, maxMetadataLength)
+			}
+			if len(value) > maxMetadataLength {
+				return spec, fmt.Errorf("metadata %q is longer than %d characters", key, maxMetadataLength)
+			}
+			spec.metadata[key] = value
+		}
+	}
+
+	if raw, ok := props["tags"].([]interface{}); ok {
+		if len(raw) > maxServerTags {
+			return spec, fmt.Errorf("an instance can have at most %d tags, got %d", maxServerTags, len(raw))
+		}
+		spec.tags = make([]string, 0, len(raw))
+		for _, v := range raw {
+			tag, ok := v.(string)
+			if !ok {
+				return spec, fmt.Errorf("tag %v must be a string", v)
+			}
+			if tag == "" || len(tag) > maxServerTagLen || strings.ContainsAny(tag, "/,") {
+				return spec, fmt.Errorf("tag %q must be 1 to %d characters without '/' or ','", tag, maxServerTagLen)
+			}
+			if !slices.Contains(spec.tags, tag) {
+				spec.tags = append(spec.tags, tag)
+			}
+		}
+		slices.Sort(spec.tags)
+	}
+	return spec, nil
+}
+
+// serverID returns the Nova server ID at the end of an instance native ID
+func serverID(nativeID string) string {
+	return nativeID[strings.LastIndex(nativeID, "/")+1:]
+}
+
+// pendingServers holds the Nova properties of instances still building.
+// Nova refuses metadata and tag changes until the instance is ready, and status
+// requests carry no properties, so they are kept here from Create until the
+// poll that finds the instance ready. If the plugin restarts in between,
+// the properties are missing on the next read and the next apply sets them.
+var pendingServers = &serverQueue{specs: make(map[string]queuedServer), now: time.Now}
+
+type serverQueue struct {
+	mu    sync.Mutex
+	specs map[string]queuedServer
+	now   func() time.Time
+}
+
+type queuedServer struct {
+	spec   serverSpec
+	queued time.Time
+}
+
+// add queues spec for the instance, dropping entries no poll picked up
+func (q *serverQueue) add(nativeID string, spec serverSpec) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	for id, entry := range q.specs {
+		if now.Sub(entry.queued) > pendingServerExpiry {
+			delete(q.specs, id)
+		}
+	}
+	q.specs[nativeID] = queuedServer{spec: spec, queued: now}
+}
+
+// take removes and returns the queued spec of the instance
+func (q *serverQueue) take(nativeID string) (serverSpec, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entry, ok := q.specs[nativeID]
+	delete(q.specs, nativeID)
+	return entry.spec, ok
+}
+
+// forget drops the queued spec of an instance that failed to build
+func (q *serverQueue) forget(nativeID string) {
+	q.mu.Lock()
+	delete(q.specs, nativeID)
+	q.mu.Unlock()
+}
+
+// Create creates the instance, then queues its Nova properties until it is
+// ready. Properties are checked first, so a bad value creates nothing.
+func (s *serverProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return s.Provisioner.Create(ctx, request)
+	}
+	spec, err := s.parseSpec(props)
+	if err != nil {
+		return serverCreateFailure(resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+	if !spec.declared() {
+		return s.Provisioner.Create(ctx, request)
+	}
+	client, err := s.client()
+	if err != nil {
+		return serverCreateFailure(resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	result, err := s.Provisioner.Create(ctx, request)
+	if err != nil || result == nil || result.ProgressResult == nil {
+		return result, err
+	}
+
+	progress := result.ProgressResult
+	switch progress.OperationStatus {
+	case resource.OperationStatusInProgress:
+		pendingServers.add(progress.NativeID, spec)
+	case resource.OperationStatusSuccess:
+		if code, message := s.apply(ctx, client, progress, spec, serverSpec{}); code != "" {
+			progress.OperationStatus = resource.OperationStatusFailure
+			progress.ErrorCode = code
+			progress.StatusMessage = message
+		}
+	}
+	return result, nil
+}
+
+// Status applies the queued Nova properties once the instance is ready
+func (s *serverProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	result, err := s.Provisioner.Status(ctx, request)
+	if err != nil || result == nil || result.ProgressResult == nil {
+		return result, err
+	}
+
+	progress := result.ProgressResult
+	switch progress.OperationStatus {
+	case resource.OperationStatusFailure:
+		pendingServers.forget(request.NativeID)
+		return result, nil
+	case resource.OperationStatusSuccess:
+	default:
+		return result, nil
+	}
+
+	spec, ok := pendingServers.take(request.NativeID)
+	if !ok {
+		return result, nil
+	}
+	client, err := s.client()
+	if err == nil {
+		if code, message := s.apply(ctx, client, progress, spec, serverSpec{}); code != "" {
+			err = fmt.Errorf("%s", message)
+			progress.ErrorCode = code
+		}
+	} else {
+		progress.ErrorCode = resource.OperationErrorCodeInvalidRequest
+	}
+	if err != nil {
+		// Kept so that a retried poll applies them again
+		pendingServers.add(request.NativeID, spec)
+		progress.OperationStatus = resource.OperationStatusFailure
+		progress.StatusMessage = fmt.Sprintf("instance is ready but its OpenStack properties were not set: %v", err)
+	}
+	return result, nil
+}
+
+// Read adds the Nova properties to the instance. Without OpenStack
+// credentials, or when Nova cannot be reached, the instance is returned
+// without them.
+func (s *serverProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	result, err := s.Provisioner.Read(ctx, request)
+	if err != nil || result == nil || result.ErrorCode != "" || result.Properties == "" {
+		return result, err
+	}
+
+	client, err := s.openStack()
+	if err != nil || client == nil {
+		return result, nil
+	}
+
+	var props map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Properties), &props); err != nil {
+		return result, nil
+	}
+	region, _ := props["region"].(string)
+	if err := readServer(ctx, client, region, serverID(request.NativeID), props, s.policy); err != nil {
+		warnings.Warnf(ctx, "failed to read OpenStack properties of instance %s: %s", request.NativeID, resources.OpenStackErrorMessage(err))
+		return result, nil
+	}
+
+	propsJSON, err := json.Marshal(props)
+	if err != nil {
+		return result, nil
+	}
+	result.Properties = string(propsJSON)
+	return result, nil
+}
+
+// Update sets changed Nova properties before updating the instance, so a
+// rejected value leaves the instance unchanged. Properties that are not
+// declared are left as they are on the server.
+func (s *serverProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	var desired, prior map[string]interface{}
+	if err := json.Unmarshal(request.DesiredProperties, &desired); err != nil {
+		return s.Provisioner.Update(ctx, request)
+	}
+	if len(request.PriorProperties) > 0 {
+		if err := json.Unmarshal(request.PriorProperties, &prior); err != nil {
+			return s.Provisioner.Update(ctx, request)
+		}
+	}
+
+	spec, err := s.parseSpec(desired)
+	if err != nil {
+		return serverUpdateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+	// Prior properties were read back without the defaults, which are
+	// merged in again to compare what is on the server
+	priorSpec, _ := s.parseSpec(prior)
+
+	if !spec.declared() {
+		return s.Provisioner.Update(ctx, request)
+	}
+	client, err := s.client()
+	if err != nil {
+		return serverUpdateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	progress := &resource.ProgressResult{NativeID: request.NativeID}
+	if code, message := s.apply(ctx, client, progress, spec, priorSpec); code != "" {
+		return serverUpdateFailure(request.NativeID, code, message), nil
+	}
+
+	result, err := s.Provisioner.Update(ctx, request)
+	if err != nil || result == nil || result.ProgressResult == nil {
+		return result, err
+	}
+	s.withServerProperties(result.ProgressResult, spec)
+	return result, nil
+}
+
+// ResolveName imports instances by name through the wrapped provisioner
+func (s *serverProvisioner) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	resolver, ok := s.Provisioner.(prov.NameResolver)
+	if !ok {
+		return nil, fmt.Errorf("%s cannot be imported by name, import it by native ID", request.ResourceType)
+	}
+	return resolver.ResolveName(ctx, request, name)
+}
+
+// parseSpec reads the Nova properties of an instance with the target's
+// metadata policy applied
+func (s *serverProvisioner) parseSpec(props map[string]interface{}) (serverSpec, error) {
+	spec, err := parseServerSpec(props)
+	if err != nil {
+		return spec, err
+	}
+	return spec, s.policy.apply(&spec)
+}
+
+// client returns the OpenStack client, failing when the target has none
+func (s *serverProvisioner) client() (*openstacktransport.Client, error) {
+	client, err := s.openStack()
+	if err != nil {
+		return nil, fmt.Errorf("instance metadata and tags need OpenStack credentials: %w", err)
+	}
+	if client == nil {
+		return nil, fmt.Errorf("instance metadata and tags need OpenStack credentials; set OS_AUTH_URL and the other OS_* variables")
+	}
+	return client, nil
+}
+
+// apply sets the Nova properties of spec that differ from prior on the
+// instance of progress, and adds them to its properties. It returns an error
+// code and message on failure.
+func (s *serverProvisioner) apply(ctx context.Context, client *openstacktransport.Client, progress *resource.ProgressResult, spec, prior serverSpec) (resource.OperationErrorCode, string) {
+	computeClient, err := client.ComputeClientFor(spec.region)
+	if err != nil {
+		return resources.MapOpenStackErrorToOperationErrorCode(err), err.Error()
+	}
+	id := serverID(progress.NativeID)
+
+	if spec.metadata != nil && !reflect.DeepEqual(spec.metadata, prior.metadata) {
+		// Replacing the whole set deletes the keys no longer declared
+		_, err := servers.ResetMetadata(ctx, computeClient, id, servers.MetadataOpts(spec.metadata)).Extract()
+		if err != nil {
+			return resources.MapOpenStackErrorToOperationErrorCode(err), fmt.Sprintf("failed to set metadata: %s", resources.OpenStackErrorMessage(err))
+		}
+	}
+
+	if spec.tags != nil && !slices.Equal(spec.tags, prior.tags) {
+		_, err := tags.ReplaceAll(ctx, openstacktransport.ServerTagsClient(computeClient), id, tags.ReplaceAllOpts{Tags: spec.tags}).Extract()
+		if err != nil {
+			return resources.MapOpenStackErrorToOperationErrorCode(err), fmt.Sprintf("failed to set tags: %s", resources.OpenStackErrorMessage(err))
+		}
+	}
+
+	s.withServerProperties(progress, spec)
+	return "", ""
+}
+
+// withServerProperties adds the Nova properties of spec to the resource
+// properties of progress, when it has any, leaving out metadata defaults
+func (s *serverProvisioner) withServerProperties(progress *resource.ProgressResult, spec serverSpec) {
+	if len(progress.ResourceProperties) == 0 {
+		return
+	}
+	var props map[string]interface{}
+	if err := json.Unmarshal(progress.ResourceProperties, &props); err != nil {
+		return
+	}
+	if metadata := s.policy.strip(spec.metadata); len(metadata) > 0 {
+		props["metadata"] = metadata
+	}
+	if len(spec.tags) > 0 {
+		props["tags"] = spec.tags
+	}
+	if propsJSON, err := json.Marshal(props); err == nil {
+		progress.ResourceProperties = propsJSON
+	}
+}
+
+// readServer adds the Nova properties of server id to props, leaving out
+// the metadata that holds the defaults of policy
+func readServer(ctx context.Context, client *openstacktransport.Client, region, id string, props map[string]interface{}, policy MetadataPolicy) error {
+	computeClient, err := client.ComputeClientFor(region)
+	if err != nil {
+		return err
+	}
+	metadata, err := servers.Metadata(ctx, computeClient, id).Extract()
+	if err != nil {
+		return err
+	}
+	if metadata := policy.strip(metadata); len(metadata) > 0 {
+		props["metadata"] = metadata
+	}
+
+	serverTags, err := tags.List(ctx, openstacktransport.ServerTagsClient(computeClient), id).Extract()
+	if err != nil {
+		return err
+	}
+	if len(serverTags) > 0 {
+		slices.Sort(serverTags)
+		props["tags"] = serverTags
+	}
+	return nil
+}
+
+func serverCreateFailure(errorCode resource.OperationErrorCode, message string) *resource.CreateResult {
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+		},
+	}
+}
+
+func serverUpdateFailure(nativeID string, errorCode resource.OperationErrorCode, message string) *resource.UpdateResult {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			NativeID:        nativeID,
+		},
+	}
+}
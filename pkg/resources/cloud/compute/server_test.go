@@ -0,0 +1,280 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	openstacktransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstance stands in for the OVH API instance provisioner
+type fakeInstance struct {
+	prov.Provisioner
+	created    *resource.ProgressResult
+	status     *resource.ProgressResult
+	properties string
+	updated    int
+}
+
+func (f *fakeInstance) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	return &resource.CreateResult{ProgressResult: f.created}, nil
+}
+
+func (f *fakeInstance) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{ProgressResult: f.status}, nil
+}
+
+func (f *fakeInstance) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	return &resource.ReadResult{Properties: f.properties}, nil
+}
+
+func (f *fakeInstance) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	f.updated++
+	return &resource.UpdateResult{ProgressResult: &resource.ProgressResult{
+		Operation:          resource.OperationUpdate,
+		OperationStatus:    resource.OperationStatusSuccess,
+		NativeID:           request.NativeID,
+		ResourceProperties: json.RawMessage(f.properties),
+	}}, nil
+}
+
+// fakeNova keeps the metadata and tags of servers and serves their Nova APIs
+type fakeNova struct {
+	mu           sync.Mutex
+	metadata     map[string]map[string]string
+	tags         map[string][]string
+	writes       int
+	microversion string
+}
+
+func newFakeNova(t *testing.T) (*fakeNova, OpenStackClientFunc) {
+	t.Helper()
+	nova := &fakeNova{metadata: make(map[string]map[string]string), tags: make(map[string][]string)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nova.mu.Lock()
+		defer nova.mu.Unlock()
+
+		id, api, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/servers/"), "/")
+		var body struct {
+			Metadata map[string]string `json:"metadata"`
+			Tags     []string          `json:"tags"`
+		}
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			nova.writes++
+		}
+		switch api {
+		case "metadata":
+			if r.Method == http.MethodPut {
+				nova.metadata[id] = body.Metadata
+			}
+			body.Metadata = nova.metadata[id]
+		case "tags":
+			nova.microversion = r.Header.Get("X-OpenStack-Nova-API-Version")
+			if r.Method == http.MethodPut {
+				nova.tags[id] = body.Tags
+			}
+			body.Tags = nova.tags[id]
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	client := &openstacktransport.Client{
+		Provider: &gophercloud.ProviderClient{
+			EndpointLocator: func(gophercloud.EndpointOpts) (string, error) {
+				return server.URL + "/", nil
+			},
+		},
+	}
+	return nova, func() (*openstacktransport.Client, error) { return client, nil }
+}
+
+func instanceProps(t *testing.T, props map[string]interface{}) []byte {
+	t.Helper()
+	propsJSON, err := json.Marshal(props)
+	require.NoError(t, err)
+	return propsJSON
+}
+
+func TestServerProvisioner_AppliesMetadataOnceReady(t *testing.T) {
+	nova, openStack := newFakeNova(t)
+	inner := &fakeInstance{
+		created: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusInProgress,
+			NativeID:        "p1/srv-ready",
+		},
+		status: &resource.ProgressResult{
+			Operation:          resource.OperationCheckStatus,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           "p1/srv-ready",
+			ResourceProperties: json.RawMessage(`{"name":"web","region":"GRA11"}`),
+		},
+	}
+	p := WithServerProperties(inner, openStack, MetadataPolicy{})
+
+	props := instanceProps(t, map[string]interface{}{
+		"name":     "web",
+		"region":   "GRA11",
+		"metadata": map[string]interface{}{"role": "web"},
+	})
+	created, err := p.Create(context.Background(), &resource.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, created.ProgressResult.OperationStatus)
+	assert.Zero(t, nova.writes, "metadata must wait for the instance to be ready")
+
+	status, err := p.Status(context.Background(), &resource.StatusRequest{NativeID: "p1/srv-ready"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, status.ProgressResult.OperationStatus)
+	assert.Equal(t, map[string]string{"role": "web"}, nova.metadata["srv-ready"])
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(status.ProgressResult.ResourceProperties, &got))
+	assert.Equal(t, map[string]interface{}{"role": "web"}, got["metadata"])
+
+	// Applied once: a later poll has nothing queued
+	_, err = p.Status(context.Background(), &resource.StatusRequest{NativeID: "p1/srv-ready"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, nova.writes)
+}
+
+func TestServerProvisioner_UpdateRemovesDroppedKeys(t *testing.T) {
+	nova, openStack := newFakeNova(t)
+	nova.metadata["srv-1"] = map[string]string{"role": "web", "owner": "ops"}
+	inner := &fakeInstance{properties: `{"name":"web","region":"GRA11"}`}
+	p := WithServerProperties(inner, openStack, MetadataPolicy{})
+
+	result, err := p.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "p1/srv-1",
+		PriorProperties:   instanceProps(t, map[string]interface{}{"region": "GRA11", "metadata": map[string]interface{}{"role": "web", "owner": "ops"}}),
+		DesiredProperties: instanceProps(t, map[string]interface{}{"region": "GRA11", "metadata": map[string]interface{}{"role": "web"}}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, map[string]string{"role": "web"}, nova.metadata["srv-1"])
+	assert.Equal(t, 1, inner.updated)
+}
+
+func TestServerProvisioner_UpdateUnchangedMetadata(t *testing.T) {
+	nova, openStack := newFakeNova(t)
+	inner := &fakeInstance{properties: `{"name":"web"}`}
+	p := WithServerProperties(inner, openStack, MetadataPolicy{})
+
+	props := instanceProps(t, map[string]interface{}{"metadata": map[string]interface{}{"role": "web"}})
+	_, err := p.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "p1/srv-1",
+		PriorProperties:   props,
+		DesiredProperties: props,
+	})
+	require.NoError(t, err)
+	assert.Zero(t, nova.writes)
+}
+
+func TestServerProvisioner_ReadAddsMetadata(t *testing.T) {
+	nova, openStack := newFakeNova(t)
+	nova.metadata["srv-1"] = map[string]string{"role": "web"}
+	p := WithServerProperties(&fakeInstance{properties: `{"name":"web","region":"GRA11"}`}, openStack, MetadataPolicy{})
+
+	result, err := p.Read(context.Background(), &resource.ReadRequest{NativeID: "p1/srv-1"})
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result.Properties), &got))
+	assert.Equal(t, map[string]interface{}{"role": "web"}, got["metadata"])
+}
+
+func TestServerProvisioner_MetadataNeedsOpenStack(t *testing.T) {
+	inner := &fakeInstance{}
+	p := WithServerProperties(inner, func() (*openstacktransport.Client, error) { return nil, nil }, MetadataPolicy{})
+
+	result, err := p.Create(context.Background(), &resource.CreateRequest{
+		Properties: instanceProps(t, map[string]interface{}{"metadata": map[string]interface{}{"role": "web"}}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+	assert.Equal(t, resource.OperationErrorCodeInvalidRequest, result.ProgressResult.ErrorCode)
+}
+
+func TestParseServerSpec(t *testing.T) {
+	spec, err := parseServerSpec(map[string]interface{}{"region": "GRA11"})
+	require.NoError(t, err)
+	assert.False(t, spec.declared())
+
+	spec, err = parseServerSpec(map[string]interface{}{"metadata": map[string]interface{}{}})
+	require.NoError(t, err)
+	assert.True(t, spec.declared(), "an empty mapping removes all metadata")
+
+	for _, metadata := range []map[string]interface{}{
+		{"": "x"},
+		{"k": strings.Repeat("v", maxMetadataLength+1)},
+		{"k": 1},
+	} {
+		_, err := parseServerSpec(map[string]interface{}{"metadata": metadata})
+		assert.Error(t, err, "%v", metadata)
+	}
+}
+
+func TestServerProvisioner_ReplacesTags(t *testing.T) {
+	nova, openStack := newFakeNova(t)
+	nova.tags["srv-1"] = []string{"web", "legacy"}
+	inner := &fakeInstance{properties: `{"name":"web","region":"GRA11"}`}
+	p := WithServerProperties(inner, openStack, MetadataPolicy{})
+
+	result, err := p.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "p1/srv-1",
+		PriorProperties:   instanceProps(t, map[string]interface{}{"region": "GRA11", "tags": []interface{}{"legacy", "web"}}),
+		DesiredProperties: instanceProps(t, map[string]interface{}{"region": "GRA11", "tags": []interface{}{"web", "team-a"}}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, []string{"team-a", "web"}, nova.tags["srv-1"])
+	assert.Equal(t, "2.26", nova.microversion)
+	assert.Equal(t, 1, nova.writes, "metadata is not declared and must be left alone")
+
+	read, err := p.Read(context.Background(), &resource.ReadRequest{NativeID: "p1/srv-1"})
+	require.NoError(t, err)
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(read.Properties), &got))
+	assert.Equal(t, []interface{}{"team-a", "web"}, got["tags"])
+}
+
+func TestParseServerSpec_Tags(t *testing.T) {
+	spec, err := parseServerSpec(map[string]interface{}{"tags": []interface{}{"b", "a", "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, spec.tags)
+
+	tooMany := make([]interface{}, maxServerTags+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag-%d", i)
+	}
+	for _, tags := range [][]interface{}{
+		{""},
+		{"a/b"},
+		{"a,b"},
+		{strings.Repeat("t", maxServerTagLen+1)},
+		tooMany,
+	} {
+		_, err := parseServerSpec(map[string]interface{}{"tags": tags})
+		assert.Error(t, err, "%v", tags)
+	}
+}
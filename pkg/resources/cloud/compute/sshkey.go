@@ -0,0 +1,89 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// sshKeyTypes are the public key algorithms accepted for instance login
+var sshKeyTypes = map[string]bool{
+	"ssh-rsa":             true,
+	"ssh-ed25519":         true,
+	"ecdsa-sha2-nistp256": true,
+	"ecdsa-sha2-nistp384": true,
+	"ecdsa-sha2-nistp521": true,
+}
+
+// sshKeyRequestTransformer rejects malformed public keys before they reach
+// the API. Some regions accept any string, leaving instances that cannot
+// be logged into.
+var sshKeyRequestTransformer = base.RequestTransformerFunc(func(props map[string]interface{}, ctx base.TransformContext) (map[string]interface{}, error) {
+	if ctx.Operation == resource.OperationCreate {
+		publicKey, _ := props["publicKey"].(string)
+		if err := validatePublicKey(publicKey); err != nil {
+			return nil, err
+		}
+	}
+	return props, nil
+})
+
+// validatePublicKey checks an OpenSSH public key line: a supported type,
+// a base64 body and an optional comment. The body must decode to a complete
+// key of the same type, which catches truncated keys and line breaks or
+// spaces pasted into the middle of it.
+func validatePublicKey(publicKey string) error {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return fmt.Errorf("publicKey must be an OpenSSH public key (\"<type> <base64> [comment]\")")
+	}
+
+	keyType := fields[0]
+	if !sshKeyTypes[keyType] {
+		return fmt.Errorf("publicKey type %q is not supported, use ssh-rsa, ssh-ed25519 or ecdsa-sha2-nistp256/384/521", keyType)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return fmt.Errorf("publicKey body is not valid base64, check it was not truncated or wrapped: %w", err)
+	}
+
+	parts, err := splitKeyBlob(blob)
+	if err != nil {
+		return fmt.Errorf("publicKey body is incomplete, check it was not truncated: %w", err)
+	}
+	if parts[0] != keyType {
+		return fmt.Errorf("publicKey body holds a %q key but is labelled %q", parts[0], keyType)
+	}
+	return nil
+}
+
+// splitKeyBlob splits an SSH wire-format key into its length-prefixed
+// fields, the first of which is the key type
+func splitKeyBlob(blob []byte) ([]string, error) {
+	var parts []string
+	for len(blob) > 0 {
+		if len(blob) < 4 {
+			return nil, fmt.Errorf("%d trailing bytes", len(blob))
+		}
+		n := binary.BigEndian.Uint32(blob)
+		blob = blob[4:]
+		if uint64(n) > uint64(len(blob)) {
+			return nil, fmt.Errorf("field of %d bytes with only %d left", n, len(blob))
+		}
+		parts = append(parts, string(blob[:n]))
+		blob = blob[n:]
+	}
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("no key material")
+	}
+	return parts, nil
+}
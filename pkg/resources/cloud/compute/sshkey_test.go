@@ -0,0 +1,67 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keyBlob builds an SSH wire-format key from its fields
+func keyBlob(fields ...string) string {
+	var blob []byte
+	for _, field := range fields {
+		blob = binary.BigEndian.AppendUint32(blob, uint32(len(field)))
+		blob = append(blob, field...)
+	}
+	return base64.StdEncoding.EncodeToString(blob)
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	ed25519 := keyBlob("ssh-ed25519", "0123456789abcdef0123456789abcdef")
+	rsa := keyBlob("ssh-rsa", "\x01\x00\x01", "modulus-bytes-modulus-bytes")
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{name: "ed25519 with comment", key: "ssh-ed25519 " + ed25519 + " me@laptop"},
+		{name: "rsa with trailing newline", key: "ssh-rsa " + rsa + "\n"},
+		{name: "empty", key: "", wantErr: "OpenSSH public key"},
+		{name: "unsupported type", key: "ssh-dss " + ed25519, wantErr: "not supported"},
+		{name: "space in body", key: "ssh-ed25519 " + ed25519[:10] + " " + ed25519[10:], wantErr: "base64"},
+		{name: "truncated", key: "ssh-rsa " + rsa[:len(rsa)-8], wantErr: "incomplete"},
+		{name: "mislabelled", key: "ssh-rsa " + ed25519, wantErr: "labelled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePublicKey(tt.key)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSSHKeyRequestTransformer_ValidatesOnCreate(t *testing.T) {
+	props := map[string]interface{}{"name": "laptop", "publicKey": "not a key"}
+
+	_, err := sshKeyRequestTransformer.Transform(props, base.TransformContext{Operation: resource.OperationCreate})
+	assert.Error(t, err)
+
+	body, err := sshKeyRequestTransformer.Transform(props, base.TransformContext{Operation: resource.OperationRead})
+	require.NoError(t, err)
+	assert.Equal(t, props, body)
+}
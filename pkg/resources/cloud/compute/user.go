@@ -0,0 +1,317 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// UserResourceType is the resource type for OpenStack project users.
+const UserResourceType = "OVH::Compute::User"
+
+// User is an OpenStack user scoped to the cloud project:
+// - Create: POST   /cloud/project/{serviceName}/user
+// - Read:   GET    /cloud/project/{serviceName}/user/{userId}
+// - Delete: DELETE /cloud/project/{serviceName}/user/{userId}
+// - List:   GET    /cloud/project/{serviceName}/user
+// The password is only returned by Create. Users are immutable, so there is no Update.
+
+// userProvisioner handles cloud project user operations.
+type userProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &userProvisioner{}
+
+func (p *userProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return userCreateFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project := extractProject(request.TargetConfig)
+	if serviceName, ok := props["serviceName"].(string); ok && serviceName != "" {
+		project = serviceName
+	}
+	if project == "" {
+		return userCreateFailure(resource.OperationErrorCodeInvalidRequest,
+			"serviceName is required"), nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/user", project)
+
+	body := make(map[string]interface{})
+	for _, field := range []string{"description", "role", "roles"} {
+		if v, ok := props[field]; ok && v != nil {
+			body[field] = v
+		}
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   url,
+		Body:   body,
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return userCreateFailure(ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message), nil
+		}
+		return userCreateFailure(resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	userID := userIDString(response.Body["id"])
+	if userID == "" {
+		return userCreateFailure(resource.OperationErrorCodeServiceInternalError,
+			"no user ID in response"), nil
+	}
+
+	// Native ID: project/userId
+	nativeID := fmt.Sprintf("%s/%s", project, userID)
+
+	// Response includes the generated password
+	propsJSON, _ := json.Marshal(response.Body)
+
+	// User creation is async - Status waits for the user to leave "creating"
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusInProgress,
+			NativeID:           nativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *userProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	project, userID, err := parseUserNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/user/%s", project, userID),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+
+	propsJSON, _ := json.Marshal(response.Body)
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+// Update is not supported for cloud project users.
+func (p *userProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       resource.OperationErrorCodeNotUpdatable,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+func (p *userProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	project, userID, err := parseUserNativeID(request.NativeID)
+	if err != nil {
+		return userDeleteFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/cloud/project/%s/user/%s", project, userID),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			// 404 is success for delete (already deleted)
+			if transportErr.Code == ovhtransport.ErrorCodeResourceNotFound {
+				return &resource.DeleteResult{
+					ProgressResult: &resource.ProgressResult{
+						Operation:       resource.OperationDelete,
+						OperationStatus: resource.OperationStatusSuccess,
+						NativeID:        request.NativeID,
+					},
+				}, nil
+			}
+			return userDeleteFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return userDeleteFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+func (p *userProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	project := extractProject(request.TargetConfig)
+	if serviceName := request.AdditionalProperties["serviceName"]; serviceName != "" {
+		project = serviceName
+	}
+	if project == "" {
+		return &resource.ListResult{NativeIDs: nil}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/user", project),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, item := range response.BodyArray {
+		if user, ok := item.(map[string]interface{}); ok {
+			if userID := userIDString(user["id"]); userID != "" {
+				nativeIDs = append(nativeIDs, fmt.Sprintf("%s/%s", project, userID))
+			}
+		}
+	}
+
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
+
+// Status waits for the user to leave the "creating" state.
+func (p *userProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	project, userID, err := parseUserNativeID(request.NativeID)
+	if err != nil {
+		return userStatusFailure(request, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/user/%s", project, userID),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return userStatusFailure(request, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return userStatusFailure(request, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	status, _ := response.Body["status"].(string)
+	switch status {
+	case "creating":
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusInProgress,
+				StatusMessage:   fmt.Sprintf("User status: %s", status),
+				RequestID:       request.RequestID,
+				NativeID:        request.NativeID,
+			},
+		}, nil
+	case "deleted", "deleting":
+		return userStatusFailure(request, resource.OperationErrorCodeNotFound,
+			fmt.Sprintf("User status: %s", status)), nil
+	}
+
+	// Properties are deliberately not returned: GET omits the password, and
+	// returning them here would replace the ones captured at Create time.
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// parseUserNativeID parses "project/userId" format
+func parseUserNativeID(nativeID string) (project, userID string, err error) {
+	parts := strings.SplitN(nativeID, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid user native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], nil
+}
+
+// userIDString converts a user ID to a string. OVH returns numeric user IDs.
+func userIDString(v interface{}) string {
+	switch id := v.(type) {
+	case string:
+		return id
+	case float64:
+		return fmt.Sprintf("%.0f", id)
+	default:
+		return ""
+	}
+}
+
+func userCreateFailure(errorCode resource.OperationErrorCode, message string) *resource.CreateResult {
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+		},
+	}
+}
+
+func userDeleteFailure(nativeID string, errorCode resource.OperationErrorCode, message string) *resource.DeleteResult {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			NativeID:        nativeID,
+		},
+	}
+}
+
+func userStatusFailure(request *resource.StatusRequest, errorCode resource.OperationErrorCode, message string) *resource.StatusResult {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}
+}
+
+func init() {
+	registry.Register(
+		UserResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+			resource.OperationList,
+			resource.OperationCheckStatus,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &userProvisioner{client: client}
+		},
+	)
+	registry.RegisterSensitive(UserResourceType, "password")
+}
@@ -0,0 +1,126 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newUserAPI serves every call with response and records the request bodies
+func newUserAPI(t *testing.T, response string) (*ovhtransport.Client, *[]map[string]interface{}) {
+	t.Helper()
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ovhtransport.NewClient(&ovhtransport.OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+	})
+	require.NoError(t, err)
+	return client, &bodies
+}
+
+func TestUserCreate(t *testing.T) {
+	client, bodies := newUserAPI(t, `{"id":42,"username":"user-abc","password":"generated","status":"creating"}`)
+	p := &userProvisioner{client: client}
+
+	props, _ := json.Marshal(map[string]interface{}{
+		"serviceName": "p1",
+		"description": "ci",
+		"role":        "objectstore_operator",
+		"username":    "ignored",
+	})
+	result, err := p.Create(context.Background(), &resource.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "p1/42", result.ProgressResult.NativeID)
+
+	var created map[string]interface{}
+	require.NoError(t, json.Unmarshal(result.ProgressResult.ResourceProperties, &created))
+	assert.Equal(t, "generated", created["password"])
+
+	require.Len(t, *bodies, 1)
+	assert.Equal(t, map[string]interface{}{"description": "ci", "role": "objectstore_operator"}, (*bodies)[0])
+}
+
+func TestUserCreate_RequiresProject(t *testing.T) {
+	p := &userProvisioner{}
+	result, err := p.Create(context.Background(), &resource.CreateRequest{Properties: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+	assert.Equal(t, resource.OperationErrorCodeInvalidRequest, result.ProgressResult.ErrorCode)
+}
+
+func TestUserStatus(t *testing.T) {
+	tests := []struct {
+		status     string
+		wantStatus resource.OperationStatus
+		wantCode   resource.OperationErrorCode
+	}{
+		{status: "creating", wantStatus: resource.OperationStatusInProgress},
+		{status: "ok", wantStatus: resource.OperationStatusSuccess},
+		{status: "deleting", wantStatus: resource.OperationStatusFailure, wantCode: resource.OperationErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			client, _ := newUserAPI(t, fmt.Sprintf(`{"id":42,"status":%q}`, tt.status))
+			p := &userProvisioner{client: client}
+
+			result, err := p.Status(context.Background(), &resource.StatusRequest{RequestID: "req-1", NativeID: "p1/42"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, result.ProgressResult.OperationStatus)
+			assert.Equal(t, tt.wantCode, result.ProgressResult.ErrorCode)
+			// Properties from GET lack the password, so Status never returns them
+			assert.Nil(t, result.ProgressResult.ResourceProperties)
+		})
+	}
+}
+
+func TestUserUpdate_NotUpdatable(t *testing.T) {
+	p := &userProvisioner{}
+	result, err := p.Update(context.Background(), &resource.UpdateRequest{NativeID: "p1/42"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationErrorCodeNotUpdatable, result.ProgressResult.ErrorCode)
+}
+
+func TestParseUserNativeID(t *testing.T) {
+	project, userID, err := parseUserNativeID("p1/42")
+	require.NoError(t, err)
+	assert.Equal(t, "p1", project)
+	assert.Equal(t, "42", userID)
+
+	_, _, err = parseUserNativeID("42")
+	assert.Error(t, err)
+}
+
+func TestUserIDString(t *testing.T) {
+	assert.Equal(t, "42", userIDString(float64(42)))
+	assert.Equal(t, "abc", userIDString("abc"))
+	assert.Equal(t, "", userIDString(nil))
+}
@@ -0,0 +1,103 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// maxUserDataSize is the OpenStack limit on base64-encoded user data, which
+// the OVH API passes through to Nova.
+const maxUserDataSize = 65535
+
+// instanceRequestTransformer checks the boot source and availability zone of
+// a new instance and normalizes user data.
+// userData is sent as plain text and encoded by the API; userDataBase64 is
+// decoded here so the API never receives an already-encoded payload and
+// encodes it twice. hostname is merged into the user data as cloud-config.
+// rescue and rescueImageId are applied through the rescue action, not the
+// instance body.
+var instanceRequestTransformer = base.RequestTransformerFunc(func(props map[string]interface{}, ctx base.TransformContext) (map[string]interface{}, error) {
+	// The boot source is create-only; updates carry the instance as read back
+	if ctx.Operation == resource.OperationCreate {
+		if err := validateBootSource(props); err != nil {
+			return nil, err
+		}
+		if err := validateAvailabilityZone(props, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	userData, err := normalizeUserData(props)
+	if err != nil {
+		return nil, err
+	}
+	// The hostname is create-only; updates carry the instance as read back
+	if hostname, _ := props["hostname"].(string); hostname != "" && ctx.Operation == resource.OperationCreate {
+		if userData, err = withHostname(userData, hostname); err != nil {
+			return nil, err
+		}
+	}
+
+	body := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		switch k {
+		case "userDataBase64", "hostname", "rescue", "rescueImageId", "metadata", "tags":
+			continue
+		}
+		body[k] = v
+	}
+	if userData != "" {
+		body["userData"] = userData
+	}
+	return body, nil
+})
+
+// normalizeUserData returns the plain-text user data from userData or
+// userDataBase64, which are mutually exclusive.
+func normalizeUserData(props map[string]interface{}) (string, error) {
+	raw, _ := props["userData"].(string)
+	encoded, _ := props["userDataBase64"].(string)
+
+	if raw != "" && encoded != "" {
+		return "", fmt.Errorf("userData and userDataBase64 are mutually exclusive")
+	}
+
+	if encoded != "" {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+		if err != nil {
+			return "", fmt.Errorf("userDataBase64 is not valid base64: %w", err)
+		}
+		raw = string(decoded)
+	}
+	if raw == "" {
+		return "", nil
+	}
+
+	if !utf8.ValidString(raw) {
+		return "", fmt.Errorf("user data must be UTF-8 text")
+	}
+	if size := base64.StdEncoding.EncodedLen(len(raw)); size > maxUserDataSize {
+		return "", fmt.Errorf("user data is %d bytes once base64-encoded, over the %d byte OpenStack limit", size, maxUserDataSize)
+	}
+	return raw, nil
+}
+
+// validateBootSource requires a root disk source: an image, or an existing
+// bootable volume (boot from volume).
+func validateBootSource(props map[string]interface{}) error {
+	imageID, _ := props["imageId"].(string)
+	volumeID, _ := props["volumeId"].(string)
+	if imageID == "" && volumeID == "" {
+		return fmt.Errorf("one of imageId or volumeId is required to boot the instance")
+	}
+	return nil
+}
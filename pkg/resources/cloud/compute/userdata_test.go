@@ -0,0 +1,116 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cloudConfig = "#cloud-config\npackages:\n  - nginx\n"
+
+func TestInstanceRequestTransformer_RawUserData(t *testing.T) {
+	body, err := instanceRequestTransformer.Transform(map[string]interface{}{
+		"name":     "web-1",
+		"imageId":  "img-1",
+		"userData": cloudConfig,
+	}, base.TransformContext{})
+	require.NoError(t, err)
+	assert.Equal(t, cloudConfig, body["userData"])
+	assert.Equal(t, "web-1", body["name"])
+}
+
+func TestInstanceRequestTransformer_DecodesBase64(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(cloudConfig))
+	// Wrapped base64, as produced by `base64` without -w0
+	wrapped := encoded[:20] + "\n" + encoded[20:]
+
+	body, err := instanceRequestTransformer.Transform(map[string]interface{}{
+		"volumeId":       "vol-1",
+		"userDataBase64": wrapped,
+	}, base.TransformContext{})
+	require.NoError(t, err)
+	assert.Equal(t, cloudConfig, body["userData"])
+	assert.NotContains(t, body, "userDataBase64")
+}
+
+func TestInstanceRequestTransformer_NoUserData(t *testing.T) {
+	body, err := instanceRequestTransformer.Transform(map[string]interface{}{"name": "web-1", "imageId": "img-1"}, base.TransformContext{})
+	require.NoError(t, err)
+	assert.NotContains(t, body, "userData")
+}
+
+func TestInstanceRequestTransformer_RequiresBootSource(t *testing.T) {
+	create := base.TransformContext{Operation: resource.OperationCreate}
+
+	_, err := instanceRequestTransformer.Transform(map[string]interface{}{"name": "web-1"}, create)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "imageId or volumeId")
+
+	body, err := instanceRequestTransformer.Transform(map[string]interface{}{"name": "web-1", "volumeId": "vol-1"}, create)
+	require.NoError(t, err)
+	assert.Equal(t, "vol-1", body["volumeId"])
+}
+
+func TestInstanceRequestTransformer_UpdateSkipsBootSource(t *testing.T) {
+	// An instance read back with both boot fields, or neither, can still be renamed
+	for _, props := range []map[string]interface{}{
+		{"name": "web-2"},
+		{"name": "web-2", "imageId": "img-1", "volumeId": "vol-1"},
+	} {
+		body, err := instanceRequestTransformer.Transform(props, base.TransformContext{Operation: resource.OperationUpdate})
+		require.NoError(t, err)
+		assert.Equal(t, "web-2", body["name"])
+	}
+}
+
+func TestNormalizeUserData_Rejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		props map[string]interface{}
+		want  string
+	}{
+		{
+			name:  "both fields",
+			props: map[string]interface{}{"userData": cloudConfig, "userDataBase64": "Zm9v"},
+			want:  "mutually exclusive",
+		},
+		{
+			name:  "invalid base64",
+			props: map[string]interface{}{"userDataBase64": "not base64!"},
+			want:  "not valid base64",
+		},
+		{
+			name:  "binary payload",
+			props: map[string]interface{}{"userDataBase64": base64.StdEncoding.EncodeToString([]byte{0xff, 0xfe, 0x00})},
+			want:  "UTF-8",
+		},
+		{
+			name:  "over limit",
+			props: map[string]interface{}{"userData": strings.Repeat("a", 50000)},
+			want:  "over the 65535 byte OpenStack limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := normalizeUserData(tt.props)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestNormalizeUserData_AtLimit(t *testing.T) {
+	// 49149 bytes encode to exactly 65532 base64 bytes
+	_, err := normalizeUserData(map[string]interface{}{"userData": strings.Repeat("a", 49149)})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,168 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// volumeProductPrefix marks block storage products in the availability catalog,
+// e.g. "volume.classic" or "volume.high-speed".
+const volumeProductPrefix = "volume."
+
+// multiattachTypeMarker names the volume types whose volumes can be attached
+// to several instances at once, e.g. "classic-multiattach". OVH selects
+// multiattach through the volume type; the volume body has no flag for it.
+const multiattachTypeMarker = "multiattach"
+
+// encryptedTypeSuffix names the volume types whose volumes are encrypted at
+// rest with LUKS, e.g. "classic-luks". As with multiattach, encryption is
+// selected through the volume type, with keys managed by OVHcloud.
+const encryptedTypeSuffix = "-luks"
+
+// volumeRequestTransformer rejects a volumeType the region does not offer,
+// listing the valid types, instead of letting the API fail opaquely.
+// Only Create is checked, and a catalog that cannot be fetched lets the API decide.
+var volumeRequestTransformer = base.RequestTransformerFunc(func(props map[string]interface{}, ctx base.TransformContext) (map[string]interface{}, error) {
+	props, err := applyMultiattach(props, ctx.Operation)
+	if err != nil {
+		return nil, err
+	}
+	props, err = applyEncrypted(props, ctx.Operation)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeType, _ := props["volumeType"].(string)
+	region, _ := props["region"].(string)
+	if ctx.Operation != resource.OperationCreate || volumeType == "" || region == "" || ctx.Client == nil {
+		return props, nil
+	}
+
+	response, err := ctx.Client.Do(ctx.Ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/capabilities/productAvailability", ctx.Project),
+	})
+	if err != nil {
+		return props, nil
+	}
+
+	valid := availableVolumeTypes(response.Body, region)
+	if len(valid) == 0 {
+		return props, nil
+	}
+	for _, name := range valid {
+		if name == volumeType {
+			return props, nil
+		}
+	}
+	return nil, fmt.Errorf("volume type %q is not available in region %s; valid types are: %s",
+		volumeType, region, strings.Join(valid, ", "))
+})
+
+// availableVolumeTypes returns the sorted volume types offered in region by
+// the products of a productAvailability response.
+func availableVolumeTypes(catalog map[string]interface{}, region string) []string {
+	products, _ := catalog["products"].([]interface{})
+
+	var types []string
+	for _, item := range products {
+		product, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := product["name"].(string)
+		if !strings.HasPrefix(name, volumeProductPrefix) {
+			continue
+		}
+		regions, _ := product["regions"].([]interface{})
+		for _, r := range regions {
+			entry, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if regionName, _ := entry["name"].(string); regionName == region {
+				types = append(types, strings.TrimPrefix(name, volumeProductPrefix))
+				break
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// applyMultiattach requires a multiattach volume type when a new volume asks
+// for multiattach, and drops the flag from the body, which the API does not
+// accept.
+func applyMultiattach(props map[string]interface{}, operation resource.Operation) (map[string]interface{}, error) {
+	multiattach, ok := props["multiattach"]
+	if !ok {
+		return props, nil
+	}
+
+	volumeType, _ := props["volumeType"].(string)
+	if operation == resource.OperationCreate && multiattach == true && !isMultiattachType(volumeType) {
+		return nil, fmt.Errorf("multiattach requires a multiattach volume type such as classic-multiattach, got %q", volumeType)
+	}
+	return withoutProperty(props, "multiattach"), nil
+}
+
+// applyEncrypted requires an encrypted volume type when a new volume asks to
+// be encrypted, and drops the flag from the body, which the API does not
+// accept.
+func applyEncrypted(props map[string]interface{}, operation resource.Operation) (map[string]interface{}, error) {
+	encrypted, ok := props["encrypted"]
+	if !ok {
+		return props, nil
+	}
+
+	volumeType, _ := props["volumeType"].(string)
+	if operation == resource.OperationCreate && encrypted == true && !isEncryptedType(volumeType) {
+		return nil, fmt.Errorf("encrypted requires an encrypted volume type such as classic-luks, got %q", volumeType)
+	}
+	if operation == resource.OperationCreate && encrypted == false && isEncryptedType(volumeType) {
+		return nil, fmt.Errorf("volume type %q is always encrypted; remove encrypted = false or pick a type without -luks", volumeType)
+	}
+	return withoutProperty(props, "encrypted"), nil
+}
+
+// withoutProperty returns a copy of props without key
+func withoutProperty(props map[string]interface{}, key string) map[string]interface{} {
+	body := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		if k != key {
+			body[k] = v
+		}
+	}
+	return body
+}
+
+// volumeResponseTransformer reports whether the volume can be attached to
+// several instances and whether it is encrypted at rest, as read from its type.
+var volumeResponseTransformer = base.ResponseTransformerFunc(func(apiResponse map[string]interface{}, ctx base.TransformContext) map[string]interface{} {
+	if apiResponse == nil {
+		return apiResponse
+	}
+	volumeType, _ := apiResponse["type"].(string)
+	apiResponse["multiattach"] = isMultiattachType(volumeType)
+	apiResponse["encrypted"] = isEncryptedType(volumeType)
+	return apiResponse
+})
+
+// isMultiattachType reports whether volumeType allows multiattach
+func isMultiattachType(volumeType string) bool {
+	return strings.Contains(volumeType, multiattachTypeMarker)
+}
+
+// isEncryptedType reports whether volumeType encrypts volumes at rest
+func isEncryptedType(volumeType string) bool {
+	return strings.HasSuffix(volumeType, encryptedTypeSuffix)
+}
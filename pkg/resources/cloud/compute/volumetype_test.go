@@ -0,0 +1,119 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeRequestTransformer(t *testing.T) {
+	client := &fakeQuotaClient{responses: map[string]map[string]interface{}{
+		"/cloud/project/p1/capabilities/productAvailability": {
+			"products": []interface{}{
+				map[string]interface{}{"name": "volume.classic", "regions": []interface{}{
+					map[string]interface{}{"name": "GRA11"}, map[string]interface{}{"name": "BHS5"},
+				}},
+				map[string]interface{}{"name": "volume.high-speed", "regions": []interface{}{
+					map[string]interface{}{"name": "GRA11"},
+				}},
+				map[string]interface{}{"name": "b2-7", "regions": []interface{}{
+					map[string]interface{}{"name": "GRA11"},
+				}},
+			},
+		},
+	}}
+	ctx := quotaContext(client)
+	ctx.Operation = resource.OperationCreate
+
+	props := map[string]interface{}{"region": "GRA11", "volumeType": "high-speed"}
+	body, err := volumeRequestTransformer(props, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, props, body)
+
+	_, err = volumeRequestTransformer(map[string]interface{}{"region": "BHS5", "volumeType": "high-speed"}, ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `volume type "high-speed" is not available in region BHS5`)
+	assert.Contains(t, err.Error(), "valid types are: classic")
+
+	// Volumes without a type, and regions missing from the catalog, are left to the API
+	_, err = volumeRequestTransformer(map[string]interface{}{"region": "GRA11"}, ctx)
+	assert.NoError(t, err)
+	_, err = volumeRequestTransformer(map[string]interface{}{"region": "SBG5", "volumeType": "classic"}, ctx)
+	assert.NoError(t, err)
+
+	// Updates are not checked
+	ctx.Operation = resource.OperationUpdate
+	_, err = volumeRequestTransformer(map[string]interface{}{"region": "BHS5", "volumeType": "high-speed"}, ctx)
+	assert.NoError(t, err)
+}
+
+func TestVolumeRequestTransformer_CatalogUnavailable(t *testing.T) {
+	ctx := quotaContext(&fakeQuotaClient{})
+	ctx.Operation = resource.OperationCreate
+
+	_, err := volumeRequestTransformer(map[string]interface{}{"region": "GRA11", "volumeType": "bogus"}, ctx)
+	assert.NoError(t, err)
+}
+
+func TestApplyMultiattach(t *testing.T) {
+	body, err := applyMultiattach(map[string]interface{}{"volumeType": "classic-multiattach", "multiattach": true}, resource.OperationCreate)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"volumeType": "classic-multiattach"}, body)
+
+	_, err = applyMultiattach(map[string]interface{}{"volumeType": "classic", "multiattach": true}, resource.OperationCreate)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "multiattach volume type")
+
+	_, err = applyMultiattach(map[string]interface{}{"multiattach": true}, resource.OperationCreate)
+	assert.Error(t, err)
+
+	// Single-attach volumes, and updates carrying the flag read back, only drop it
+	body, err = applyMultiattach(map[string]interface{}{"volumeType": "classic", "multiattach": false}, resource.OperationCreate)
+	require.NoError(t, err)
+	assert.NotContains(t, body, "multiattach")
+	body, err = applyMultiattach(map[string]interface{}{"volumeType": "classic", "multiattach": true}, resource.OperationUpdate)
+	require.NoError(t, err)
+	assert.NotContains(t, body, "multiattach")
+}
+
+func TestApplyEncrypted(t *testing.T) {
+	body, err := applyEncrypted(map[string]interface{}{"volumeType": "high-speed-luks", "encrypted": true}, resource.OperationCreate)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"volumeType": "high-speed-luks"}, body)
+
+	_, err = applyEncrypted(map[string]interface{}{"volumeType": "high-speed", "encrypted": true}, resource.OperationCreate)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "encrypted volume type")
+
+	_, err = applyEncrypted(map[string]interface{}{"volumeType": "classic-luks", "encrypted": false}, resource.OperationCreate)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "always encrypted")
+
+	// Updates carrying the flag read back only drop it
+	body, err = applyEncrypted(map[string]interface{}{"volumeType": "classic", "encrypted": true}, resource.OperationUpdate)
+	require.NoError(t, err)
+	assert.NotContains(t, body, "encrypted")
+
+	body, err = applyEncrypted(map[string]interface{}{"volumeType": "classic"}, resource.OperationCreate)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"volumeType": "classic"}, body)
+}
+
+func TestVolumeResponseTransformer(t *testing.T) {
+	props := volumeResponseTransformer(map[string]interface{}{"id": "vol-1", "type": "classic-multiattach"}, base.TransformContext{})
+	assert.Equal(t, true, props["multiattach"])
+	assert.Equal(t, false, props["encrypted"])
+
+	props = volumeResponseTransformer(map[string]interface{}{"id": "vol-2", "type": "high-speed"}, base.TransformContext{})
+	assert.Equal(t, false, props["multiattach"])
+
+	props = volumeResponseTransformer(map[string]interface{}{"id": "vol-3", "type": "high-speed-gen2-luks"}, base.TransformContext{})
+	assert.Equal(t, true, props["encrypted"])
+}
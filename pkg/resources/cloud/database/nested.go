@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
@@ -26,6 +27,20 @@ type NestedResourceConfig struct {
 	SupportsUpdate bool
 	// StripFields are fields to remove from request body (in URL path)
 	StripFields []string
+	// CreateDefaults fill a missing create field from another property
+	// (e.g., sourceServiceId from clusterId), keyed by the field to fill
+	CreateDefaults map[string]string
+	// CreateOnlyFields are sent on Create but removed from the PUT body
+	CreateOnlyFields []string
+	// UpdatableFields, when set, limits the PUT body to these fields for APIs
+	// that reject create-only fields on update
+	UpdatableFields []string
+	// PreservedFields are fields the API only returns on creation (e.g., generated
+	// passwords). They are carried over from prior properties on Update.
+	PreservedFields []string
+	// ReadyStatus, when set, makes Create and Update return InProgress and Status poll
+	// until the resource reports this status (e.g., "READY")
+	ReadyStatus string
 }
 
 // nestedProvisioner handles nested database resource operations.
@@ -68,6 +83,11 @@ func (p *nestedProvisioner) Create(ctx context.Context, request *resource.Create
 		project, engine, clusterID, p.config.PathSegment)
 
 	body := filterProps(props, p.config.StripFields...)
+	for field, from := range p.config.CreateDefaults {
+		if body[field] == nil && props[from] != nil {
+			body[field] = props[from]
+		}
+	}
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "POST",
@@ -90,10 +110,15 @@ func (p *nestedProvisioner) Create(ctx context.Context, request *resource.Create
 
 	propsJSON, _ := json.Marshal(response.Body)
 
+	operationStatus := resource.OperationStatusSuccess
+	if p.config.ReadyStatus != "" {
+		operationStatus = resource.OperationStatusInProgress
+	}
+
 	return &resource.CreateResult{
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
-			OperationStatus:    resource.OperationStatusSuccess,
+			OperationStatus:    operationStatus,
 			NativeID:           nativeID,
 			ResourceProperties: propsJSON,
 		},
@@ -106,8 +131,7 @@ func (p *nestedProvisioner) Read(ctx context.Context, request *resource.ReadRequ
 		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
 	}
 
-	url := fmt.Sprintf("/cloud/project/%s/database/%s/%s/%s/%s",
-		project, engine, clusterID, p.config.PathSegment, resourceID)
+	url := p.resourcePath(project, engine, clusterID, resourceID)
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "GET",
@@ -149,10 +173,12 @@ func (p *nestedProvisioner) Update(ctx context.Context, request *resource.Update
 		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
 	}
 
-	url := fmt.Sprintf("/cloud/project/%s/database/%s/%s/%s/%s",
-		project, engine, clusterID, p.config.PathSegment, resourceID)
+	url := p.resourcePath(project, engine, clusterID, resourceID)
 
-	body := filterProps(props, p.config.StripFields...)
+	body := filterProps(filterProps(props, p.config.StripFields...), p.config.CreateOnlyFields...)
+	if len(p.config.UpdatableFields) > 0 {
+		body = keepFields(body, p.config.UpdatableFields)
+	}
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "PUT",
@@ -167,12 +193,19 @@ func (p *nestedProvisioner) Update(ctx context.Context, request *resource.Update
 		return updateFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
 	}
 
+	p.preserveFields(request.PriorProperties, response.Body)
+
 	propsJSON, _ := json.Marshal(response.Body)
 
+	operationStatus := resource.OperationStatusSuccess
+	if p.config.ReadyStatus != "" {
+		operationStatus = resource.OperationStatusInProgress
+	}
+
 	return &resource.UpdateResult{
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
-			OperationStatus:    resource.OperationStatusSuccess,
+			OperationStatus:    operationStatus,
 			NativeID:           request.NativeID,
 			ResourceProperties: propsJSON,
 		},
@@ -185,8 +218,7 @@ func (p *nestedProvisioner) Delete(ctx context.Context, request *resource.Delete
 		return deleteFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
 	}
 
-	url := fmt.Sprintf("/cloud/project/%s/database/%s/%s/%s/%s",
-		project, engine, clusterID, p.config.PathSegment, resourceID)
+	url := p.resourcePath(project, engine, clusterID, resourceID)
 
 	_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "DELETE",
@@ -250,16 +282,103 @@ func (p *nestedProvisioner) List(ctx context.Context, request *resource.ListRequ
 
 func (p *nestedProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
 	// Nested resources don't need status polling by default
+	if p.config.ReadyStatus == "" {
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusSuccess,
+				RequestID:       request.RequestID,
+				NativeID:        request.NativeID,
+			},
+		}, nil
+	}
+
+	project, engine, clusterID, resourceID, err := parseNestedNativeID(request.NativeID)
+	if err != nil {
+		return statusFailure(request, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   p.resourcePath(project, engine, clusterID, resourceID),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return statusFailure(request, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return statusFailure(request, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	status := resolveString(response.Body["status"])
+	if status == "ERROR" {
+		return statusFailure(request, resource.OperationErrorCodeServiceInternalError,
+			fmt.Sprintf("%s status: %s", p.config.PathSegment, status)), nil
+	}
+	if status != p.config.ReadyStatus {
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusInProgress,
+				StatusMessage:   fmt.Sprintf("%s status: %s", p.config.PathSegment, status),
+				RequestID:       request.RequestID,
+				NativeID:        request.NativeID,
+			},
+		}, nil
+	}
+
+	propsJSON, _ := json.Marshal(response.Body)
+
 	return &resource.StatusResult{
 		ProgressResult: &resource.ProgressResult{
-			Operation:       resource.OperationCheckStatus,
-			OperationStatus: resource.OperationStatusSuccess,
-			RequestID:       request.RequestID,
-			NativeID:        request.NativeID,
+			Operation:          resource.OperationCheckStatus,
+			OperationStatus:    resource.OperationStatusSuccess,
+			RequestID:          request.RequestID,
+			NativeID:           request.NativeID,
+			ResourceProperties: propsJSON,
 		},
 	}, nil
 }
 
+// resourcePath builds the URL for a single nested resource.
+// The resource ID is escaped since some identifiers contain slashes (e.g., CIDR blocks).
+func (p *nestedProvisioner) resourcePath(project, engine, clusterID, resourceID string) string {
+	return fmt.Sprintf("/cloud/project/%s/database/%s/%s/%s/%s",
+		project, engine, clusterID, p.config.PathSegment, strings.ReplaceAll(resourceID, "/", "%2F"))
+}
+
+// preserveFields copies create-only outputs from prior properties into the response body
+func (p *nestedProvisioner) preserveFields(priorProperties json.RawMessage, body map[string]interface{}) {
+	if len(p.config.PreservedFields) == 0 || body == nil {
+		return
+	}
+
+	var prior map[string]interface{}
+	if err := json.Unmarshal(priorProperties, &prior); err != nil {
+		return
+	}
+
+	for _, field := range p.config.PreservedFields {
+		if _, ok := body[field]; ok {
+			continue
+		}
+		if v, ok := prior[field]; ok && v != nil {
+			body[field] = v
+		}
+	}
+}
+
+// keepFields returns the entries of body whose keys are in fields
+func keepFields(body map[string]interface{}, fields []string) map[string]interface{} {
+	result := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if v, ok := body[field]; ok {
+			result[field] = v
+		}
+	}
+	return result
+}
+
 // getEngine returns the engine from props or the fixed engine
 func (p *nestedProvisioner) getEngine(props map[string]interface{}) string {
 	if p.config.FixedEngine != "" {
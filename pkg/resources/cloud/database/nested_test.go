@@ -0,0 +1,226 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest is a request received by the fake OVH API
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// newFakeAPI serves every call with the given JSON response and records the requests
+func newFakeAPI(t *testing.T, response map[string]interface{}) (*ovhtransport.Client, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		recorded := recordedRequest{Method: r.Method, Path: r.URL.EscapedPath()}
+		_ = json.NewDecoder(r.Body).Decode(&recorded.Body)
+		requests = append(requests, recorded)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ovhtransport.NewClient(&ovhtransport.OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+	})
+	require.NoError(t, err)
+	return client, &requests
+}
+
+func TestNestedCreate_SendsPasswordAndDefaults(t *testing.T) {
+	client, requests := newFakeAPI(t, map[string]interface{}{"id": "u-1", "password": "secret"})
+	p := newNestedProvisioner(client, NestedResourceConfig{
+		PathSegment:      "user",
+		CreateOnlyFields: []string{"password"},
+		CreateDefaults:   map[string]string{"sourceServiceId": "clusterId"},
+	})
+
+	props, _ := json.Marshal(map[string]interface{}{
+		"serviceName": "p1",
+		"engine":      "mysql",
+		"clusterId":   "c1",
+		"name":        "app",
+		"password":    "chosen",
+	})
+	result, err := p.Create(context.Background(), &resource.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "p1/mysql/c1/u-1", result.ProgressResult.NativeID)
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "POST", (*requests)[0].Method)
+	assert.Equal(t, "/cloud/project/p1/database/mysql/c1/user", (*requests)[0].Path)
+	assert.Equal(t, map[string]interface{}{
+		"name":            "app",
+		"password":        "chosen",
+		"sourceServiceId": "c1",
+	}, (*requests)[0].Body)
+}
+
+func TestNestedCreate_ReadyStatusReturnsInProgress(t *testing.T) {
+	client, _ := newFakeAPI(t, map[string]interface{}{"id": "i-1", "status": "PENDING"})
+	p := newNestedProvisioner(client, NestedResourceConfig{PathSegment: "integration", ReadyStatus: "READY"})
+
+	props, _ := json.Marshal(map[string]interface{}{"serviceName": "p1", "engine": "kafka", "clusterId": "c1"})
+	result, err := p.Create(context.Background(), &resource.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, result.ProgressResult.OperationStatus)
+}
+
+func TestNestedUpdate_FiltersBody(t *testing.T) {
+	client, requests := newFakeAPI(t, map[string]interface{}{"id": "u-1", "roles": []interface{}{"admin"}})
+	p := newNestedProvisioner(client, NestedResourceConfig{
+		PathSegment:      "user",
+		SupportsUpdate:   true,
+		CreateOnlyFields: []string{"password"},
+		PreservedFields:  []string{"password"},
+	})
+
+	desired, _ := json.Marshal(map[string]interface{}{
+		"serviceName": "p1",
+		"engine":      "mysql",
+		"clusterId":   "c1",
+		"roles":       []interface{}{"admin"},
+		"password":    "chosen",
+	})
+	prior, _ := json.Marshal(map[string]interface{}{"password": "chosen"})
+	result, err := p.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "p1/mysql/c1/u-1",
+		PriorProperties:   prior,
+		DesiredProperties: desired,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "PUT", (*requests)[0].Method)
+	assert.Equal(t, map[string]interface{}{"roles": []interface{}{"admin"}}, (*requests)[0].Body)
+
+	var props map[string]interface{}
+	require.NoError(t, json.Unmarshal(result.ProgressResult.ResourceProperties, &props))
+	assert.Equal(t, "chosen", props["password"])
+}
+
+func TestNestedUpdate_UpdatableFields(t *testing.T) {
+	client, requests := newFakeAPI(t, map[string]interface{}{"name": "pool"})
+	p := newNestedProvisioner(client, NestedResourceConfig{
+		PathSegment:     "connectionPool",
+		SupportsUpdate:  true,
+		UpdatableFields: []string{"databaseId", "mode", "size", "userId"},
+	})
+
+	desired, _ := json.Marshal(map[string]interface{}{
+		"serviceName": "p1",
+		"engine":      "postgresql",
+		"clusterId":   "c1",
+		"name":        "pool",
+		"databaseId":  "db-1",
+		"mode":        "session",
+		"size":        float64(10),
+	})
+	_, err := p.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "p1/postgresql/c1/pool-1",
+		DesiredProperties: desired,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, map[string]interface{}{"databaseId": "db-1", "mode": "session", "size": float64(10)},
+		(*requests)[0].Body)
+}
+
+func TestNestedStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		wantStatus resource.OperationStatus
+	}{
+		{name: "ready", status: "READY", wantStatus: resource.OperationStatusSuccess},
+		{name: "pending", status: "PENDING", wantStatus: resource.OperationStatusInProgress},
+		{name: "error", status: "ERROR", wantStatus: resource.OperationStatusFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := newFakeAPI(t, map[string]interface{}{"ip": "10.0.0.0/24", "status": tt.status})
+			p := newNestedProvisioner(client, NestedResourceConfig{
+				PathSegment: "ipRestriction",
+				IDField:     "ip",
+				ReadyStatus: "READY",
+			})
+
+			result, err := p.Status(context.Background(), &resource.StatusRequest{
+				RequestID: "req-1",
+				NativeID:  "p1/mysql/c1/10.0.0.0/24",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, result.ProgressResult.OperationStatus)
+			assert.Equal(t, tt.wantStatus == resource.OperationStatusSuccess,
+				result.ProgressResult.ResourceProperties != nil)
+
+			require.Len(t, *requests, 1)
+			assert.Equal(t, "/cloud/project/p1/database/mysql/c1/ipRestriction/10.0.0.0%2F24", (*requests)[0].Path)
+		})
+	}
+}
+
+func TestNestedStatus_NoReadyStatus(t *testing.T) {
+	client, requests := newFakeAPI(t, nil)
+	p := newNestedProvisioner(client, NestedResourceConfig{PathSegment: "database"})
+
+	result, err := p.Status(context.Background(), &resource.StatusRequest{NativeID: "p1/mysql/c1/db-1"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Empty(t, *requests)
+}
+
+func TestPreserveFields(t *testing.T) {
+	p := newNestedProvisioner(nil, NestedResourceConfig{PreservedFields: []string{"password"}})
+
+	body := map[string]interface{}{"id": "u-1"}
+	p.preserveFields(json.RawMessage(`{"password":"old"}`), body)
+	assert.Equal(t, "old", body["password"])
+
+	body = map[string]interface{}{"id": "u-1", "password": "new"}
+	p.preserveFields(json.RawMessage(`{"password":"old"}`), body)
+	assert.Equal(t, "new", body["password"], "a value returned by the API wins")
+
+	body = map[string]interface{}{"id": "u-1"}
+	p.preserveFields(json.RawMessage(`{"password":null}`), body)
+	assert.NotContains(t, body, "password")
+
+	body = map[string]interface{}{"id": "u-1"}
+	p.preserveFields(nil, body)
+	assert.NotContains(t, body, "password")
+}
+
+func TestKeepFields(t *testing.T) {
+	body := map[string]interface{}{"name": "pool", "mode": "session", "size": 10}
+	assert.Equal(t, map[string]interface{}{"mode": "session", "size": 10},
+		keepFields(body, []string{"mode", "size", "missing"}))
+}
@@ -45,6 +45,8 @@ func init() {
 	// User
 	// POST /cloud/project/{serviceName}/database/{engine}/{clusterId}/user
 	// Supports Update (PUT) for roles
+	// The password is only returned by Create, so it is never sent back to the
+	// API on Update and is carried over from prior properties instead
 	registry.Register(
 		UserResourceType,
 		[]resource.Operation{
@@ -56,15 +58,20 @@ func init() {
 		},
 		func(client *ovhtransport.Client) prov.Provisioner {
 			return newNestedProvisioner(client, NestedResourceConfig{
-				PathSegment:    "user",
-				SupportsUpdate: true,
+				PathSegment:      "user",
+				SupportsUpdate:   true,
+				CreateOnlyFields: []string{"password"},
+				PreservedFields:  []string{"password"},
 			})
 		},
 	)
+	registry.RegisterSensitive(UserResourceType, "password")
 
 	// Integration
 	// POST /cloud/project/{serviceName}/database/{engine}/{clusterId}/integration
 	// No Update support
+	// sourceServiceId defaults to the cluster the integration is created on
+	// Status waits until the integration reports READY
 	registry.Register(
 		IntegrationResourceType,
 		[]resource.Operation{
@@ -72,11 +79,14 @@ func init() {
 			resource.OperationRead,
 			resource.OperationDelete,
 			resource.OperationList,
+			resource.OperationCheckStatus,
 		},
 		func(client *ovhtransport.Client) prov.Provisioner {
 			return newNestedProvisioner(client, NestedResourceConfig{
 				PathSegment:    "integration",
 				SupportsUpdate: false,
+				CreateDefaults: map[string]string{"sourceServiceId": "clusterId"},
+				ReadyStatus:    "READY",
 			})
 		},
 	)
@@ -85,6 +95,7 @@ func init() {
 	// POST /cloud/project/{serviceName}/database/{engine}/{clusterId}/ipRestriction
 	// Identifier is "ip" not "id"
 	// Supports Update (PUT)
+	// Status waits until the cluster has applied the ACL
 	registry.Register(
 		IpRestrictionResourceType,
 		[]resource.Operation{
@@ -93,12 +104,14 @@ func init() {
 			resource.OperationUpdate,
 			resource.OperationDelete,
 			resource.OperationList,
+			resource.OperationCheckStatus,
 		},
 		func(client *ovhtransport.Client) prov.Provisioner {
 			return newNestedProvisioner(client, NestedResourceConfig{
 				PathSegment:    "ipRestriction",
 				IDField:        "ip",
 				SupportsUpdate: true,
+				ReadyStatus:    "READY",
 			})
 		},
 	)
@@ -151,7 +164,8 @@ func init() {
 	// PostgresqlConnectionPool
 	// POST /cloud/project/{serviceName}/database/postgresql/{clusterId}/connectionPool
 	// Fixed engine: postgresql
-	// Supports Update (PUT)
+	// Supports Update (PUT) of databaseId, mode, size and userId; name is create-only.
+	// Read returns the pooler's uri, port and sslMode for applications to connect to.
 	registry.Register(
 		PostgresqlConnectionPoolResourceType,
 		[]resource.Operation{
@@ -163,10 +177,11 @@ func init() {
 		},
 		func(client *ovhtransport.Client) prov.Provisioner {
 			return newNestedProvisioner(client, NestedResourceConfig{
-				PathSegment:    "connectionPool",
-				FixedEngine:    "postgresql",
-				SupportsUpdate: true,
-				StripFields:    []string{"serviceName", "clusterId"},
+				PathSegment:     "connectionPool",
+				FixedEngine:     "postgresql",
+				SupportsUpdate:  true,
+				StripFields:     []string{"serviceName", "clusterId"},
+				UpdatableFields: []string{"databaseId", "mode", "size", "userId"},
 			})
 		},
 	)
@@ -9,8 +9,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
 	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
@@ -19,6 +21,10 @@ import (
 // ServiceResourceType is the resource type for database services/clusters.
 const ServiceResourceType = "OVH::Database::Service"
 
+// serviceStatusBackoff spaces out status checks during cluster builds,
+// which commonly take 10-15 minutes.
+var serviceStatusBackoff = base.NewStatusBackoff(30*time.Second, 2*time.Minute)
+
 // serviceProvisioner handles database service operations.
 // Service has special path: /cloud/project/{project}/database/{engine}[/{clusterId}]
 type serviceProvisioner struct {
@@ -224,6 +230,10 @@ func (p *serviceProvisioner) Status(ctx context.Context, request *resource.Statu
 		return statusFailure(request, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
 	}
 
+	if due, result := serviceStatusBackoff.Due(request); !due {
+		return result, nil
+	}
+
 	url := fmt.Sprintf("/cloud/project/%s/database/%s/%s", project, engine, clusterID)
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
@@ -231,6 +241,7 @@ func (p *serviceProvisioner) Status(ctx context.Context, request *resource.Statu
 		Path:   url,
 	})
 	if err != nil {
+		serviceStatusBackoff.Done(request)
 		if transportErr, ok := err.(*ovhtransport.Error); ok {
 			return statusFailure(request, ovhtransport.ToResourceErrorCode(transportErr.Code),
 				transportErr.Message), nil
@@ -238,20 +249,30 @@ func (p *serviceProvisioner) Status(ctx context.Context, request *resource.Statu
 		return statusFailure(request, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
 	}
 
-	// Check if service is READY
 	status, _ := response.Body["status"].(string)
-	if status != "READY" {
+	switch phase := serviceStatusPhase(status); phase {
+	case servicePhaseFailed:
+		serviceStatusBackoff.Done(request)
+		return statusFailure(request, resource.OperationErrorCodeGeneralServiceException,
+			fmt.Sprintf("Service status: %s, the cluster failed and needs attention in the OVH console", status)), nil
+	case servicePhaseBuilding, servicePhaseBusy:
+		serviceStatusBackoff.Pending(request)
+		message := fmt.Sprintf("Service status: %s", status)
+		if phase == servicePhaseBusy {
+			message += ", the cluster is healthy and waiting for maintenance or an update to finish"
+		}
 		return &resource.StatusResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCheckStatus,
 				OperationStatus: resource.OperationStatusInProgress,
-				StatusMessage:   fmt.Sprintf("Service status: %s", status),
+				StatusMessage:   message,
 				RequestID:       request.RequestID,
 				NativeID:        request.NativeID,
 			},
 		}, nil
 	}
 
+	serviceStatusBackoff.Done(request)
 	propsJSON, _ := json.Marshal(response.Body)
 
 	return &resource.StatusResult{
@@ -265,6 +286,33 @@ func (p *serviceProvisioner) Status(ctx context.Context, request *resource.Statu
 	}, nil
 }
 
+// servicePhase groups the statuses a database cluster reports
+type servicePhase int
+
+const (
+	servicePhaseReady servicePhase = iota
+	// The cluster is being created
+	servicePhaseBuilding
+	// A healthy cluster undergoing maintenance, an update or a reboot
+	servicePhaseBusy
+	servicePhaseFailed
+)
+
+// serviceStatusPhase classifies a cluster status. Unknown statuses are
+// treated as building, so Status keeps polling instead of failing.
+func serviceStatusPhase(status string) servicePhase {
+	switch status {
+	case "READY":
+		return servicePhaseReady
+	case "UPDATING", "MAINTENANCE", "REBOOTING", "LOCKED", "LOCKED_PENDING", "LOCKED_UPDATING":
+		return servicePhaseBusy
+	case "ERROR", "ERROR_INCONSISTENT_SPEC":
+		return servicePhaseFailed
+	default:
+		return servicePhaseBuilding
+	}
+}
+
 // parseServiceNativeID parses "project/engine/clusterId" format
 func parseServiceNativeID(nativeID string) (project, engine, clusterID string, err error) {
 	parts := strings.SplitN(nativeID, "/", 3)
@@ -0,0 +1,44 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceStatus(t *testing.T) {
+	tests := []struct {
+		status      string
+		wantStatus  resource.OperationStatus
+		wantMessage string
+	}{
+		{status: "READY", wantStatus: resource.OperationStatusSuccess},
+		{status: "CREATING", wantStatus: resource.OperationStatusInProgress, wantMessage: "Service status: CREATING"},
+		{status: "MAINTENANCE", wantStatus: resource.OperationStatusInProgress, wantMessage: "healthy"},
+		{status: "UPDATING", wantStatus: resource.OperationStatusInProgress, wantMessage: "healthy"},
+		{status: "REBOOTING", wantStatus: resource.OperationStatusInProgress, wantMessage: "healthy"},
+		{status: "ERROR", wantStatus: resource.OperationStatusFailure, wantMessage: "failed"},
+		{status: "ERROR_INCONSISTENT_SPEC", wantStatus: resource.OperationStatusFailure, wantMessage: "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			client, _ := newFakeAPI(t, map[string]interface{}{"id": "c1", "status": tt.status})
+			p := &serviceProvisioner{client: client}
+
+			result, err := p.Status(context.Background(), &resource.StatusRequest{
+				RequestID: "req-" + tt.status,
+				NativeID:  "p1/mysql/c1",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, result.ProgressResult.OperationStatus)
+			assert.Contains(t, result.ProgressResult.StatusMessage, tt.wantMessage)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package dedicated
+
+import (
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// filterProps returns a copy of props with only the specified keys
+func filterProps(props map[string]interface{}, keys ...string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, k := range keys {
+		if v, ok := props[k]; ok && v != nil {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// createFailure creates a failure result for Create operations
+func createFailure(errorCode resource.OperationErrorCode, message string) *resource.CreateResult {
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+		},
+	}
+}
+
+// updateFailure creates a failure result for Update operations
+func updateFailure(nativeID string, errorCode resource.OperationErrorCode, message string) *resource.UpdateResult {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			NativeID:        nativeID,
+		},
+	}
+}
+
+// statusFailure creates a failure result for Status operations
+func statusFailure(request *resource.StatusRequest, errorCode resource.OperationErrorCode, message string) *resource.StatusResult {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}
+}
+
+// transportErrorCode maps a transport error to an operation error code and message
+func transportErrorCode(err error) (resource.OperationErrorCode, string) {
+	if transportErr, ok := err.(*ovhtransport.Error); ok {
+		return ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message
+	}
+	return resource.OperationErrorCodeServiceInternalError, err.Error()
+}
+
+// isNotFound reports whether err is a transport NotFound error
+func isNotFound(err error) bool {
+	transportErr, ok := err.(*ovhtransport.Error)
+	return ok && transportErr.Code == ovhtransport.ErrorCodeResourceNotFound
+}
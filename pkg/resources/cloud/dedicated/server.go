@@ -0,0 +1,303 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package dedicated
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// ServerResourceType is the resource type for a dedicated (bare-metal) server.
+const ServerResourceType = "OVH::Dedicated::Server"
+
+// serverWritableFields are the fields accepted by PUT /dedicated/server/{serviceName}
+var serverWritableFields = []string{"bootId", "monitoring", "noIntervention", "rescueMail"}
+
+// serverProvisioner manages an existing dedicated server.
+// Servers are ordered through the OVH console, so Create adopts one by
+// serviceName and Delete only stops managing it. Changing os reinstalls the
+// server and changing bootId reboots it into the new boot mode.
+// Path: /dedicated/server/{serviceName}
+type serverProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &serverProvisioner{}
+
+func (p *serverProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return createFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	serviceName, _ := props["serviceName"].(string)
+	if serviceName == "" {
+		return createFailure(resource.OperationErrorCodeInvalidRequest, "serviceName is required"), nil
+	}
+
+	reinstalling, body, err := p.apply(ctx, serviceName, props)
+	if err != nil {
+		code, message := transportErrorCode(err)
+		return createFailure(code, message), nil
+	}
+
+	propsJSON, _ := json.Marshal(body)
+
+	status := resource.OperationStatusSuccess
+	if reinstalling {
+		status = resource.OperationStatusInProgress
+	}
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    status,
+			NativeID:           serviceName,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *serverProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	body, err := p.get(ctx, request.NativeID)
+	if err != nil {
+		code, _ := transportErrorCode(err)
+		return &resource.ReadResult{ErrorCode: code}, nil
+	}
+
+	propsJSON, _ := json.Marshal(body)
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+func (p *serverProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.DesiredProperties, &props); err != nil {
+		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	reinstalling, body, err := p.apply(ctx, request.NativeID, props)
+	if err != nil {
+		code, message := transportErrorCode(err)
+		return updateFailure(request.NativeID, code, message), nil
+	}
+
+	propsJSON, _ := json.Marshal(body)
+
+	status := resource.OperationStatusSuccess
+	if reinstalling {
+		status = resource.OperationStatusInProgress
+	}
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    status,
+			NativeID:           request.NativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+// Delete stops managing the server; terminating it is a billing action.
+func (p *serverProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+func (p *serverProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   "/dedicated/server",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dedicated servers: %w", err)
+	}
+
+	var serviceNames []string
+	for _, item := range response.BodyArray {
+		if name, ok := item.(string); ok {
+			serviceNames = append(serviceNames, name)
+		}
+	}
+	return &resource.ListResult{NativeIDs: serviceNames}, nil
+}
+
+// Status follows a reinstall until the install status endpoint stops
+// reporting one, which it does with a 404 once the installation finishes.
+func (p *serverProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/dedicated/server/%s/install/status", request.NativeID),
+	})
+	if err != nil && !isNotFound(err) {
+		code, message := transportErrorCode(err)
+		return statusFailure(request, code, message), nil
+	}
+
+	if err == nil {
+		done, failure, message := installProgress(response.Body)
+		if failure != "" {
+			return statusFailure(request, resource.OperationErrorCodeServiceInternalError,
+				fmt.Sprintf("Reinstall failed: %s", failure)), nil
+		}
+		if !done {
+			return &resource.StatusResult{
+				ProgressResult: &resource.ProgressResult{
+					Operation:       resource.OperationCheckStatus,
+					OperationStatus: resource.OperationStatusInProgress,
+					StatusMessage:   message,
+					RequestID:       request.RequestID,
+					NativeID:        request.NativeID,
+				},
+			}, nil
+		}
+	}
+
+	body, err := p.get(ctx, request.NativeID)
+	if err != nil {
+		code, message := transportErrorCode(err)
+		return statusFailure(request, code, message), nil
+	}
+
+	propsJSON, _ := json.Marshal(body)
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCheckStatus,
+			OperationStatus:    resource.OperationStatusSuccess,
+			RequestID:          request.RequestID,
+			NativeID:           request.NativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+// apply brings the server in line with props and returns its properties.
+// A reinstall reboots the server itself, so bootId changes only trigger a
+// separate reboot when the os is unchanged.
+func (p *serverProvisioner) apply(ctx context.Context, serviceName string, props map[string]interface{}) (bool, map[string]interface{}, error) {
+	current, err := p.get(ctx, serviceName)
+	if err != nil {
+		return false, nil, err
+	}
+
+	url := fmt.Sprintf("/dedicated/server/%s", serviceName)
+
+	body := filterProps(props, serverWritableFields...)
+	if len(body) > 0 {
+		if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "PUT",
+			Path:   url,
+			Body:   body,
+		}); err != nil {
+			return false, nil, err
+		}
+	}
+
+	template, _ := props["os"].(string)
+	reinstalling := template != "" && template != current["os"]
+
+	switch {
+	case reinstalling:
+		if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "POST",
+			Path:   url + "/install/start",
+			Body:   map[string]interface{}{"templateName": template},
+		}); err != nil {
+			return false, nil, err
+		}
+	case bootChanged(props, current):
+		if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "POST",
+			Path:   url + "/reboot",
+			Body:   map[string]interface{}{},
+		}); err != nil {
+			return false, nil, err
+		}
+	}
+
+	updated, err := p.get(ctx, serviceName)
+	if err != nil {
+		return false, nil, err
+	}
+	if reinstalling {
+		// GET still reports the previous os until the install completes
+		updated["os"] = template
+	}
+	return reinstalling, updated, nil
+}
+
+// get fetches the server
+func (p *serverProvisioner) get(ctx context.Context, serviceName string) (map[string]interface{}, error) {
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/dedicated/server/%s", serviceName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// bootChanged reports whether props request a different boot than current
+func bootChanged(props, current map[string]interface{}) bool {
+	desired, ok := props["bootId"]
+	if !ok || desired == nil {
+		return false
+	}
+	return fmt.Sprint(desired) != fmt.Sprint(current["bootId"])
+}
+
+// installProgress summarizes GET .../install/status. It returns the error of
+// the first failed step, or the comment of the step in progress.
+func installProgress(body map[string]interface{}) (done bool, failure string, message string) {
+	steps, _ := body["progress"].([]interface{})
+	done = true
+	for _, s := range steps {
+		step, _ := s.(map[string]interface{})
+		status, _ := step["status"].(string)
+		comment, _ := step["comment"].(string)
+		switch status {
+		case "error":
+			if failure, _ = step["error"].(string); failure == "" {
+				failure = comment
+			}
+			return false, failure, ""
+		case "done":
+		default:
+			if done {
+				message = fmt.Sprintf("Reinstalling: %s", comment)
+			}
+			done = false
+		}
+	}
+	return done, "", message
+}
+
+func init() {
+	registry.Register(
+		ServerResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &serverProvisioner{client: client}
+		},
+	)
+}
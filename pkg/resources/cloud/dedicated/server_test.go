@@ -0,0 +1,69 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package dedicated
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallProgress(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		done    bool
+		failure string
+		message string
+	}{
+		{
+			name:    "in progress",
+			body:    `{"progress":[{"status":"done","comment":"Preparing"},{"status":"doing","comment":"Partitioning"},{"status":"todo","comment":"Rebooting"}]}`,
+			message: "Reinstalling: Partitioning",
+		},
+		{
+			name:    "failed step",
+			body:    `{"progress":[{"status":"done","comment":"Preparing"},{"status":"error","comment":"Partitioning","error":"disk not found"}]}`,
+			failure: "disk not found",
+		},
+		{
+			name: "all done",
+			body: `{"progress":[{"status":"done","comment":"Preparing"},{"status":"done","comment":"Rebooting"}]}`,
+			done: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.body), &body))
+
+			done, failure, message := installProgress(body)
+			assert.Equal(t, tt.done, done)
+			assert.Equal(t, tt.failure, failure)
+			assert.Equal(t, tt.message, message)
+		})
+	}
+}
+
+func TestBootChanged(t *testing.T) {
+	current := map[string]interface{}{"bootId": float64(1)}
+
+	assert.False(t, bootChanged(map[string]interface{}{}, current))
+	assert.False(t, bootChanged(map[string]interface{}{"bootId": float64(1)}, current))
+	assert.True(t, bootChanged(map[string]interface{}{"bootId": float64(22)}, current))
+}
+
+func TestFilterProps(t *testing.T) {
+	props := map[string]interface{}{
+		"serviceName": "ns123.ip-1-2-3.eu",
+		"monitoring":  true,
+		"rescueMail":  nil,
+		"os":          "debian12_64",
+	}
+	assert.Equal(t, map[string]interface{}{"monitoring": true}, filterProps(props, serverWritableFields...))
+}
@@ -0,0 +1,215 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package iplb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// FarmResourceType is the resource type for IP Load Balancing backend farms.
+const FarmResourceType = "OVH::LoadBalancer::Farm"
+
+// farmProvisioner handles IP Load Balancing farm operations.
+// Path: /ipLoadbalancing/{serviceName}/{protocol}/farm[/{farmId}]
+// Native ID: serviceName/protocol/farmId, since farm IDs are scoped per protocol.
+type farmProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &farmProvisioner{}
+
+func (p *farmProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return createFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	serviceName, _ := props["serviceName"].(string)
+	protocol, _ := props["protocol"].(string)
+	if serviceName == "" || !validProtocol(protocol, farmProtocols) {
+		return createFailure(resource.OperationErrorCodeInvalidRequest,
+			"serviceName and protocol (http, tcp or udp) are required"), nil
+	}
+
+	// POST /ipLoadbalancing/{serviceName}/{protocol}/farm
+	url := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm", serviceName, protocol)
+	body := filterProps(props, "serviceName", "protocol")
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   url,
+		Body:   body,
+	})
+	if err != nil {
+		return transportCreateFailure(err), nil
+	}
+
+	farmID := idString(response.Body["farmId"])
+	if farmID == "" {
+		return createFailure(resource.OperationErrorCodeServiceInternalError, "no farm ID in response"), nil
+	}
+	nativeID := fmt.Sprintf("%s/%s/%s", serviceName, protocol, farmID)
+
+	if err := refresh(ctx, p.client, serviceName); err != nil {
+		result := transportCreateFailure(err)
+		result.ProgressResult.NativeID = nativeID
+		result.ProgressResult.StatusMessage = fmt.Sprintf("farm created but refresh failed: %s", result.ProgressResult.StatusMessage)
+		return result, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        nativeID,
+			ResourceProperties: withIdentity(response.Body, map[string]interface{}{
+				"serviceName": serviceName,
+				"protocol":    protocol,
+			}),
+		},
+	}, nil
+}
+
+func (p *farmProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	serviceName, protocol, farmID, err := parseFarmNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s", serviceName, protocol, farmID),
+	})
+	if err != nil {
+		return transportReadFailure(err), nil
+	}
+
+	propsJSON := withIdentity(response.Body, map[string]interface{}{
+		"serviceName": serviceName,
+		"protocol":    protocol,
+	})
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+func (p *farmProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.DesiredProperties, &props); err != nil {
+		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	serviceName, protocol, farmID, err := parseFarmNativeID(request.NativeID)
+	if err != nil {
+		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	url := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s", serviceName, protocol, farmID)
+
+	// Strip identity and immutable fields
+	body := filterProps(props, "serviceName", "protocol", "farmId", "zone")
+
+	if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "PUT",
+		Path:   url,
+		Body:   body,
+	}); err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	if err := refresh(ctx, p.client, serviceName); err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+			ResourceProperties: withIdentity(response.Body, map[string]interface{}{
+				"serviceName": serviceName,
+				"protocol":    protocol,
+			}),
+		},
+	}, nil
+}
+
+func (p *farmProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	serviceName, protocol, farmID, err := parseFarmNativeID(request.NativeID)
+	if err != nil {
+		return deleteFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s", serviceName, protocol, farmID),
+	})
+	if err == nil {
+		err = refresh(ctx, p.client, serviceName)
+	}
+	return deleteResult(request.NativeID, err), nil
+}
+
+func (p *farmProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	serviceNames, err := servicesToList(ctx, p.client, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var nativeIDs []string
+	for _, serviceName := range serviceNames {
+		for _, protocol := range farmProtocols {
+			response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+				Method: "GET",
+				Path:   fmt.Sprintf("/ipLoadbalancing/%s/%s/farm", serviceName, protocol),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s farms of %s: %w", protocol, serviceName, err)
+			}
+			for _, item := range response.BodyArray {
+				if id := idString(item); id != "" {
+					nativeIDs = append(nativeIDs, fmt.Sprintf("%s/%s/%s", serviceName, protocol, id))
+				}
+			}
+		}
+	}
+
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
+
+func (p *farmProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return statusSuccess(request), nil
+}
+
+func init() {
+	registry.Register(
+		FarmResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &farmProvisioner{client: client}
+		},
+	)
+}
@@ -0,0 +1,208 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package iplb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// farmProtocols are the frontend/farm protocols exposed under /ipLoadbalancing/{serviceName}
+var farmProtocols = []string{"http", "tcp", "udp"}
+
+// routeProtocols are the protocols that support routes
+var routeProtocols = []string{"http", "tcp"}
+
+// validProtocol reports whether protocol is one of allowed
+func validProtocol(protocol string, allowed []string) bool {
+	for _, p := range allowed {
+		if protocol == p {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFarmNativeID parses "serviceName/protocol/farmId" format
+func parseFarmNativeID(nativeID string) (serviceName, protocol, farmID string, err error) {
+	parts := strings.Split(nativeID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || !validProtocol(parts[1], farmProtocols) {
+		return "", "", "", fmt.Errorf("invalid farm native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// parseServerNativeID parses "serviceName/protocol/farmId/serverId" format
+func parseServerNativeID(nativeID string) (serviceName, protocol, farmID, serverID string, err error) {
+	parts := strings.Split(nativeID, "/")
+	if len(parts) != 4 || parts[0] == "" || parts[2] == "" || parts[3] == "" || !validProtocol(parts[1], farmProtocols) {
+		return "", "", "", "", fmt.Errorf("invalid farm server native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+// parseRouteNativeID parses "serviceName/protocol/routeId" format
+func parseRouteNativeID(nativeID string) (serviceName, protocol, routeID string, err error) {
+	parts := strings.Split(nativeID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || !validProtocol(parts[1], routeProtocols) {
+		return "", "", "", fmt.Errorf("invalid route native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// idString renders a numeric ID (from an API response or a resolved
+// property) as a string
+func idString(v interface{}) string {
+	switch id := v.(type) {
+	case float64:
+		return fmt.Sprintf("%.0f", id)
+	case string:
+		return id
+	}
+	return ""
+}
+
+// filterProps returns a copy of props without the specified keys
+func filterProps(props map[string]interface{}, keys ...string) map[string]interface{} {
+	result := make(map[string]interface{})
+	keySet := make(map[string]bool)
+	for _, k := range keys {
+		keySet[k] = true
+	}
+
+	for k, v := range props {
+		if keySet[k] {
+			continue
+		}
+		if v == nil {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// withIdentity adds the identifying fields that the API omits from its responses
+func withIdentity(body map[string]interface{}, fields map[string]interface{}) json.RawMessage {
+	props := make(map[string]interface{}, len(body)+len(fields))
+	for k, v := range body {
+		props[k] = v
+	}
+	for k, v := range fields {
+		props[k] = v
+	}
+	propsJSON, _ := json.Marshal(props)
+	return propsJSON
+}
+
+// refresh applies pending configuration changes to the load balancer.
+// IP Load Balancing stages farm, server and route changes until refreshed.
+func refresh(ctx context.Context, client *ovhtransport.Client, serviceName string) error {
+	_, err := client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s/refresh", serviceName),
+		Body:   map[string]interface{}{},
+	})
+	return err
+}
+
+// createFailure creates a failure result for Create operations
+func createFailure(errorCode resource.OperationErrorCode, message string) *resource.CreateResult {
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+		},
+	}
+}
+
+// updateFailure creates a failure result for Update operations
+func updateFailure(nativeID string, errorCode resource.OperationErrorCode, message string) *resource.UpdateResult {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			NativeID:        nativeID,
+		},
+	}
+}
+
+// deleteFailure creates a failure result for Delete operations
+func deleteFailure(nativeID string, errorCode resource.OperationErrorCode, message string) *resource.DeleteResult {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       errorCode,
+			StatusMessage:   message,
+			NativeID:        nativeID,
+		},
+	}
+}
+
+// transportCreateFailure converts transport errors to CreateResult
+func transportCreateFailure(err error) *resource.CreateResult {
+	if transportErr, ok := err.(*ovhtransport.Error); ok {
+		return createFailure(ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message)
+	}
+	return createFailure(resource.OperationErrorCodeServiceInternalError, err.Error())
+}
+
+// transportUpdateFailure converts transport errors to UpdateResult
+func transportUpdateFailure(nativeID string, err error) *resource.UpdateResult {
+	if transportErr, ok := err.(*ovhtransport.Error); ok {
+		return updateFailure(nativeID, ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message)
+	}
+	return updateFailure(nativeID, resource.OperationErrorCodeServiceInternalError, err.Error())
+}
+
+// transportReadFailure converts transport errors to ReadResult
+func transportReadFailure(err error) *resource.ReadResult {
+	if transportErr, ok := err.(*ovhtransport.Error); ok {
+		return &resource.ReadResult{ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code)}
+	}
+	return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}
+}
+
+// deleteResult treats NotFound as success, since the resource is already gone
+func deleteResult(nativeID string, err error) *resource.DeleteResult {
+	if err != nil {
+		transportErr, ok := err.(*ovhtransport.Error)
+		if !ok {
+			return deleteFailure(nativeID, resource.OperationErrorCodeServiceInternalError, err.Error())
+		}
+		if transportErr.Code != ovhtransport.ErrorCodeResourceNotFound {
+			return deleteFailure(nativeID, ovhtransport.ToResourceErrorCode(transportErr.Code), transportErr.Message)
+		}
+	}
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        nativeID,
+		},
+	}
+}
+
+// statusSuccess returns success immediately; IP Load Balancing calls are synchronous
+func statusSuccess(request *resource.StatusRequest) *resource.StatusResult {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}
+}
@@ -0,0 +1,61 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package iplb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFarmNativeID(t *testing.T) {
+	serviceName, protocol, farmID, err := parseFarmNativeID("loadbalancer-abc/http/42")
+	require.NoError(t, err)
+	assert.Equal(t, "loadbalancer-abc", serviceName)
+	assert.Equal(t, "http", protocol)
+	assert.Equal(t, "42", farmID)
+
+	for _, nativeID := range []string{"loadbalancer-abc/42", "loadbalancer-abc/ftp/42", "/http/42", "loadbalancer-abc/http/42/7"} {
+		_, _, _, err := parseFarmNativeID(nativeID)
+		assert.Error(t, err, nativeID)
+	}
+}
+
+func TestParseServerNativeID(t *testing.T) {
+	serviceName, protocol, farmID, serverID, err := parseServerNativeID("loadbalancer-abc/udp/42/7")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"loadbalancer-abc", "udp", "42", "7"}, []string{serviceName, protocol, farmID, serverID})
+
+	_, _, _, _, err = parseServerNativeID("loadbalancer-abc/udp/42")
+	assert.Error(t, err)
+}
+
+func TestParseRouteNativeID(t *testing.T) {
+	_, protocol, routeID, err := parseRouteNativeID("loadbalancer-abc/tcp/3")
+	require.NoError(t, err)
+	assert.Equal(t, "tcp", protocol)
+	assert.Equal(t, "3", routeID)
+
+	// UDP frontends have no routes
+	_, _, _, err = parseRouteNativeID("loadbalancer-abc/udp/3")
+	assert.Error(t, err)
+}
+
+func TestIDString(t *testing.T) {
+	assert.Equal(t, "123456", idString(float64(123456)))
+	assert.Equal(t, "42", idString("42"))
+	assert.Equal(t, "", idString(nil))
+}
+
+func TestServerIdentity_FarmIDIsNumeric(t *testing.T) {
+	props := withIdentity(map[string]interface{}{"address": "10.0.0.5"}, serverIdentity("loadbalancer-abc", "http", "42"))
+	assert.JSONEq(t, `{"address":"10.0.0.5","serviceName":"loadbalancer-abc","protocol":"http","farmId":42}`, string(props))
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(props, &decoded))
+	assert.Equal(t, float64(42), decoded["farmId"])
+}
@@ -0,0 +1,217 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package iplb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// ServerResourceType is the resource type for backend servers of an IP Load Balancing farm.
+const ServerResourceType = "OVH::LoadBalancer::FarmServer"
+
+// serverProvisioner handles backend servers of a farm.
+// Path: /ipLoadbalancing/{serviceName}/{protocol}/farm/{farmId}/server[/{serverId}]
+// Native ID: serviceName/protocol/farmId/serverId
+type serverProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &serverProvisioner{}
+
+func (p *serverProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return createFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	serviceName, _ := props["serviceName"].(string)
+	protocol, _ := props["protocol"].(string)
+	farmID := idString(props["farmId"])
+	if serviceName == "" || farmID == "" || !validProtocol(protocol, farmProtocols) {
+		return createFailure(resource.OperationErrorCodeInvalidRequest,
+			"serviceName, protocol (http, tcp or udp) and farmId are required"), nil
+	}
+
+	// POST /ipLoadbalancing/{serviceName}/{protocol}/farm/{farmId}/server
+	url := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s/server", serviceName, protocol, farmID)
+	body := filterProps(props, "serviceName", "protocol", "farmId")
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   url,
+		Body:   body,
+	})
+	if err != nil {
+		return transportCreateFailure(err), nil
+	}
+
+	serverID := idString(response.Body["serverId"])
+	if serverID == "" {
+		return createFailure(resource.OperationErrorCodeServiceInternalError, "no server ID in response"), nil
+	}
+	nativeID := fmt.Sprintf("%s/%s/%s/%s", serviceName, protocol, farmID, serverID)
+
+	if err := refresh(ctx, p.client, serviceName); err != nil {
+		result := transportCreateFailure(err)
+		result.ProgressResult.NativeID = nativeID
+		result.ProgressResult.StatusMessage = fmt.Sprintf("server created but refresh failed: %s", result.ProgressResult.StatusMessage)
+		return result, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: withIdentity(response.Body, serverIdentity(serviceName, protocol, farmID)),
+		},
+	}, nil
+}
+
+func (p *serverProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	serviceName, protocol, farmID, serverID, err := parseServerNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s/server/%s", serviceName, protocol, farmID, serverID),
+	})
+	if err != nil {
+		return transportReadFailure(err), nil
+	}
+
+	propsJSON := withIdentity(response.Body, serverIdentity(serviceName, protocol, farmID))
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+func (p *serverProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.DesiredProperties, &props); err != nil {
+		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	serviceName, protocol, farmID, serverID, err := parseServerNativeID(request.NativeID)
+	if err != nil {
+		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	url := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s/server/%s", serviceName, protocol, farmID, serverID)
+
+	// Strip identity and immutable fields
+	body := filterProps(props, "serviceName", "protocol", "farmId", "serverId", "address", "zone")
+
+	if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "PUT",
+		Path:   url,
+		Body:   body,
+	}); err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	if err := refresh(ctx, p.client, serviceName); err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: withIdentity(response.Body, serverIdentity(serviceName, protocol, farmID)),
+		},
+	}, nil
+}
+
+func (p *serverProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	serviceName, protocol, farmID, serverID, err := parseServerNativeID(request.NativeID)
+	if err != nil {
+		return deleteFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s/server/%s", serviceName, protocol, farmID, serverID),
+	})
+	if err == nil {
+		err = refresh(ctx, p.client, serviceName)
+	}
+	return deleteResult(request.NativeID, err), nil
+}
+
+// List requires serviceName, protocol and farmId, like other nested resources
+func (p *serverProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	serviceName := request.AdditionalProperties["serviceName"]
+	protocol := request.AdditionalProperties["protocol"]
+	farmID := request.AdditionalProperties["farmId"]
+	if serviceName == "" || farmID == "" || !validProtocol(protocol, farmProtocols) {
+		return &resource.ListResult{NativeIDs: nil}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%s/server", serviceName, protocol, farmID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list farm servers: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, item := range response.BodyArray {
+		if id := idString(item); id != "" {
+			nativeIDs = append(nativeIDs, fmt.Sprintf("%s/%s/%s/%s", serviceName, protocol, farmID, id))
+		}
+	}
+
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
+
+func (p *serverProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return statusSuccess(request), nil
+}
+
+// serverIdentity returns the fields locating a server, which the API omits.
+// farmId is kept numeric to match the farm's own farmId.
+func serverIdentity(serviceName, protocol, farmID string) map[string]interface{} {
+	return map[string]interface{}{
+		"serviceName": serviceName,
+		"protocol":    protocol,
+		"farmId":      json.Number(farmID),
+	}
+}
+
+func init() {
+	registry.Register(
+		ServerResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &serverProvisioner{client: client}
+		},
+	)
+}
@@ -0,0 +1,189 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package iplb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// ServiceResourceType is the resource type for an IP Load Balancing service.
+const ServiceResourceType = "OVH::LoadBalancer::IPLoadBalancing"
+
+// serviceProvisioner manages an existing IP Load Balancing service.
+// Services are ordered through the OVH console, so Create adopts one by
+// serviceName and Delete only stops managing it.
+// Path: /ipLoadbalancing/{serviceName}
+type serviceProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &serviceProvisioner{}
+
+func (p *serviceProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return createFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	serviceName, _ := props["serviceName"].(string)
+	if serviceName == "" {
+		return createFailure(resource.OperationErrorCodeInvalidRequest, "serviceName is required"), nil
+	}
+
+	url := fmt.Sprintf("/ipLoadbalancing/%s", serviceName)
+
+	// Apply the desired display name and SSL configuration, if any
+	body := filterProps(props, "serviceName")
+	if len(body) > 0 {
+		if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "PUT",
+			Path:   url,
+			Body:   body,
+		}); err != nil {
+			return transportCreateFailure(err), nil
+		}
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return transportCreateFailure(err), nil
+	}
+
+	propsJSON, _ := json.Marshal(response.Body)
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           serviceName,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *serviceProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/ipLoadbalancing/%s", request.NativeID),
+	})
+	if err != nil {
+		return transportReadFailure(err), nil
+	}
+
+	propsJSON, _ := json.Marshal(response.Body)
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+func (p *serviceProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.DesiredProperties, &props); err != nil {
+		return updateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	url := fmt.Sprintf("/ipLoadbalancing/%s", request.NativeID)
+
+	// Only displayName and sslConfiguration are writable
+	body := filterProps(props, "serviceName", "ipLoadbalancing", "ipv4", "ipv6", "zone",
+		"offer", "state", "vrackEligibility", "vrackName", "metricsToken", "orderableZone")
+
+	if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "PUT",
+		Path:   url,
+		Body:   body,
+	}); err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return transportUpdateFailure(request.NativeID, err), nil
+	}
+
+	propsJSON, _ := json.Marshal(response.Body)
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+// Delete stops managing the service; terminating it is a billing action.
+func (p *serviceProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	return deleteResult(request.NativeID, nil), nil
+}
+
+func (p *serviceProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	serviceNames, err := listServiceNames(ctx, p.client)
+	if err != nil {
+		return nil, err
+	}
+	return &resource.ListResult{NativeIDs: serviceNames}, nil
+}
+
+func (p *serviceProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return statusSuccess(request), nil
+}
+
+// listServiceNames returns every IP Load Balancing service of the account
+func listServiceNames(ctx context.Context, client *ovhtransport.Client) ([]string, error) {
+	response, err := client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   "/ipLoadbalancing",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP load balancers: %w", err)
+	}
+
+	var serviceNames []string
+	for _, item := range response.BodyArray {
+		if name, ok := item.(string); ok {
+			serviceNames = append(serviceNames, name)
+		}
+	}
+	return serviceNames, nil
+}
+
+// servicesToList returns the serviceName filter of a List request, or every service
+func servicesToList(ctx context.Context, client *ovhtransport.Client, request *resource.ListRequest) ([]string, error) {
+	if serviceName := request.AdditionalProperties["serviceName"]; serviceName != "" {
+		return []string{serviceName}, nil
+	}
+	return listServiceNames(ctx, client)
+}
+
+func init() {
+	registry.Register(
+		ServiceResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &serviceProvisioner{client: client}
+		},
+	)
+}
@@ -127,6 +127,41 @@ func (t *gatewayRequestTransformer) Transform(props map[string]interface{}, ctx
 
 var gatewayTransformer = &gatewayRequestTransformer{}
 
+// gatewayResponseTransformer restores network_id and subnet_id from the gateway interfaces.
+// OVH only returns them as interfaces[].networkId/subnetId, so without this Read would
+// drop the network/subnet association the gateway was created with.
+type gatewayResponseTransformer struct{}
+
+func (t *gatewayResponseTransformer) Transform(props map[string]interface{}, ctx base.TransformContext) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range props {
+		result[k] = v
+	}
+
+	interfaces, ok := props["interfaces"].([]interface{})
+	if !ok || len(interfaces) == 0 {
+		return result
+	}
+	iface, ok := interfaces[0].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	if _, exists := result["network_id"]; !exists {
+		if networkID, ok := iface["networkId"].(string); ok {
+			result["network_id"] = networkID
+		}
+	}
+	if _, exists := result["subnet_id"]; !exists {
+		if subnetID, ok := iface["subnetId"].(string); ok {
+			result["subnet_id"] = subnetID
+		}
+	}
+	return result
+}
+
+var gatewayResponseTransformer_ = &gatewayResponseTransformer{}
+
 // gatewayRegistry is separate from cloudNetworkRegistry to use custom API config.
 var gatewayRegistry *base.ResourceRegistry
 
@@ -158,6 +193,8 @@ func init() {
 		},
 		// Strip network_id and subnet_id from request body (used in URL path)
 		RequestTransformer: gatewayTransformer,
+		// Restore network_id and subnet_id from interfaces on Read
+		ResponseTransformer: gatewayResponseTransformer_,
 		// Gateway creation is async - need to poll for status
 		StatusChecker: gatewayStatusChecker,
 		Operations: []resource.Operation{
@@ -5,6 +5,9 @@
 package network
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/cloud"
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
@@ -31,12 +34,17 @@ func gatewayStatusChecker(resourceData map[string]interface{}) (bool, error) {
 		// No status field - consider ready
 		return true, nil
 	}
+	// The gateway API reports lowercase statuses (active, building, error, ...)
+	if strings.EqualFold(status, "error") {
+		return false, fmt.Errorf("gateway is in error state")
+	}
 	// Gateway is ready when status is ACTIVE
-	return status == "ACTIVE", nil
+	return strings.EqualFold(status, "ACTIVE"), nil
 }
 
 // privateNetworkStatusChecker verifies all regions have ACTIVE status.
 // OVH private networks require region activation before subnets can be created.
+// A region in ERROR fails the operation instead of polling until timeout.
 func privateNetworkStatusChecker(resourceData map[string]interface{}) (bool, error) {
 	regions, ok := resourceData["regions"].([]interface{})
 	if !ok {
@@ -50,6 +58,9 @@ func privateNetworkStatusChecker(resourceData map[string]interface{}) (bool, err
 			continue
 		}
 		status, _ := region["status"].(string)
+		if status == "ERROR" {
+			return false, fmt.Errorf("private network activation failed in region %v", region["region"])
+		}
 		if status != "ACTIVE" {
 			// At least one region is not yet active
 			return false, nil
@@ -128,7 +128,9 @@ func (t *subnetPrivateRequestTransformer) Transform(props map[string]interface{}
 var subnetPrivateTransformer = &subnetPrivateRequestTransformer{}
 
 // privateNetworkResponseTransformer simplifies the regions field in the response.
-// OVH API returns regions as [{openstackId, region, status}, ...] but we only need ["DE1", ...]
+// OVH API returns regions as [{openstackId, region, status}, ...]; regions is reduced
+// to ["DE1", ...] to match the schema, and the per-region activation state is kept
+// in regionStatus.
 type privateNetworkResponseTransformer struct{}
 
 func (t *privateNetworkResponseTransformer) Transform(props map[string]interface{}, ctx base.TransformContext) map[string]interface{} {
@@ -142,14 +144,21 @@ func (t *privateNetworkResponseTransformer) Transform(props map[string]interface
 	// Transform regions from [{region: "DE1", ...}, ...] to ["DE1", ...]
 	if regions, ok := props["regions"].([]interface{}); ok {
 		var regionStrings []string
+		var regionStatus []map[string]interface{}
 		for _, r := range regions {
 			if regionObj, ok := r.(map[string]interface{}); ok {
 				if regionName, ok := regionObj["region"].(string); ok {
 					regionStrings = append(regionStrings, regionName)
+					regionStatus = append(regionStatus, map[string]interface{}{
+						"region":      regionName,
+						"status":      regionObj["status"],
+						"openstackId": regionObj["openstackId"],
+					})
 				}
 			}
 		}
 		result["regions"] = regionStrings
+		result["regionStatus"] = regionStatus
 	}
 
 	if id, ok := props["id"].([]interface{}); ok {
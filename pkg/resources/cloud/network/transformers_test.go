@@ -5,6 +5,7 @@
 package network
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
@@ -210,3 +211,122 @@ func TestFloatingIPRequestTransformer(t *testing.T) {
 	// Check that other fields are preserved
 	assert.Equal(t, "192.168.1.100", result["ip"])
 }
+
+func TestGatewayPathBuilder(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      base.PathContext
+		wantPath string
+	}{
+		{
+			name: "Create - POST nested under network/subnet",
+			ctx: base.PathContext{
+				Project:        "my-project",
+				Region:         "GRA7",
+				CustomSegments: []string{"network-123", "subnet-456"},
+			},
+			wantPath: "/cloud/project/my-project/region/GRA7/network/network-123/subnet/subnet-456/gateway",
+		},
+		{
+			name: "List - GET without network/subnet",
+			ctx: base.PathContext{
+				Project: "my-project",
+				Region:  "GRA7",
+			},
+			wantPath: "/cloud/project/my-project/region/GRA7/gateway",
+		},
+		{
+			name: "Read - GET with gateway ID",
+			ctx: base.PathContext{
+				Project:      "my-project",
+				Region:       "GRA7",
+				ResourceName: "gateway-789",
+			},
+			wantPath: "/cloud/project/my-project/region/GRA7/gateway/gateway-789",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPath := gatewayPathBuilder(tt.ctx)
+			assert.Equal(t, tt.wantPath, gotPath)
+		})
+	}
+}
+
+func TestGatewayResponseTransformer(t *testing.T) {
+	transformer := &gatewayResponseTransformer{}
+
+	input := map[string]interface{}{
+		"id":     "gateway-789",
+		"name":   "my-gateway",
+		"model":  "s",
+		"status": "active",
+		"interfaces": []interface{}{
+			map[string]interface{}{
+				"id":        "interface-1",
+				"ip":        "10.0.0.1",
+				"networkId": "network-123",
+				"subnetId":  "subnet-456",
+			},
+		},
+	}
+
+	result := transformer.Transform(input, base.TransformContext{})
+
+	assert.Equal(t, "network-123", result["network_id"])
+	assert.Equal(t, "subnet-456", result["subnet_id"])
+	assert.Equal(t, "my-gateway", result["name"])
+	assert.Equal(t, "s", result["model"])
+}
+
+func TestGatewayResponseTransformer_NoInterfaces(t *testing.T) {
+	transformer := &gatewayResponseTransformer{}
+
+	result := transformer.Transform(map[string]interface{}{"id": "gateway-789"}, base.TransformContext{})
+
+	assert.NotContains(t, result, "network_id")
+	assert.NotContains(t, result, "subnet_id")
+}
+
+func TestPrivateNetworkResponseTransformer(t *testing.T) {
+	input := map[string]interface{}{
+		"id":     "pn-123",
+		"name":   "backbone",
+		"vlanId": float64(42),
+		"regions": []interface{}{
+			map[string]interface{}{"region": "GRA11", "status": "ACTIVE", "openstackId": "os-1"},
+			map[string]interface{}{"region": "BHS5", "status": "BUILDING", "openstackId": "os-2"},
+		},
+	}
+
+	result := privateNetworkResponseTransformer_.Transform(input, base.TransformContext{})
+
+	assert.Equal(t, []string{"GRA11", "BHS5"}, result["regions"])
+	assert.Equal(t, float64(42), result["vlanId"])
+	assert.Equal(t, []map[string]interface{}{
+		{"region": "GRA11", "status": "ACTIVE", "openstackId": "os-1"},
+		{"region": "BHS5", "status": "BUILDING", "openstackId": "os-2"},
+	}, result["regionStatus"])
+}
+
+func TestPrivateNetworkStatusChecker(t *testing.T) {
+	regions := func(statuses ...string) map[string]interface{} {
+		var list []interface{}
+		for i, status := range statuses {
+			list = append(list, map[string]interface{}{"region": fmt.Sprintf("R%d", i), "status": status})
+		}
+		return map[string]interface{}{"regions": list}
+	}
+
+	ready, err := privateNetworkStatusChecker(regions("ACTIVE", "ACTIVE"))
+	require.NoError(t, err)
+	assert.True(t, ready)
+
+	ready, err = privateNetworkStatusChecker(regions("ACTIVE", "BUILDING"))
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	_, err = privateNetworkStatusChecker(regions("ACTIVE", "ERROR"))
+	assert.ErrorContains(t, err, "R1")
+}
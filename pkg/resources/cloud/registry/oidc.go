@@ -229,4 +229,5 @@ func init() {
 			return &oidcProvisioner{client: client}
 		},
 	)
+	registry.RegisterSensitive(OidcResourceType, "oidcClientSecret")
 }
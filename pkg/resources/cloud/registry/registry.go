@@ -115,8 +115,24 @@ func (p *registryProvisioner) Update(ctx context.Context, request *resource.Upda
 
 	url := fmt.Sprintf("/cloud/project/%s/containerRegistry/%s", project, registryID)
 
-	// Strip immutable fields
-	body := filterProps(props, "serviceName", "region")
+	// Plan upgrades have their own endpoint: PUT /containerRegistry/{id}/plan
+	if planID, ok := props["planId"].(string); ok && planID != "" && planID != priorPlanID(request.PriorProperties) {
+		_, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "PUT",
+			Path:   url + "/plan",
+			Body:   map[string]interface{}{"planID": planID},
+		})
+		if err != nil {
+			if transportErr, ok := err.(*ovhtransport.Error); ok {
+				return updateFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+					fmt.Sprintf("failed to change plan: %s", transportErr.Message)), nil
+			}
+			return updateFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+		}
+	}
+
+	// Strip immutable fields; the registry PUT only accepts the name
+	body := filterProps(props, "serviceName", "region", "planId")
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "PUT",
@@ -231,6 +247,10 @@ func (p *registryProvisioner) Status(ctx context.Context, request *resource.Stat
 
 	// Check if registry is READY
 	status, _ := response.Body["status"].(string)
+	if status == "ERROR" {
+		return statusFailure(request, resource.OperationErrorCodeGeneralServiceException,
+			"registry is in ERROR state"), nil
+	}
 	if status != "READY" {
 		return &resource.StatusResult{
 			ProgressResult: &resource.ProgressResult{
@@ -256,6 +276,16 @@ func (p *registryProvisioner) Status(ctx context.Context, request *resource.Stat
 	}, nil
 }
 
+// priorPlanID returns the planId recorded before the update, if any
+func priorPlanID(prior json.RawMessage) string {
+	var props map[string]interface{}
+	if err := json.Unmarshal(prior, &props); err != nil {
+		return ""
+	}
+	planID, _ := props["planId"].(string)
+	return planID
+}
+
 func init() {
 	registry.Register(
 		RegistryResourceType,
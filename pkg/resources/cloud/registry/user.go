@@ -207,4 +207,5 @@ func init() {
 			return &userProvisioner{client: client}
 		},
 	)
+	registry.RegisterSensitive(UserResourceType, "password")
 }
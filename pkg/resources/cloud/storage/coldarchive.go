@@ -0,0 +1,444 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// ColdArchiveResourceType is the resource type for Cold Archive containers.
+const ColdArchiveResourceType = "OVH::Storage::ColdArchive"
+
+// coldArchiveRegion is the only region offering the Cold Archive tier
+const coldArchiveRegion = "RBX-ARCHIVE"
+
+// ColdArchive is a Cold Archive container, filled over S3 then sealed to tape:
+// - Create:  POST   /cloud/project/{serviceName}/region/{regionName}/coldArchive
+// - Read:    GET    /cloud/project/{serviceName}/region/{regionName}/coldArchive/{name}
+// - Archive: POST   /cloud/project/{serviceName}/region/{regionName}/coldArchive/{name}/archive
+// - Restore: POST   /cloud/project/{serviceName}/region/{regionName}/coldArchive/{name}/restore
+// - Delete:  DELETE /cloud/project/{serviceName}/region/{regionName}/coldArchive/{name}
+// - Destroy: POST   /cloud/project/{serviceName}/region/{regionName}/coldArchive/{name}/destroy
+// - List:    GET    /cloud/project/{serviceName}/region/{regionName}/coldArchive
+// Archiving and restoring take hours, so Create and Update return InProgress
+// and Status polls until the container settles.
+
+// Cold Archive container statuses
+const (
+	coldArchiveStatusNone      = "none"
+	coldArchiveStatusArchiving = "archiving"
+	coldArchiveStatusArchived  = "archived"
+	coldArchiveStatusRestoring = "restoring"
+	coldArchiveStatusRestored  = "restored"
+	coldArchiveStatusDeleting  = "deleting"
+	coldArchiveStatusFlushed   = "flushed"
+)
+
+// coldArchiveProvisioner handles Cold Archive container operations.
+type coldArchiveProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &coldArchiveProvisioner{}
+
+func (p *coldArchiveProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project := s3ExtractProject(request.TargetConfig, props)
+	if project == "" {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			"serviceName is required"), nil
+	}
+
+	region, _ := props["region"].(string)
+	if region == "" {
+		region = coldArchiveRegion
+	}
+
+	name, _ := props["name"].(string)
+	if name == "" {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			"name is required"), nil
+	}
+
+	archive, _ := props["archive"].(bool)
+	if restore, _ := props["restore"].(bool); restore {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			"restore needs an archived container; set it once the container is archived"), nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/region/%s/coldArchive", project, region)
+
+	// archive and restore are actions on the container, not part of its body
+	body := s3FilterProps(props, "serviceName", "region", "archive", "restore")
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   url,
+		Body:   body,
+	})
+	if err != nil {
+		return s3HandleTransportError(err), nil
+	}
+
+	nativeID := fmt.Sprintf("%s/%s/%s", project, region, name)
+
+	status := coldArchiveStatusNone
+	if archive {
+		if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "POST",
+			Path:   fmt.Sprintf("%s/%s/archive", url, name),
+		}); err != nil {
+			result := s3HandleTransportError(err)
+			result.ProgressResult.NativeID = nativeID
+			result.ProgressResult.StatusMessage = "container created but archiving failed: " + result.ProgressResult.StatusMessage
+			return result, nil
+		}
+		status = coldArchiveStatusArchiving
+	}
+
+	if response.Body == nil {
+		response.Body = map[string]interface{}{"name": name}
+	}
+	response.Body["status"] = status
+	propsJSON, _ := json.Marshal(coldArchiveToProperties(response.Body, region))
+
+	operationStatus := resource.OperationStatusSuccess
+	if archive {
+		operationStatus = resource.OperationStatusInProgress
+	}
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    operationStatus,
+			NativeID:           nativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *coldArchiveProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	project, region, name, err := parseColdArchiveNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/region/%s/coldArchive/%s", project, region, name),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+
+	propsJSON, _ := json.Marshal(coldArchiveToProperties(response.Body, region))
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+// Update archives the container or starts a restore of its objects. Nothing
+// else about a container can change.
+func (p *coldArchiveProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.DesiredProperties, &props); err != nil {
+		return s3UpdateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project, region, name, err := parseColdArchiveNativeID(request.NativeID)
+	if err != nil {
+		return s3UpdateFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/region/%s/coldArchive/%s", project, region, name)
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return s3UpdateFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return s3UpdateFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	if response.Body == nil {
+		response.Body = map[string]interface{}{"name": name}
+	}
+	archive, _ := props["archive"].(bool)
+	restore, _ := props["restore"].(bool)
+	status, _ := response.Body["status"].(string)
+
+	action, nextStatus, errorCode, err := coldArchiveAction(status, archive, restore)
+	if err != nil {
+		return s3UpdateFailure(request.NativeID, errorCode, err.Error()), nil
+	}
+
+	operationStatus := resource.OperationStatusSuccess
+	if action != "" {
+		if _, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "POST",
+			Path:   fmt.Sprintf("%s/%s", url, action),
+		}); err != nil {
+			if transportErr, ok := err.(*ovhtransport.Error); ok {
+				return s3UpdateFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+					transportErr.Message), nil
+			}
+			return s3UpdateFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+		}
+		response.Body["status"] = nextStatus
+		operationStatus = resource.OperationStatusInProgress
+	}
+
+	propsJSON, _ := json.Marshal(coldArchiveToProperties(response.Body, region))
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    operationStatus,
+			NativeID:           request.NativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+// Delete removes an open container, or destroys the archive of a sealed one.
+// Destroying an archive is irreversible.
+func (p *coldArchiveProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	project, region, name, err := parseColdArchiveNativeID(request.NativeID)
+	if err != nil {
+		return s3DeleteFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/region/%s/coldArchive/%s", project, region, name)
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err == nil {
+		status, _ := response.Body["status"].(string)
+		switch status {
+		case coldArchiveStatusNone, coldArchiveStatusFlushed:
+			_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
+				Method: "DELETE",
+				Path:   url,
+			})
+		case coldArchiveStatusDeleting:
+			// Already being destroyed
+		default:
+			_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
+				Method: "POST",
+				Path:   url + "/destroy",
+			})
+		}
+	}
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			if transportErr.Code == ovhtransport.ErrorCodeResourceNotFound {
+				return &resource.DeleteResult{
+					ProgressResult: &resource.ProgressResult{
+						Operation:       resource.OperationDelete,
+						OperationStatus: resource.OperationStatusSuccess,
+						NativeID:        request.NativeID,
+					},
+				}, nil
+			}
+			return s3DeleteFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return s3DeleteFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+func (p *coldArchiveProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	project := s3ExtractProjectFromAdditional(request.TargetConfig, request.AdditionalProperties)
+	if project == "" {
+		return &resource.ListResult{NativeIDs: nil}, nil
+	}
+
+	region := request.AdditionalProperties["region"]
+	if region == "" {
+		region = coldArchiveRegion
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/region/%s/coldArchive", project, region),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cold archive containers: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, item := range response.BodyArray {
+		if container, ok := item.(map[string]interface{}); ok {
+			if name, ok := container["name"].(string); ok {
+				nativeIDs = append(nativeIDs, fmt.Sprintf("%s/%s/%s", project, region, name))
+			}
+		}
+	}
+
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
+
+// Status waits for an archive or restore to finish.
+func (p *coldArchiveProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	project, region, name, err := parseColdArchiveNativeID(request.NativeID)
+	if err != nil {
+		return s3StatusFailure(request, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   fmt.Sprintf("/cloud/project/%s/region/%s/coldArchive/%s", project, region, name),
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return s3StatusFailure(request, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return s3StatusFailure(request, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	status, _ := response.Body["status"].(string)
+	switch status {
+	case coldArchiveStatusArchiving, coldArchiveStatusRestoring:
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusInProgress,
+				StatusMessage:   fmt.Sprintf("Cold archive status: %s", status),
+				RequestID:       request.RequestID,
+				NativeID:        request.NativeID,
+			},
+		}, nil
+	case coldArchiveStatusDeleting, coldArchiveStatusFlushed:
+		return s3StatusFailure(request, resource.OperationErrorCodeNotFound,
+			fmt.Sprintf("Cold archive status: %s", status)), nil
+	}
+
+	propsJSON, _ := json.Marshal(coldArchiveToProperties(response.Body, region))
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCheckStatus,
+			OperationStatus:    resource.OperationStatusSuccess,
+			RequestID:          request.RequestID,
+			NativeID:           request.NativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+// coldArchiveAction returns the container action that moves a container in
+// status towards the desired archive and restore flags, and the status it
+// leaves the container in. An empty action means there is nothing to do.
+func coldArchiveAction(status string, archive, restore bool) (action, next string, code resource.OperationErrorCode, err error) {
+	switch status {
+	case coldArchiveStatusNone:
+		if restore {
+			return "", "", resource.OperationErrorCodeInvalidRequest,
+				fmt.Errorf("restore needs an archived container; set it once the container is archived")
+		}
+		if archive {
+			return "archive", coldArchiveStatusArchiving, "", nil
+		}
+		return "", "", "", nil
+	case coldArchiveStatusArchiving:
+		if !archive {
+			return "", "", resource.OperationErrorCodeNotUpdatable, fmt.Errorf("an archived container cannot be reopened")
+		}
+		if restore {
+			return "", "", resource.OperationErrorCodeGeneralServiceException,
+				fmt.Errorf("the container is still being archived; restore it once archived")
+		}
+		return "", "", "", nil
+	case coldArchiveStatusArchived:
+		if !archive {
+			return "", "", resource.OperationErrorCodeNotUpdatable, fmt.Errorf("an archived container cannot be reopened")
+		}
+		if restore {
+			return "restore", coldArchiveStatusRestoring, "", nil
+		}
+		return "", "", "", nil
+	case coldArchiveStatusRestoring, coldArchiveStatusRestored:
+		// A restored copy expires by itself; clearing restore has nothing to undo
+		if !archive {
+			return "", "", resource.OperationErrorCodeNotUpdatable, fmt.Errorf("an archived container cannot be reopened")
+		}
+		return "", "", "", nil
+	}
+	return "", "", resource.OperationErrorCodeNotFound, fmt.Errorf("cold archive container is %s", status)
+}
+
+// coldArchiveToProperties adds the archive and restore flags, derived from
+// the container status, and the region to an API container.
+func coldArchiveToProperties(container map[string]interface{}, region string) map[string]interface{} {
+	if container == nil {
+		return nil
+	}
+	status, _ := container["status"].(string)
+	switch status {
+	case coldArchiveStatusArchiving, coldArchiveStatusArchived:
+		container["archive"], container["restore"] = true, false
+	case coldArchiveStatusRestoring, coldArchiveStatusRestored:
+		container["archive"], container["restore"] = true, true
+	default:
+		container["archive"], container["restore"] = false, false
+	}
+	container["region"] = region
+	return container
+}
+
+// parseColdArchiveNativeID parses "project/region/name" format
+func parseColdArchiveNativeID(nativeID string) (project, region, name string, err error) {
+	parts := strings.SplitN(nativeID, "/", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid cold archive native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func init() {
+	registry.Register(
+		ColdArchiveResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+			resource.OperationCheckStatus,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &coldArchiveProvisioner{client: client}
+		},
+	)
+}
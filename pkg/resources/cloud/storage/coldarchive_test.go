@@ -0,0 +1,150 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const coldArchiveURL = "/cloud/project/p1/region/RBX-ARCHIVE/coldArchive"
+
+// newColdArchiveAPI serves one container in status and records the method
+// and path of every call
+func newColdArchiveAPI(t *testing.T, status string) (*ovhtransport.Client, *[]string) {
+	t.Helper()
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "POST" && r.URL.Path == coldArchiveURL:
+			w.Write([]byte(`{"name":"compliance","status":"none","objectsCount":0}`))
+		case r.Method == "GET" && r.URL.Path == coldArchiveURL+"/compliance":
+			fmt.Fprintf(w, `{"name":"compliance","status":%q,"objectsCount":12}`, status)
+		case r.Method == "POST" || r.Method == "DELETE":
+			w.Write([]byte(`null`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ovhtransport.NewClient(&ovhtransport.OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+	})
+	require.NoError(t, err)
+	return client, &calls
+}
+
+func TestColdArchiveCreate_Archives(t *testing.T) {
+	client, calls := newColdArchiveAPI(t, "archiving")
+	p := &coldArchiveProvisioner{client: client}
+
+	props, _ := json.Marshal(map[string]interface{}{"serviceName": "p1", "name": "compliance", "archive": true})
+	result, err := p.Create(context.Background(), &resource.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "p1/RBX-ARCHIVE/compliance", result.ProgressResult.NativeID)
+	assert.Equal(t, []string{"POST " + coldArchiveURL, "POST " + coldArchiveURL + "/compliance/archive"}, *calls)
+
+	status, err := p.Status(context.Background(), &resource.StatusRequest{NativeID: "p1/RBX-ARCHIVE/compliance"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, status.ProgressResult.OperationStatus)
+}
+
+func TestColdArchiveUpdate_Restores(t *testing.T) {
+	client, calls := newColdArchiveAPI(t, "archived")
+	p := &coldArchiveProvisioner{client: client}
+
+	desired, _ := json.Marshal(map[string]interface{}{"name": "compliance", "archive": true, "restore": true})
+	result, err := p.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          "p1/RBX-ARCHIVE/compliance",
+		DesiredProperties: desired,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, result.ProgressResult.OperationStatus)
+	assert.Equal(t, []string{"GET " + coldArchiveURL + "/compliance", "POST " + coldArchiveURL + "/compliance/restore"}, *calls)
+
+	var props map[string]interface{}
+	require.NoError(t, json.Unmarshal(result.ProgressResult.ResourceProperties, &props))
+	assert.Equal(t, "restoring", props["status"])
+	assert.Equal(t, true, props["restore"])
+}
+
+func TestColdArchiveDelete_DestroysArchive(t *testing.T) {
+	client, calls := newColdArchiveAPI(t, "archived")
+	p := &coldArchiveProvisioner{client: client}
+
+	result, err := p.Delete(context.Background(), &resource.DeleteRequest{NativeID: "p1/RBX-ARCHIVE/compliance"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, []string{"GET " + coldArchiveURL + "/compliance", "POST " + coldArchiveURL + "/compliance/destroy"}, *calls)
+}
+
+func TestColdArchiveRead_DerivesFlags(t *testing.T) {
+	client, _ := newColdArchiveAPI(t, "restored")
+	p := &coldArchiveProvisioner{client: client}
+
+	result, err := p.Read(context.Background(), &resource.ReadRequest{NativeID: "p1/RBX-ARCHIVE/compliance"})
+	require.NoError(t, err)
+
+	var props map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result.Properties), &props))
+	assert.Equal(t, true, props["archive"])
+	assert.Equal(t, true, props["restore"])
+	assert.Equal(t, "RBX-ARCHIVE", props["region"])
+}
+
+func TestColdArchiveAction(t *testing.T) {
+	tests := []struct {
+		status           string
+		archive, restore bool
+		action, next     string
+		code             resource.OperationErrorCode
+	}{
+		{status: "none"},
+		{status: "none", archive: true, action: "archive", next: "archiving"},
+		{status: "none", archive: true, restore: true, code: resource.OperationErrorCodeInvalidRequest},
+		{status: "archiving", archive: true},
+		{status: "archiving", archive: true, restore: true, code: resource.OperationErrorCodeGeneralServiceException},
+		{status: "archived", archive: true, restore: true, action: "restore", next: "restoring"},
+		{status: "archived", code: resource.OperationErrorCodeNotUpdatable},
+		{status: "restored", archive: true},
+		{status: "restored", archive: true, restore: true},
+		{status: "deleting", archive: true, code: resource.OperationErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s archive=%t restore=%t", tt.status, tt.archive, tt.restore), func(t *testing.T) {
+			action, next, code, err := coldArchiveAction(tt.status, tt.archive, tt.restore)
+			if tt.code != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.code, code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.action, action)
+			assert.Equal(t, tt.next, next)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package storage
+
+import (
+	"context"
+	"reflect"
+
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// Lifecycle rules (object expiration, noncurrent version expiration, aborting
+// incomplete multipart uploads) live on a separate endpoint from the bucket:
+// - GET    /cloud/project/{serviceName}/region/{regionName}/storage/{name}/lifecycle
+// - PUT    /cloud/project/{serviceName}/region/{regionName}/storage/{name}/lifecycle
+// - DELETE /cloud/project/{serviceName}/region/{regionName}/storage/{name}/lifecycle
+
+// s3LifecycleRules returns the rules of a lifecycle property, or nil when unset
+func s3LifecycleRules(lifecycle interface{}) []interface{} {
+	config, _ := lifecycle.(map[string]interface{})
+	rules, _ := config["rules"].([]interface{})
+	return rules
+}
+
+// applyS3Lifecycle replaces the bucket's lifecycle rules when they changed.
+// Removing every rule deletes the configuration, so objects stop expiring.
+func applyS3Lifecycle(ctx context.Context, client *ovhtransport.Client, bucketURL string, desired, prior interface{}) error {
+	desiredRules := s3LifecycleRules(desired)
+	priorRules := s3LifecycleRules(prior)
+	if reflect.DeepEqual(desiredRules, priorRules) {
+		return nil
+	}
+
+	if len(desiredRules) == 0 {
+		_, err := client.Do(ctx, ovhtransport.RequestOptions{
+			Method: "DELETE",
+			Path:   bucketURL + "/lifecycle",
+		})
+		if transportErr, ok := err.(*ovhtransport.Error); ok && transportErr.Code == ovhtransport.ErrorCodeResourceNotFound {
+			return nil
+		}
+		return err
+	}
+
+	_, err := client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "PUT",
+		Path:   bucketURL + "/lifecycle",
+		Body:   map[string]interface{}{"rules": desiredRules},
+	})
+	return err
+}
+
+// readS3Lifecycle returns the bucket's lifecycle configuration, or nil when it has none
+func readS3Lifecycle(ctx context.Context, client *ovhtransport.Client, bucketURL string) (map[string]interface{}, error) {
+	response, err := client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   bucketURL + "/lifecycle",
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok && transportErr.Code == ovhtransport.ErrorCodeResourceNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(s3LifecycleRules(response.Body)) == 0 {
+		return nil, nil
+	}
+	return response.Body, nil
+}
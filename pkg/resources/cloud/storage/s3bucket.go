@@ -58,8 +58,9 @@ func (p *s3BucketProvisioner) Create(ctx context.Context, request *resource.Crea
 	// Build URL: POST /cloud/project/{serviceName}/region/{regionName}/storage
 	url := fmt.Sprintf("/cloud/project/%s/region/%s/storage", project, shortRegion)
 
-	// Strip serviceName and region from body (they're in the URL)
-	body := s3FilterProps(props, "serviceName", "region")
+	// Strip serviceName and region from body (they're in the URL);
+	// lifecycle rules are applied once the bucket exists
+	body := s3FilterProps(props, "serviceName", "region", "lifecycle")
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "POST",
@@ -73,6 +74,16 @@ func (p *s3BucketProvisioner) Create(ctx context.Context, request *resource.Crea
 	// Native ID: project/region/name (uses short region for consistency)
 	nativeID := fmt.Sprintf("%s/%s/%s", project, shortRegion, name)
 
+	if err := applyS3Lifecycle(ctx, p.client, fmt.Sprintf("%s/%s", url, name), props["lifecycle"], nil); err != nil {
+		result := s3HandleTransportError(err)
+		result.ProgressResult.NativeID = nativeID
+		result.ProgressResult.StatusMessage = "bucket created but lifecycle rules failed: " + result.ProgressResult.StatusMessage
+		return result, nil
+	}
+
+	if response.Body != nil && props["lifecycle"] != nil {
+		response.Body["lifecycle"] = props["lifecycle"]
+	}
 	propsJSON, _ := json.Marshal(response.Body)
 
 	return &resource.CreateResult{
@@ -106,6 +117,19 @@ func (p *s3BucketProvisioner) Read(ctx context.Context, request *resource.ReadRe
 		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
 	}
 
+	lifecycle, err := readS3Lifecycle(ctx, p.client, url)
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+	if response.Body != nil && lifecycle != nil {
+		response.Body["lifecycle"] = lifecycle
+	}
+
 	propsJSON, _ := json.Marshal(response.Body)
 	return &resource.ReadResult{Properties: string(propsJSON)}, nil
 }
@@ -124,8 +148,8 @@ func (p *s3BucketProvisioner) Update(ctx context.Context, request *resource.Upda
 
 	url := fmt.Sprintf("/cloud/project/%s/region/%s/storage/%s", project, region, name)
 
-	// Strip immutable fields
-	body := s3FilterProps(props, "serviceName", "region", "name", "ownerId", "objectLock")
+	// Strip immutable fields, and lifecycle rules which have their own endpoint
+	body := s3FilterProps(props, "serviceName", "region", "name", "ownerId", "objectLock", "lifecycle")
 
 	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
 		Method: "PUT",
@@ -140,6 +164,21 @@ func (p *s3BucketProvisioner) Update(ctx context.Context, request *resource.Upda
 		return s3UpdateFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
 	}
 
+	var prior map[string]interface{}
+	if len(request.PriorProperties) > 0 {
+		_ = json.Unmarshal(request.PriorProperties, &prior)
+	}
+	if err := applyS3Lifecycle(ctx, p.client, url, props["lifecycle"], prior["lifecycle"]); err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return s3UpdateFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return s3UpdateFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	if response.Body != nil && props["lifecycle"] != nil {
+		response.Body["lifecycle"] = props["lifecycle"]
+	}
 	propsJSON, _ := json.Marshal(response.Body)
 
 	return &resource.UpdateResult{
@@ -380,3 +380,88 @@ func TestS3Bucket_CreateWithVersioning_Integration(t *testing.T) {
 
 	t.Logf("✓ Created S3 bucket with versioning: %s", nativeID)
 }
+
+func TestS3Bucket_Lifecycle_Integration(t *testing.T) {
+	testutil.SkipIfOVHNotConfigured(t)
+
+	ctx := context.Background()
+	provisioner := getS3BucketProvisioner(t)
+
+	bucketName := fmt.Sprintf("formae-test-s3-lifecycle-%d", time.Now().Unix())
+
+	lifecycle := map[string]interface{}{
+		"rules": []interface{}{
+			map[string]interface{}{
+				"id":         "expire-logs",
+				"status":     "enabled",
+				"filter":     map[string]interface{}{"prefix": "logs/"},
+				"expiration": map[string]interface{}{"days": 30},
+			},
+		},
+	}
+	createProps, _ := json.Marshal(map[string]interface{}{
+		"serviceName": testutil.OVHCloudProjectID,
+		"name":        bucketName,
+		"region":      testRegion,
+		"lifecycle":   lifecycle,
+	})
+
+	createResult, err := provisioner.Create(ctx, &resource.CreateRequest{
+		ResourceType: S3BucketResourceType,
+		Label:        bucketName,
+		Properties:   createProps,
+		TargetConfig: testTargetConfig,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, createResult.ProgressResult)
+	require.NotEmpty(t, createResult.ProgressResult.NativeID)
+	require.Equal(t, resource.OperationStatusSuccess, createResult.ProgressResult.OperationStatus,
+		createResult.ProgressResult.StatusMessage)
+
+	nativeID := createResult.ProgressResult.NativeID
+	defer func() {
+		_, _ = provisioner.Delete(ctx, &resource.DeleteRequest{
+			ResourceType: S3BucketResourceType,
+			NativeID:     nativeID,
+			TargetConfig: testTargetConfig,
+		})
+	}()
+
+	readResult, err := provisioner.Read(ctx, &resource.ReadRequest{
+		ResourceType: S3BucketResourceType,
+		NativeID:     nativeID,
+		TargetConfig: testTargetConfig,
+	})
+	require.NoError(t, err)
+
+	var props map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(readResult.Properties), &props))
+	assert.NotNil(t, props["lifecycle"], "Lifecycle rules should be read back")
+
+	// Removing the rules deletes the lifecycle configuration
+	desiredProps, _ := json.Marshal(map[string]interface{}{
+		"serviceName": testutil.OVHCloudProjectID,
+		"name":        bucketName,
+		"region":      testRegion,
+	})
+	updateResult, err := provisioner.Update(ctx, &resource.UpdateRequest{
+		ResourceType:      S3BucketResourceType,
+		NativeID:          nativeID,
+		PriorProperties:   createProps,
+		DesiredProperties: desiredProps,
+		TargetConfig:      testTargetConfig,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, updateResult.ProgressResult.OperationStatus,
+		updateResult.ProgressResult.StatusMessage)
+
+	readResult, err = provisioner.Read(ctx, &resource.ReadRequest{
+		ResourceType: S3BucketResourceType,
+		NativeID:     nativeID,
+		TargetConfig: testTargetConfig,
+	})
+	require.NoError(t, err)
+	props = nil
+	require.NoError(t, json.Unmarshal([]byte(readResult.Properties), &props))
+	assert.Nil(t, props["lifecycle"], "Lifecycle rules should be removed")
+}
@@ -0,0 +1,248 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+)
+
+// S3CredentialResourceType is the resource type for S3 access keys.
+const S3CredentialResourceType = "OVH::Storage::S3Credential"
+
+// S3Credential is an EC2-style access key pair owned by a cloud project user:
+// - Create: POST   /cloud/project/{serviceName}/user/{userId}/s3Credentials
+// - Read:   GET    /cloud/project/{serviceName}/user/{userId}/s3Credentials/{access}
+// - Delete: DELETE /cloud/project/{serviceName}/user/{userId}/s3Credentials/{access}
+// - List:   GET    /cloud/project/{serviceName}/user/{userId}/s3Credentials
+// The secret is only returned by Create. Credentials are immutable, so there is no Update.
+
+// s3CredentialProvisioner handles S3 credential operations.
+type s3CredentialProvisioner struct {
+	client *ovhtransport.Client
+}
+
+var _ prov.Provisioner = &s3CredentialProvisioner{}
+
+func (p *s3CredentialProvisioner) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	var props map[string]interface{}
+	if err := json.Unmarshal(request.Properties, &props); err != nil {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			fmt.Sprintf("failed to parse properties: %v", err)), nil
+	}
+
+	project := s3ExtractProject(request.TargetConfig, props)
+	if project == "" {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			"serviceName is required"), nil
+	}
+
+	userID := s3CredentialUserID(props["userId"])
+	if userID == "" {
+		return s3CreateFailure(resource.OperationErrorCodeInvalidRequest,
+			"userId is required"), nil
+	}
+
+	// Build URL: POST /cloud/project/{serviceName}/user/{userId}/s3Credentials
+	url := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials", project, userID)
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "POST",
+		Path:   url,
+	})
+	if err != nil {
+		return s3HandleTransportError(err), nil
+	}
+
+	access, _ := response.Body["access"].(string)
+	if access == "" {
+		return s3CreateFailure(resource.OperationErrorCodeServiceInternalError,
+			"no access key in response"), nil
+	}
+
+	// Native ID: project/userId/access
+	nativeID := fmt.Sprintf("%s/%s/%s", project, userID, access)
+
+	// Response includes the secret key
+	propsJSON, _ := json.Marshal(response.Body)
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: propsJSON,
+		},
+	}, nil
+}
+
+func (p *s3CredentialProvisioner) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	project, userID, access, err := parseS3CredentialNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeInvalidRequest}, nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials/%s", project, userID, access)
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			return &resource.ReadResult{
+				ErrorCode: ovhtransport.ToResourceErrorCode(transportErr.Code),
+			}, nil
+		}
+		return &resource.ReadResult{ErrorCode: resource.OperationErrorCodeServiceInternalError}, nil
+	}
+
+	// GET omits the secret. It is captured once at Create and kept from
+	// there, so drift checks don't move it over the wire again.
+	propsJSON, _ := json.Marshal(response.Body)
+	return &resource.ReadResult{Properties: string(propsJSON)}, nil
+}
+
+// Update is not supported for S3 credentials.
+func (p *s3CredentialProvisioner) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationUpdate,
+			OperationStatus: resource.OperationStatusFailure,
+			ErrorCode:       resource.OperationErrorCodeNotUpdatable,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Delete revokes the credential.
+func (p *s3CredentialProvisioner) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	project, userID, access, err := parseS3CredentialNativeID(request.NativeID)
+	if err != nil {
+		return s3DeleteFailure(request.NativeID, resource.OperationErrorCodeInvalidRequest, err.Error()), nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials/%s", project, userID, access)
+
+	_, err = p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "DELETE",
+		Path:   url,
+	})
+	if err != nil {
+		if transportErr, ok := err.(*ovhtransport.Error); ok {
+			if transportErr.Code == ovhtransport.ErrorCodeResourceNotFound {
+				return &resource.DeleteResult{
+					ProgressResult: &resource.ProgressResult{
+						Operation:       resource.OperationDelete,
+						OperationStatus: resource.OperationStatusSuccess,
+						NativeID:        request.NativeID,
+					},
+				}, nil
+			}
+			return s3DeleteFailure(request.NativeID, ovhtransport.ToResourceErrorCode(transportErr.Code),
+				transportErr.Message), nil
+		}
+		return s3DeleteFailure(request.NativeID, resource.OperationErrorCodeServiceInternalError, err.Error()), nil
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+func (p *s3CredentialProvisioner) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	project := s3ExtractProjectFromAdditional(request.TargetConfig, request.AdditionalProperties)
+	if project == "" {
+		return &resource.ListResult{NativeIDs: nil}, nil
+	}
+
+	userID := request.AdditionalProperties["userId"]
+	if userID == "" {
+		// Credentials are scoped to a user
+		return &resource.ListResult{NativeIDs: nil}, nil
+	}
+
+	url := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials", project, userID)
+
+	response, err := p.client.Do(ctx, ovhtransport.RequestOptions{
+		Method: "GET",
+		Path:   url,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list S3 credentials: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, item := range response.BodyArray {
+		if credential, ok := item.(map[string]interface{}); ok {
+			if access, ok := credential["access"].(string); ok {
+				nativeIDs = append(nativeIDs, fmt.Sprintf("%s/%s/%s", project, userID, access))
+			}
+		}
+	}
+
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
+
+// Status returns success immediately (credential creation is synchronous).
+func (p *s3CredentialProvisioner) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// parseS3CredentialNativeID parses "project/userId/access" format
+func parseS3CredentialNativeID(nativeID string) (project, userID, access string, err error) {
+	parts := strings.SplitN(nativeID, "/", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid S3 credential native ID: %s", nativeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// s3CredentialUserID converts the userId property to a string.
+// OVH cloud project user IDs are numeric, so the value may arrive as a number.
+func s3CredentialUserID(v interface{}) string {
+	switch id := v.(type) {
+	case string:
+		return id
+	case float64:
+		return fmt.Sprintf("%.0f", id)
+	default:
+		return ""
+	}
+}
+
+func init() {
+	registry.Register(
+		S3CredentialResourceType,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *ovhtransport.Client) prov.Provisioner {
+			return &s3CredentialProvisioner{client: client}
+		},
+	)
+	registry.RegisterSensitive(S3CredentialResourceType, "secret")
+}
@@ -0,0 +1,100 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	ovhtransport "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/ovh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newS3CredentialAPI serves canned S3 credential responses and records the
+// method and path of every call
+func newS3CredentialAPI(t *testing.T) (*ovhtransport.Client, *[]string) {
+	t.Helper()
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/cloud/project/p1/user/42/s3Credentials":
+			w.Write([]byte(`{"access":"AK1","secret":"SK1","userId":"42","tenantId":"t1"}`))
+		case r.Method == "GET" && r.URL.Path == "/cloud/project/p1/user/42/s3Credentials/AK1":
+			w.Write([]byte(`{"access":"AK1","userId":"42","tenantId":"t1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ovhtransport.NewClient(&ovhtransport.OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+	})
+	require.NoError(t, err)
+	return client, &calls
+}
+
+func TestS3CredentialCreate_CapturesSecret(t *testing.T) {
+	client, calls := newS3CredentialAPI(t)
+	p := &s3CredentialProvisioner{client: client}
+
+	props, _ := json.Marshal(map[string]interface{}{"serviceName": "p1", "userId": float64(42)})
+	result, err := p.Create(context.Background(), &resource.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "p1/42/AK1", result.ProgressResult.NativeID)
+
+	var created map[string]interface{}
+	require.NoError(t, json.Unmarshal(result.ProgressResult.ResourceProperties, &created))
+	assert.Equal(t, "SK1", created["secret"])
+	assert.Equal(t, []string{"POST /cloud/project/p1/user/42/s3Credentials"}, *calls)
+}
+
+func TestS3CredentialRead_DoesNotFetchSecret(t *testing.T) {
+	client, calls := newS3CredentialAPI(t)
+	p := &s3CredentialProvisioner{client: client}
+
+	result, err := p.Read(context.Background(), &resource.ReadRequest{NativeID: "p1/42/AK1"})
+	require.NoError(t, err)
+	assert.Empty(t, result.ErrorCode)
+
+	var props map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result.Properties), &props))
+	assert.Equal(t, "AK1", props["access"])
+	assert.NotContains(t, props, "secret")
+	assert.Equal(t, []string{"GET /cloud/project/p1/user/42/s3Credentials/AK1"}, *calls)
+}
+
+func TestParseS3CredentialNativeID(t *testing.T) {
+	project, userID, access, err := parseS3CredentialNativeID("p1/42/AK1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p1", "42", "AK1"}, []string{project, userID, access})
+
+	_, _, _, err = parseS3CredentialNativeID("p1/42")
+	assert.Error(t, err)
+}
+
+func TestS3CredentialUserID(t *testing.T) {
+	assert.Equal(t, "42", s3CredentialUserID(float64(42)))
+	assert.Equal(t, "42", s3CredentialUserID("42"))
+	assert.Equal(t, "", s3CredentialUserID(nil))
+}
@@ -0,0 +1,446 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package blockstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeVolumeBackup = "OVH::Storage::VolumeBackup"
+
+	// backupMetadataMicroversion is the first Cinder microversion with backup
+	// metadata. Renaming a backup needs 3.9, so updates use it as well.
+	backupMetadataMicroversion = "3.43"
+
+	// restoreVolumeMetadataKey records on the backup the volume it was last
+	// restored to, so Read and Status can report restore_volume_id
+	restoreVolumeMetadataKey = "formae_restore_volume_id"
+)
+
+// VolumeBackup provisioner
+type VolumeBackup struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// volumeBackupToProperties converts a Cinder backup to a properties map.
+// This is used by Create, Read, Update, and Status to ensure consistent property marshaling.
+func volumeBackupToProperties(backup *backups.Backup) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":          backup.ID,
+		"volume_id":   backup.VolumeID,
+		"name":        backup.Name,
+		"status":      backup.Status,
+		"size":        backup.Size,
+		"incremental": backup.IsIncremental,
+	}
+
+	if backup.Description != "" {
+		props["description"] = backup.Description
+	}
+
+	if backup.Container != "" {
+		props["container"] = backup.Container
+	}
+
+	return props
+}
+
+// restoreTarget returns the volume to restore the backup to, or "" when the
+// desired target is unset or the backup was already restored there
+func restoreTarget(props, priorProps map[string]interface{}) string {
+	restoreVolumeID, _ := props["restore_volume_id"].(string)
+	priorRestoreVolumeID, _ := priorProps["restore_volume_id"].(string)
+	if restoreVolumeID == priorRestoreVolumeID {
+		return ""
+	}
+	return restoreVolumeID
+}
+
+// metadataClient returns a copy of client at the backup metadata microversion
+func metadataClient(client *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	c := *client
+	c.Microversion = backupMetadataMicroversion
+	return &c
+}
+
+// getBackup fetches a backup and its properties, including the volume it was
+// last restored to
+func getBackup(ctx context.Context, client *gophercloud.ServiceClient, id string) (*backups.Backup, map[string]interface{}, error) {
+	result := backups.Get(ctx, metadataClient(client), id)
+	backup, err := result.Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var withMetadata struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	_ = result.ExtractIntoStructPtr(&withMetadata, "backup")
+
+	return backup, backupProperties(backup, withMetadata.Metadata), nil
+}
+
+// backupProperties converts a backup and its metadata to a properties map
+func backupProperties(backup *backups.Backup, metadata map[string]string) map[string]interface{} {
+	props := volumeBackupToProperties(backup)
+	if restoreVolumeID := metadata[restoreVolumeMetadataKey]; restoreVolumeID != "" {
+		props["restore_volume_id"] = restoreVolumeID
+	}
+	return props
+}
+
+// Register the VolumeBackup resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeVolumeBackup,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+			resource.OperationCheckStatus,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &VolumeBackup{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create starts a backup of a volume. Backups are async; Status waits for "available".
+func (v *VolumeBackup) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeBackup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// Extract volume ID (required)
+	volumeID, ok := props["volume_id"].(string)
+	if !ok || volumeID == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeBackup, resource.OperationErrorCodeInvalidRequest, "", "volume_id is required"),
+		}, nil
+	}
+
+	createOpts := backups.CreateOpts{
+		VolumeID: volumeID,
+	}
+
+	if name, ok := props["name"].(string); ok {
+		createOpts.Name = name
+	}
+
+	if description, ok := props["description"].(string); ok {
+		createOpts.Description = description
+	}
+
+	if container, ok := props["container"].(string); ok {
+		createOpts.Container = container
+	}
+
+	if incremental, ok := props["incremental"].(bool); ok {
+		createOpts.Incremental = incremental
+	}
+
+	// Force allows backing up a volume that is attached to an instance
+	if force, ok := props["force"].(bool); ok {
+		createOpts.Force = force
+	}
+
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), "", err.Error()),
+		}, nil
+	}
+
+	backup, err := backups.Create(ctx, client, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create volume backup: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusInProgress,
+			NativeID:        backup.ID,
+			StatusMessage:   fmt.Sprintf("backing up volume %s", volumeID),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of a backup
+func (v *VolumeBackup) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	id := request.NativeID
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	_, backupProps, err := getBackup(ctx, client, id)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(backupProps)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update renames the backup and, when restore_volume_id changes to a new
+// volume, restores the backup onto that volume. A restore is async; Status
+// waits for the backup to return to "available".
+func (v *VolumeBackup) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	id := request.NativeID
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resource.OperationErrorCodeInvalidRequest, id, err.Error()),
+		}, nil
+	}
+
+	var priorProps map[string]interface{}
+	if len(request.PriorProperties) > 0 {
+		priorProps, _ = resources.ParseProperties(request.PriorProperties)
+	}
+
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), id, err.Error()),
+		}, nil
+	}
+
+	updateOpts := backups.UpdateOpts{}
+	if name, ok := props["name"].(string); ok {
+		updateOpts.Name = &name
+	}
+	if description, ok := props["description"].(string); ok {
+		updateOpts.Description = &description
+	}
+
+	if updateOpts.Name != nil || updateOpts.Description != nil {
+		if _, err := backups.Update(ctx, metadataClient(client), id, updateOpts).Extract(); err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), id, fmt.Sprintf("failed to update volume backup: %v", err)),
+			}, nil
+		}
+	}
+
+	// Restore only when the target volume changes, so re-applying the same
+	// stack doesn't overwrite the volume again. The target is recorded on the
+	// backup first, so Read and Status report it once the restore finishes.
+	if restoreVolumeID := restoreTarget(props, priorProps); restoreVolumeID != "" {
+		_, err := backups.Update(ctx, metadataClient(client), id, backups.UpdateOpts{
+			Metadata: map[string]string{restoreVolumeMetadataKey: restoreVolumeID},
+		}).Extract()
+		if err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), id, fmt.Sprintf("failed to record restore target on volume backup: %v", err)),
+			}, nil
+		}
+
+		_, err = backups.RestoreFromBackup(ctx, client, id, backups.RestoreOpts{
+			VolumeID: restoreVolumeID,
+		}).Extract()
+		if err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), id, fmt.Sprintf("failed to restore backup to volume %s: %v", restoreVolumeID, err)),
+			}, nil
+		}
+
+		return &resource.UpdateResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationUpdate,
+				OperationStatus: resource.OperationStatusInProgress,
+				NativeID:        id,
+				StatusMessage:   fmt.Sprintf("restoring to volume %s", restoreVolumeID),
+			},
+		}, nil
+	}
+
+	_, backupProps, err := getBackup(ctx, client, id)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), id, fmt.Sprintf("failed to get volume backup: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(backupProps)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeBackup, resource.OperationErrorCodeGeneralServiceException, id, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           id,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes a backup
+func (v *VolumeBackup) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeVolumeBackup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	id := request.NativeID
+
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), id, err.Error()),
+		}, nil
+	}
+
+	err = backups.Delete(ctx, client, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeVolumeBackup, errCode, id, fmt.Sprintf("failed to delete volume backup: %v", err)),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        id,
+		},
+	}, nil
+}
+
+// Status waits for a backup or restore to finish
+func (v *VolumeBackup) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, err.Error()),
+		}, nil
+	}
+
+	backup, backupProps, err := getBackup(ctx, client, request.NativeID)
+	if err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeVolumeBackup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to get volume backup: %v", err)),
+		}, nil
+	}
+
+	switch backup.Status {
+	case "available":
+		propsJSON, err := resources.MarshalProperties(backupProps)
+		if err != nil {
+			return &resource.StatusResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeVolumeBackup, resource.OperationErrorCodeGeneralServiceException, backup.ID, fmt.Sprintf("failed to marshal properties: %v", err)),
+			}, nil
+		}
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:          resource.OperationCheckStatus,
+				OperationStatus:    resource.OperationStatusSuccess,
+				RequestID:          request.RequestID,
+				NativeID:           backup.ID,
+				ResourceProperties: []byte(propsJSON),
+			},
+		}, nil
+
+	case "error", "error_restoring":
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeVolumeBackup, resource.OperationErrorCodeGeneralServiceException, backup.ID, fmt.Sprintf("volume backup entered status %s: %s", backup.Status, backup.FailReason)),
+		}, nil
+
+	default:
+		// creating, restoring, backing-up
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusInProgress,
+				RequestID:       request.RequestID,
+				NativeID:        backup.ID,
+				StatusMessage:   fmt.Sprintf("volume backup status: %s", backup.Status),
+			},
+		}, nil
+	}
+}
+
+// List discovers volume backups in the project
+func (v *VolumeBackup) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.ListResult{}, err
+	}
+
+	var nativeIDs []string
+	err = backups.List(client, backups.ListOpts{}).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		list, err := backups.ExtractBackups(page)
+		if err != nil {
+			return false, err
+		}
+		for _, backup := range list {
+			nativeIDs = append(nativeIDs, backup.ID)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list volume backups: %w", err)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package blockstorage
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestoreTarget(t *testing.T) {
+	tests := []struct {
+		name  string
+		props map[string]interface{}
+		prior map[string]interface{}
+		want  string
+	}{
+		{name: "unset", props: map[string]interface{}{}, want: ""},
+		{name: "first restore", props: map[string]interface{}{"restore_volume_id": "vol-1"}, want: "vol-1"},
+		{
+			name:  "same target again",
+			props: map[string]interface{}{"restore_volume_id": "vol-1"},
+			prior: map[string]interface{}{"restore_volume_id": "vol-1"},
+			want:  "",
+		},
+		{
+			name:  "new target",
+			props: map[string]interface{}{"restore_volume_id": "vol-2"},
+			prior: map[string]interface{}{"restore_volume_id": "vol-1"},
+			want:  "vol-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, restoreTarget(tt.props, tt.prior))
+		})
+	}
+}
+
+func TestBackupProperties_SecondUpdateDoesNotRestore(t *testing.T) {
+	backup := &backups.Backup{ID: "b-1", VolumeID: "vol-src", Status: "available"}
+
+	// Read and Status report the target recorded on the backup at restore
+	// time, so the next Update sees it as the prior value
+	prior := backupProperties(backup, map[string]string{restoreVolumeMetadataKey: "vol-1"})
+	assert.Equal(t, "vol-1", prior["restore_volume_id"])
+
+	desired := map[string]interface{}{"restore_volume_id": "vol-1"}
+	assert.Empty(t, restoreTarget(desired, prior))
+}
+
+func TestBackupProperties_NoMetadata(t *testing.T) {
+	props := backupProperties(&backups.Backup{ID: "b-1"}, nil)
+	assert.NotContains(t, props, "restore_volume_id")
+}
@@ -0,0 +1,234 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package blockstorage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeVolumeTypeData = "OVH::Storage::VolumeTypeData"
+)
+
+// VolumeTypeData is a read-only lookup of a Cinder volume type by name.
+// Nothing is created in OpenStack, so Delete only forgets the lookup.
+type VolumeTypeData struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// volumeTypeToProperties converts a Cinder volume type to a properties map.
+func volumeTypeToProperties(volumeType *volumetypes.VolumeType) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":        volumeType.ID,
+		"name":      volumeType.Name,
+		"is_public": volumeType.IsPublic,
+	}
+
+	if volumeType.Description != "" {
+		props["description"] = volumeType.Description
+	}
+
+	return props
+}
+
+// Register the VolumeTypeData resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeVolumeTypeData,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &VolumeTypeData{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create resolves the volume type named name. An unknown name fails with the
+// list of types available in the region.
+func (v *VolumeTypeData) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeTypeData, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	name, ok := props["name"].(string)
+	if !ok || name == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeTypeData, resource.OperationErrorCodeInvalidRequest, "", "name is required"),
+		}, nil
+	}
+
+	types, err := v.listVolumeTypes(ctx)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeTypeData, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to list volume types: %v", err)),
+		}, nil
+	}
+
+	match := findVolumeType(types, name)
+	if match == nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeTypeData, resource.OperationErrorCodeNotFound, "",
+				fmt.Sprintf("no volume type named %q; valid types are: %s", name, strings.Join(volumeTypeNames(types), ", "))),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(volumeTypeToProperties(match))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeVolumeTypeData, resource.OperationErrorCodeGeneralServiceException, match.ID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           match.ID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the volume type by ID
+func (v *VolumeTypeData) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	volumeType, err := volumetypes.Get(ctx, client, request.NativeID).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(volumeTypeToProperties(volumeType))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update is not supported: change name to resolve a different volume type
+func (v *VolumeTypeData) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeVolumeTypeData, resource.OperationErrorCodeNotUpdatable, request.NativeID, "volume type lookups cannot be updated"),
+	}, nil
+}
+
+// Delete succeeds without calling the API; the volume type is not owned by the stack
+func (v *VolumeTypeData) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status returns success immediately (lookups are synchronous)
+func (v *VolumeTypeData) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// List discovers the volume types available in the region
+func (v *VolumeTypeData) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	types, err := v.listVolumeTypes(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list volume types: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, volumeType := range types {
+		nativeIDs = append(nativeIDs, volumeType.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
+
+// listVolumeTypes returns every volume type visible to the project
+func (v *VolumeTypeData) listVolumeTypes(ctx context.Context) ([]volumetypes.VolumeType, error) {
+	client, err := v.Client.BlockStorageClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var types []volumetypes.VolumeType
+	err = volumetypes.List(client, volumetypes.ListOpts{}).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		list, err := volumetypes.ExtractVolumeTypes(page)
+		if err != nil {
+			return false, err
+		}
+		types = append(types, list...)
+		return true, nil
+	})
+	return types, err
+}
+
+// findVolumeType returns the volume type named name, compared case-insensitively
+func findVolumeType(types []volumetypes.VolumeType, name string) *volumetypes.VolumeType {
+	for i := range types {
+		if strings.EqualFold(types[i].Name, name) {
+			return &types[i]
+		}
+	}
+	return nil
+}
+
+// volumeTypeNames returns the sorted names of types, for error messages
+func volumeTypeNames(types []volumetypes.VolumeType) []string {
+	names := make([]string, 0, len(types))
+	for _, volumeType := range types {
+		names = append(names, volumeType.Name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -41,6 +41,33 @@ func MarshalProperties(props map[string]interface{}) (string, error) {
 	return string(propsJSON), nil
 }
 
+// RegionalNativeID prefixes id with region when the resource was created
+// outside the client's default region ("region/id"). Bare IDs keep working
+// for resources in the default region.
+func RegionalNativeID(region, id string) string {
+	if region == "" {
+		return id
+	}
+	return region + "/" + id
+}
+
+// ParseRegionalNativeID splits a NativeID built by RegionalNativeID.
+// OpenStack IDs are UUIDs and never contain "/", so a bare ID has no region.
+func ParseRegionalNativeID(nativeID string) (region, id string) {
+	if i := strings.LastIndex(nativeID, "/"); i >= 0 {
+		return nativeID[:i], nativeID[i+1:]
+	}
+	return "", nativeID
+}
+
+// WithRegion records a region override in resource properties
+func WithRegion(props map[string]interface{}, region string) map[string]interface{} {
+	if region != "" {
+		props["region"] = region
+	}
+	return props
+}
+
 // NewFailureResult creates a standardized failure ProgressResult.
 // This helps reduce boilerplate when creating error responses.
 // Note: resourceType parameter kept for backward compatibility but is no longer used in ProgressResult
@@ -64,6 +91,30 @@ func NewFailureResultWithMessage(op resource.Operation, resourceType string, err
 	return result
 }
 
+// DeletionProgress reports whether a deleted resource is gone, given the error
+// from fetching it again. Neutron can accept a DELETE while the resource is
+// still referenced, so callers return InProgress until the GET is a 404 and
+// dependent resources are not created against it in the meantime.
+func DeletionProgress(op resource.Operation, resourceType string, nativeID string, getErr error) *resource.ProgressResult {
+	switch errCode := MapOpenStackErrorToOperationErrorCode(getErr); {
+	case getErr == nil:
+		return &resource.ProgressResult{
+			Operation:       op,
+			OperationStatus: resource.OperationStatusInProgress,
+			NativeID:        nativeID,
+			StatusMessage:   "waiting for deletion to complete",
+		}
+	case errCode == resource.OperationErrorCodeNotFound:
+		return &resource.ProgressResult{
+			Operation:       op,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        nativeID,
+		}
+	default:
+		return NewFailureResultWithMessage(op, resourceType, errCode, nativeID, fmt.Sprintf("failed to confirm deletion: %v", getErr))
+	}
+}
+
 // ParseTags extracts a string slice from a tags property value.
 // Tags come from JSON as []interface{}, so this handles the conversion.
 // Returns nil if the input is nil or not a valid tags format.
@@ -93,44 +144,3 @@ func ParseTags(v interface{}) []string {
 
 	return nil
 }
-
-// MapOpenStackErrorToOperationErrorCode maps OpenStack/gophercloud errors to standard operation error codes
-func MapOpenStackErrorToOperationErrorCode(err error) resource.OperationErrorCode {
-	if err == nil {
-		return ""
-	}
-
-	errStr := err.Error()
-
-	switch {
-	case strings.Contains(errStr, "404"), strings.Contains(errStr, "not found"), strings.Contains(errStr, "NotFound"):
-		return resource.OperationErrorCodeNotFound
-
-	case strings.Contains(errStr, "409"), strings.Contains(errStr, "conflict"), strings.Contains(errStr, "already exists"):
-		return resource.OperationErrorCodeAlreadyExists
-
-	case strings.Contains(errStr, "401"), strings.Contains(errStr, "unauthorized"), strings.Contains(errStr, "Unauthorized"):
-		return resource.OperationErrorCodeAccessDenied
-
-	case strings.Contains(errStr, "403"), strings.Contains(errStr, "forbidden"), strings.Contains(errStr, "Forbidden"):
-		return resource.OperationErrorCodeAccessDenied
-
-	case strings.Contains(errStr, "400"), strings.Contains(errStr, "bad request"), strings.Contains(errStr, "BadRequest"):
-		return resource.OperationErrorCodeInvalidRequest
-
-	case strings.Contains(errStr, "429"), strings.Contains(errStr, "too many requests"), strings.Contains(errStr, "rate limit"):
-		return resource.OperationErrorCodeThrottling
-
-	case strings.Contains(errStr, "500"), strings.Contains(errStr, "internal server error"):
-		return resource.OperationErrorCodeGeneralServiceException
-
-	case strings.Contains(errStr, "503"), strings.Contains(errStr, "service unavailable"):
-		return resource.OperationErrorCodeGeneralServiceException
-
-	case strings.Contains(errStr, "quota"), strings.Contains(errStr, "Quota"):
-		return resource.OperationErrorCodeServiceLimitExceeded
-
-	default:
-		return resource.OperationErrorCodeGeneralServiceException
-	}
-}
@@ -0,0 +1,278 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeInstancePasswordData = "OVH::Compute::InstancePasswordData"
+)
+
+// InstancePasswordData retrieves the admin password a Windows (or other
+// keypair-less) instance posts to Nova on first boot, encrypted with the
+// public key of the instance's SSH key. Given the matching private key it is
+// decrypted; otherwise the encrypted password is returned for decrypting
+// offline. Nothing is created in OpenStack, so Delete only forgets the lookup.
+type InstancePasswordData struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// passwordKeys holds the private key of each lookup this process created,
+// by native ID. Read gets neither prior properties nor the key, so without it
+// the decrypted password and the key would drop out of every Read.
+var passwordKeys = struct {
+	sync.Mutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+// parseRSAPrivateKey decodes a PEM RSA private key in PKCS #1 or PKCS #8 form
+func parseRSAPrivateKey(privateKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, fmt.Errorf("private_key is not a PEM encoded key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("private_key must be an RSA key, got %T", key)
+		}
+		return rsaKey, nil
+	case "OPENSSH PRIVATE KEY":
+		return nil, fmt.Errorf("private_key is in OpenSSH format; convert a copy to PEM with \"ssh-keygen -p -m PEM -f <key>\"")
+	default:
+		return nil, fmt.Errorf("private_key has unsupported PEM type %q", block.Type)
+	}
+}
+
+// Register the InstancePasswordData resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeInstancePasswordData,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &InstancePasswordData{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+	registry.RegisterSensitive(ResourceTypeInstancePasswordData, "private_key", "password")
+}
+
+// Create retrieves the password of instance_id. An instance still booting has
+// not posted it yet, which fails as not stabilized so that formae retries.
+func (p *InstancePasswordData) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	instanceID, ok := props["instance_id"].(string)
+	if !ok || instanceID == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeInvalidRequest, "", "instance_id is required"),
+		}, nil
+	}
+
+	var privateKey *rsa.PrivateKey
+	if pemKey, ok := props["private_key"].(string); ok && pemKey != "" {
+		if privateKey, err = parseRSAPrivateKey(pemKey); err != nil {
+			return &resource.CreateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+			}, nil
+		}
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	computeClient, err := p.Client.ComputeClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	result := servers.GetPassword(ctx, computeClient, instanceID)
+	encrypted, err := result.ExtractPassword(nil)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to get password of instance %s: %s", instanceID, resources.OpenStackErrorMessage(err))),
+		}, nil
+	}
+	if encrypted == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeNotStabilized, "",
+				fmt.Sprintf("instance %s has not posted its password yet; it is set once the image finishes its first boot", instanceID)),
+		}, nil
+	}
+
+	passwordProps := map[string]interface{}{
+		"instance_id":        instanceID,
+		"encrypted_password": encrypted,
+	}
+	if privateKey != nil {
+		password, err := result.ExtractPassword(privateKey)
+		if err != nil {
+			return &resource.CreateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeInvalidRequest, "",
+					fmt.Sprintf("failed to decrypt the password of instance %s; private_key must match the instance's SSH key: %v", instanceID, err)),
+			}, nil
+		}
+		passwordProps["password"] = password
+		passwordProps["private_key"] = props["private_key"]
+	}
+
+	nativeID := resources.RegionalNativeID(region, instanceID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(passwordProps, region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	if privateKey != nil {
+		passwordKeys.Lock()
+		passwordKeys.m[nativeID] = props["private_key"].(string)
+		passwordKeys.Unlock()
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the encrypted password. The password is decrypted again
+// with the private key the lookup was created with, which this process keeps
+// since Read is not given it.
+func (p *InstancePasswordData) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	region, instanceID := resources.ParseRegionalNativeID(request.NativeID)
+	computeClient, err := p.Client.ComputeClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	result := servers.GetPassword(ctx, computeClient, instanceID)
+	encrypted, err := result.ExtractPassword(nil)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	passwordProps := map[string]interface{}{
+		"instance_id":        instanceID,
+		"encrypted_password": encrypted,
+	}
+
+	passwordKeys.Lock()
+	pemKey, ok := passwordKeys.m[request.NativeID]
+	passwordKeys.Unlock()
+	if ok && encrypted != "" {
+		privateKey, err := parseRSAPrivateKey(pemKey)
+		if err == nil {
+			var password string
+			if password, err = result.ExtractPassword(privateKey); err == nil {
+				passwordProps["password"] = password
+				passwordProps["private_key"] = pemKey
+			}
+		}
+		if err != nil {
+			warnings.Warnf(ctx, "failed to decrypt the password of instance %s: %v", instanceID, err)
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(passwordProps, region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update is not supported: change instance_id to look up another instance
+func (p *InstancePasswordData) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeInstancePasswordData, resource.OperationErrorCodeNotUpdatable, request.NativeID, "instance password lookups cannot be updated"),
+	}, nil
+}
+
+// Delete succeeds without calling the API; the password stays on the instance
+func (p *InstancePasswordData) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	passwordKeys.Lock()
+	delete(passwordKeys.m, request.NativeID)
+	passwordKeys.Unlock()
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status returns success immediately (lookups are synchronous)
+func (p *InstancePasswordData) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// List is not supported: passwords are looked up per instance
+func (p *InstancePasswordData) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	return &resource.ListResult{}, nil
+}
@@ -0,0 +1,291 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	volumequotas "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	computequotas "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeProjectQuota = "OVH::Compute::ProjectQuota"
+)
+
+// ProjectQuota provisioner. Sets the Nova (cores, ram, instances) and Cinder
+// (volumes, gigabytes) quotas of a project in the default region through the
+// quota-set APIs, which require the admin role. OVH::Billing::Quota only
+// reads the quotas OVH grants; this resource changes them where permitted.
+type ProjectQuota struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// adminRoleHint is appended to permission errors, since quota sets are
+// admin-only on most deployments including OVH Public Cloud
+const adminRoleHint = "setting project quotas requires the admin role; the configured OpenStack credential is not allowed to"
+
+// projectQuotaToProperties converts the compute and volume quota sets to a properties map.
+func projectQuotaToProperties(projectID string, compute *computequotas.QuotaSet, volume *volumequotas.QuotaSet) map[string]interface{} {
+	return map[string]interface{}{
+		"project_id": projectID,
+		"cores":      compute.Cores,
+		"ram":        compute.RAM,
+		"instances":  compute.Instances,
+		"volumes":    volume.Volumes,
+		"gigabytes":  volume.Gigabytes,
+	}
+}
+
+// intProperty returns a pointer to the integer property key, or nil when unset
+func intProperty(props map[string]interface{}, key string) *int {
+	v, ok := props[key].(float64)
+	if !ok {
+		return nil
+	}
+	n := int(v)
+	return &n
+}
+
+// Register the ProjectQuota resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeProjectQuota,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &ProjectQuota{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// projectID returns the project whose quotas are managed: project_id when
+// set, else the configured OS_PROJECT_ID
+func (q *ProjectQuota) projectID(props map[string]interface{}) string {
+	if projectID, ok := props["project_id"].(string); ok && projectID != "" {
+		return projectID
+	}
+	return q.Config.ProjectID
+}
+
+// apply updates the quotas set in props, leaving the others unchanged.
+// It returns the resulting quotas, or an error code and message on failure.
+func (q *ProjectQuota) apply(ctx context.Context, projectID string, props map[string]interface{}) (map[string]interface{}, resource.OperationErrorCode, string) {
+	volumeClient, err := q.Client.BlockStorageClient()
+	if err != nil {
+		return nil, resources.MapOpenStackErrorToOperationErrorCode(err), err.Error()
+	}
+
+	computeOpts := computequotas.UpdateOpts{
+		Cores:     intProperty(props, "cores"),
+		RAM:       intProperty(props, "ram"),
+		Instances: intProperty(props, "instances"),
+	}
+	computeQuota, err := computequotas.Update(ctx, q.Client.ComputeClient, projectID, computeOpts).Extract()
+	if err != nil {
+		return nil, resources.MapOpenStackErrorToOperationErrorCode(err), quotaErrorMessage("update compute quotas", err)
+	}
+
+	volumeOpts := volumequotas.UpdateOpts{
+		Volumes:   intProperty(props, "volumes"),
+		Gigabytes: intProperty(props, "gigabytes"),
+	}
+	volumeQuota, err := volumequotas.Update(ctx, volumeClient, projectID, volumeOpts).Extract()
+	if err != nil {
+		return nil, resources.MapOpenStackErrorToOperationErrorCode(err), quotaErrorMessage("update volume quotas (compute quotas were applied)", err)
+	}
+
+	return projectQuotaToProperties(projectID, computeQuota, volumeQuota), "", ""
+}
+
+// quotaErrorMessage describes a failed quota-set call, explaining the admin
+// role requirement when the call was refused
+func quotaErrorMessage(action string, err error) string {
+	if resources.MapOpenStackErrorToOperationErrorCode(err) == resource.OperationErrorCodeAccessDenied {
+		return fmt.Sprintf("failed to %s: %s: %v", action, adminRoleHint, err)
+	}
+	return fmt.Sprintf("failed to %s: %v", action, err)
+}
+
+// Create sets the project's quotas
+func (q *ProjectQuota) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeProjectQuota, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	projectID := q.projectID(props)
+	if projectID == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeProjectQuota, resource.OperationErrorCodeInvalidRequest, "", "project_id is required when OS_PROJECT_ID is not set"),
+		}, nil
+	}
+
+	quotaProps, errCode, message := q.apply(ctx, projectID, props)
+	if quotaProps == nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeProjectQuota, errCode, "", message),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(quotaProps)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeProjectQuota, resource.OperationErrorCodeGeneralServiceException, projectID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           projectID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read returns the project's current compute and volume quotas
+func (q *ProjectQuota) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	volumeClient, err := q.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	computeQuota, err := computequotas.Get(ctx, q.Client.ComputeClient, request.NativeID).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	volumeQuota, err := volumequotas.Get(ctx, volumeClient, request.NativeID).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(projectQuotaToProperties(request.NativeID, computeQuota, volumeQuota))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update sets the project's quotas to the desired values
+func (q *ProjectQuota) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeProjectQuota, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeProjectQuota, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	quotaProps, errCode, message := q.apply(ctx, request.NativeID, props)
+	if quotaProps == nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeProjectQuota, errCode, request.NativeID, message),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(quotaProps)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeProjectQuota, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete reverts the project's quotas to the deployment defaults
+func (q *ProjectQuota) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeProjectQuota, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	volumeClient, err := q.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeProjectQuota, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, err.Error()),
+		}, nil
+	}
+
+	if err := computequotas.Delete(ctx, q.Client.ComputeClient, request.NativeID).Err; err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeProjectQuota, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, quotaErrorMessage("reset compute quotas", err)),
+		}, nil
+	}
+
+	if err := volumequotas.Delete(ctx, volumeClient, request.NativeID).ExtractErr(); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeProjectQuota, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, quotaErrorMessage("reset volume quotas", err)),
+		}, nil
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (quota sets are synchronous, so not used)
+func (q *ProjectQuota) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List is not supported: quotas exist for every project and are only
+// managed when declared
+func (q *ProjectQuota) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	return &resource.ListResult{}, nil
+}
@@ -0,0 +1,57 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package compute
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectQuota_DeleteResetsComputeAndVolumeQuotas(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		// Nova answers 202 and Cinder 200, the only codes gophercloud accepts from each
+		if strings.HasPrefix(r.URL.Path, "/compute/") {
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	provider := &gophercloud.ProviderClient{
+		EndpointLocator: func(gophercloud.EndpointOpts) (string, error) {
+			return server.URL + "/volume/", nil
+		},
+	}
+	quota := &ProjectQuota{
+		Client: &openstack.Client{
+			Provider: provider,
+			ComputeClient: &gophercloud.ServiceClient{
+				ProviderClient: provider,
+				Endpoint:       server.URL + "/compute/",
+			},
+		},
+		Config: &openstack.Config{},
+	}
+
+	result, err := quota.Delete(context.Background(), &resource.DeleteRequest{NativeID: "project-1"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, []string{"/compute/os-quota-sets/project-1", "/volume/os-quota-sets/project-1"}, deleted)
+}
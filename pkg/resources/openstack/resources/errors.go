@@ -0,0 +1,187 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// openStackFault is the error an OpenStack service described in a response
+// body. Neutron sends {"NeutronError": {"type": ..., "message": ...}}; Nova,
+// Cinder and Glance wrap the message in an object named after the fault,
+// e.g. {"conflictingRequest": {"message": ..., "code": 409}}.
+type openStackFault struct {
+	Type    string
+	Message string
+}
+
+// neutronFaultCodes maps Neutron fault types to error codes more specific
+// than the status code alone gives
+var neutronFaultCodes = map[string]resource.OperationErrorCode{
+	"OverQuota":                  resource.OperationErrorCodeServiceLimitExceeded,
+	"IpAddressGenerationFailure": resource.OperationErrorCodeServiceLimitExceeded,
+	"IpAddressInUse":             resource.OperationErrorCodeResourceConflict,
+	"MacAddressInUse":            resource.OperationErrorCodeResourceConflict,
+	"NetworkInUse":               resource.OperationErrorCodeResourceConflict,
+	"SubnetInUse":                resource.OperationErrorCodeResourceConflict,
+	"PortInUse":                  resource.OperationErrorCodeResourceConflict,
+	"RouterInUse":                resource.OperationErrorCodeResourceConflict,
+	"SecurityGroupInUse":         resource.OperationErrorCodeResourceConflict,
+	"SecurityGroupRuleExists":    resource.OperationErrorCodeAlreadyExists,
+}
+
+// MapOpenStackErrorToOperationErrorCode maps OpenStack/gophercloud errors to standard operation error codes.
+// The fault type in the response body is used when present, so that e.g. a
+// network delete refused because ports remain is a ResourceConflict rather
+// than a generic service error; otherwise the status code decides, and
+// errors that carry neither are matched on their text.
+func MapOpenStackErrorToOperationErrorCode(err error) resource.OperationErrorCode {
+	if err == nil {
+		return ""
+	}
+
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &respErr) {
+		fault := parseOpenStackFault(respErr.Body)
+		if code, ok := neutronFaultCodes[fault.Type]; ok {
+			return code
+		}
+		switch {
+		case strings.HasSuffix(fault.Type, "NotFound"):
+			return resource.OperationErrorCodeNotFound
+		case strings.HasSuffix(fault.Type, "InUse"):
+			return resource.OperationErrorCodeResourceConflict
+		}
+		if code, ok := statusErrorCode(respErr.Actual, fault.Message); ok {
+			return code
+		}
+	}
+
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "404"), strings.Contains(errStr, "not found"), strings.Contains(errStr, "NotFound"):
+		return resource.OperationErrorCodeNotFound
+
+	case strings.Contains(errStr, "already exists"):
+		return resource.OperationErrorCodeAlreadyExists
+
+	case strings.Contains(errStr, "409"), strings.Contains(errStr, "conflict"):
+		return resource.OperationErrorCodeResourceConflict
+
+	case strings.Contains(errStr, "401"), strings.Contains(errStr, "unauthorized"), strings.Contains(errStr, "Unauthorized"):
+		return resource.OperationErrorCodeAccessDenied
+
+	case strings.Contains(errStr, "quota"), strings.Contains(errStr, "Quota"):
+		return resource.OperationErrorCodeServiceLimitExceeded
+
+	case strings.Contains(errStr, "403"), strings.Contains(errStr, "forbidden"), strings.Contains(errStr, "Forbidden"):
+		return resource.OperationErrorCodeAccessDenied
+
+	case strings.Contains(errStr, "400"), strings.Contains(errStr, "bad request"), strings.Contains(errStr, "BadRequest"):
+		return resource.OperationErrorCodeInvalidRequest
+
+	case strings.Contains(errStr, "429"), strings.Contains(errStr, "too many requests"), strings.Contains(errStr, "rate limit"):
+		return resource.OperationErrorCodeThrottling
+
+	case strings.Contains(errStr, "500"), strings.Contains(errStr, "internal server error"):
+		return resource.OperationErrorCodeGeneralServiceException
+
+	case strings.Contains(errStr, "503"), strings.Contains(errStr, "service unavailable"):
+		return resource.OperationErrorCodeGeneralServiceException
+
+	default:
+		return resource.OperationErrorCodeGeneralServiceException
+	}
+}
+
+// statusErrorCode maps an HTTP status to an error code. Nova and Cinder
+// report exceeded quotas as 403 or 413, told apart by the message.
+func statusErrorCode(status int, message string) (resource.OperationErrorCode, bool) {
+	quota := strings.Contains(strings.ToLower(message), "quota")
+	switch {
+	case status == http.StatusNotFound:
+		return resource.OperationErrorCodeNotFound, true
+	case status == http.StatusConflict && strings.Contains(message, "already exists"):
+		return resource.OperationErrorCodeAlreadyExists, true
+	case status == http.StatusConflict:
+		return resource.OperationErrorCodeResourceConflict, true
+	case status == http.StatusRequestEntityTooLarge, quota && status == http.StatusForbidden:
+		return resource.OperationErrorCodeServiceLimitExceeded, true
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return resource.OperationErrorCodeAccessDenied, true
+	case status == http.StatusBadRequest:
+		return resource.OperationErrorCodeInvalidRequest, true
+	case status == http.StatusTooManyRequests:
+		return resource.OperationErrorCodeThrottling, true
+	case status >= http.StatusInternalServerError:
+		return resource.OperationErrorCodeGeneralServiceException, true
+	}
+	return "", false
+}
+
+// OpenStackErrorMessage returns the message the OpenStack service gave for
+// err, prefixed with the fault type when there is one, e.g.
+// "SubnetInUse: Unable to complete operation on subnet ...". Errors without
+// a readable body are returned as is.
+func OpenStackErrorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &respErr) {
+		fault := parseOpenStackFault(respErr.Body)
+		switch {
+		case fault.Message != "" && fault.Type != "":
+			return fault.Type + ": " + fault.Message
+		case fault.Message != "":
+			return fault.Message
+		}
+	}
+	return err.Error()
+}
+
+// parseOpenStackFault reads the fault from an OpenStack error body. Bodies
+// in neither known shape give an empty fault.
+func parseOpenStackFault(body []byte) openStackFault {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return openStackFault{}
+	}
+
+	if raw, ok := envelope["NeutronError"]; ok {
+		var neutron struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(raw, &neutron); err == nil {
+			return openStackFault{Type: neutron.Type, Message: neutron.Message}
+		}
+		// Older Neutron sends the message as a plain string
+		var message string
+		if err := json.Unmarshal(raw, &message); err == nil {
+			return openStackFault{Message: message}
+		}
+		return openStackFault{}
+	}
+
+	if len(envelope) == 1 {
+		for _, raw := range envelope {
+			var fault struct {
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(raw, &fault); err == nil && fault.Message != "" {
+				return openStackFault{Message: fault.Message}
+			}
+		}
+	}
+	return openStackFault{}
+}
@@ -0,0 +1,100 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func responseError(status int, body string) error {
+	return fmt.Errorf("failed to delete: %w", gophercloud.ErrUnexpectedResponseCode{
+		Method: "DELETE",
+		URL:    "https://network.example/v2.0/subnets/sub-1",
+		Actual: status,
+		Body:   []byte(body),
+	})
+}
+
+func TestMapOpenStackErrorToOperationErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want resource.OperationErrorCode
+	}{
+		{
+			name: "subnet in use",
+			err:  responseError(409, `{"NeutronError": {"type": "SubnetInUse", "message": "Unable to complete operation on subnet sub-1: One or more ports have an IP allocation from this subnet.", "detail": ""}}`),
+			want: resource.OperationErrorCodeResourceConflict,
+		},
+		{
+			name: "ip address in use",
+			err:  responseError(409, `{"NeutronError": {"type": "IpAddressInUse", "message": "IP address 10.0.0.5 already allocated in subnet sub-1", "detail": ""}}`),
+			want: resource.OperationErrorCodeResourceConflict,
+		},
+		{
+			name: "neutron over quota",
+			err:  responseError(409, `{"NeutronError": {"type": "OverQuota", "message": "Quota exceeded for resources: ['port'].", "detail": ""}}`),
+			want: resource.OperationErrorCodeServiceLimitExceeded,
+		},
+		{
+			name: "duplicate rule",
+			err:  responseError(409, `{"NeutronError": {"type": "SecurityGroupRuleExists", "message": "Security group rule already exists. Rule id is r-1.", "detail": ""}}`),
+			want: resource.OperationErrorCodeAlreadyExists,
+		},
+		{
+			name: "neutron not found",
+			err:  responseError(404, `{"NeutronError": {"type": "SubnetNotFound", "message": "Subnet sub-1 could not be found.", "detail": ""}}`),
+			want: resource.OperationErrorCodeNotFound,
+		},
+		{
+			name: "nova quota",
+			err:  responseError(403, `{"forbidden": {"code": 403, "message": "Quota exceeded for instances: Requested 1, but already used 20 of 20 instances"}}`),
+			want: resource.OperationErrorCodeServiceLimitExceeded,
+		},
+		{
+			name: "nova forbidden",
+			err:  responseError(403, `{"forbidden": {"code": 403, "message": "Policy doesn't allow os_compute_api:servers:create:forced_host to be performed."}}`),
+			want: resource.OperationErrorCodeAccessDenied,
+		},
+		{
+			name: "generic conflict",
+			err:  responseError(409, `{"conflictingRequest": {"code": 409, "message": "Cannot 'resize' instance while it is in task_state migrating"}}`),
+			want: resource.OperationErrorCodeResourceConflict,
+		},
+		{
+			name: "unreadable body",
+			err:  responseError(503, `<html>Service Unavailable</html>`),
+			want: resource.OperationErrorCodeGeneralServiceException,
+		},
+		{
+			name: "plain text",
+			err:  errors.New("resource not found"),
+			want: resource.OperationErrorCodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapOpenStackErrorToOperationErrorCode(tt.err))
+		})
+	}
+	assert.Empty(t, MapOpenStackErrorToOperationErrorCode(nil))
+}
+
+func TestOpenStackErrorMessage(t *testing.T) {
+	err := responseError(409, `{"NeutronError": {"type": "SubnetInUse", "message": "One or more ports have an IP allocation from this subnet.", "detail": ""}}`)
+	assert.Equal(t, "SubnetInUse: One or more ports have an IP allocation from this subnet.", OpenStackErrorMessage(err))
+
+	err = responseError(409, `{"conflictingRequest": {"code": 409, "message": "Instance is locked"}}`)
+	assert.Equal(t, "Instance is locked", OpenStackErrorMessage(err))
+
+	plain := errors.New("connection refused")
+	assert.Equal(t, "connection refused", OpenStackErrorMessage(plain))
+}
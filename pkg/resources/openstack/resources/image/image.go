@@ -0,0 +1,452 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeImage = "OVH::Image::Image"
+)
+
+// Image provisioner
+type Image struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// imageToProperties converts a Glance image to a properties map.
+// This is used by Create, Read, Update, and Status to ensure consistent property marshaling.
+func imageToProperties(img *images.Image) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":               img.ID,
+		"name":             img.Name,
+		"status":           string(img.Status),
+		"disk_format":      img.DiskFormat,
+		"container_format": img.ContainerFormat,
+		"visibility":       string(img.Visibility),
+		"min_disk":         img.MinDiskGigabytes,
+		"size":             img.SizeBytes,
+	}
+
+	if img.Checksum != "" {
+		props["checksum"] = img.Checksum
+	}
+
+	// Add tags if present
+	if len(img.Tags) > 0 {
+		props["tags"] = img.Tags
+	}
+
+	return props
+}
+
+// Register the Image resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeImage,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+			resource.OperationCheckStatus,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &Image{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates a new image. With volume_id the volume is uploaded to Glance
+// and the image stays queued/saving until the upload finishes, which Status
+// waits for. Without a source, an empty image record is registered.
+func (i *Image) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	// Parse request properties
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// Extract image name (required)
+	name, ok := props["name"].(string)
+	if !ok || name == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resource.OperationErrorCodeInvalidRequest, "", "name is required"),
+		}, nil
+	}
+
+	diskFormat, _ := props["disk_format"].(string)
+	containerFormat, _ := props["container_format"].(string)
+	visibility, _ := props["visibility"].(string)
+
+	if volumeID, ok := props["volume_id"].(string); ok && volumeID != "" {
+		return i.createFromVolume(ctx, props, volumeID, name, diskFormat, containerFormat, visibility)
+	}
+
+	// Build create options
+	createOpts := images.CreateOpts{
+		Name:            name,
+		DiskFormat:      diskFormat,
+		ContainerFormat: containerFormat,
+		Tags:            resources.ParseTags(props["tags"]),
+	}
+
+	if visibility != "" {
+		v := images.ImageVisibility(visibility)
+		createOpts.Visibility = &v
+	}
+
+	if minDisk, ok := props["min_disk"].(float64); ok {
+		createOpts.MinDisk = int(minDisk)
+	}
+
+	client, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), "", err.Error()),
+		}, nil
+	}
+
+	// Create the image via OpenStack
+	img, err := images.Create(ctx, client, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create image: %v", err)),
+		}, nil
+	}
+
+	// Convert image to properties and marshal to JSON
+	propsJSON, err := resources.MarshalProperties(imageToProperties(img))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resource.OperationErrorCodeGeneralServiceException, img.ID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	// An image without data is complete once registered
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           img.ID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// createFromVolume uploads a Cinder volume to Glance as a new image
+func (i *Image) createFromVolume(ctx context.Context, props map[string]interface{}, volumeID, name, diskFormat, containerFormat, visibility string) (*resource.CreateResult, error) {
+	uploadOpts := volumes.UploadImageOpts{
+		ImageName:       name,
+		DiskFormat:      diskFormat,
+		ContainerFormat: containerFormat,
+		Visibility:      visibility,
+	}
+
+	// Force allows uploading a volume that is attached to an instance
+	if force, ok := props["force"].(bool); ok {
+		uploadOpts.Force = force
+	}
+
+	imageClient, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), "", err.Error()),
+		}, nil
+	}
+	blockStorageClient, err := i.Client.BlockStorageClient()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), "", err.Error()),
+		}, nil
+	}
+
+	volumeImage, err := volumes.UploadImage(ctx, blockStorageClient, volumeID, uploadOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to upload volume %s to image: %v", volumeID, err)),
+		}, nil
+	}
+
+	// The upload API has no tags or min_disk, so apply them to the queued image
+	var updateOpts images.UpdateOpts
+	if tags := resources.ParseTags(props["tags"]); len(tags) > 0 {
+		updateOpts = append(updateOpts, images.ReplaceImageTags{NewTags: tags})
+	}
+	if minDisk, ok := props["min_disk"].(float64); ok {
+		updateOpts = append(updateOpts, images.ReplaceImageMinDisk{NewMinDisk: int(minDisk)})
+	}
+	if len(updateOpts) > 0 {
+		if _, err := images.Update(ctx, imageClient, volumeImage.ImageID, updateOpts).Extract(); err != nil {
+			// Log warning but don't fail - the upload is already underway
+			warnings.Warnf(ctx, "failed to set tags/min_disk on image %s: %v", volumeImage.ImageID, err)
+		}
+	}
+
+	// Image upload is async - Status waits for the image to become active
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCreate,
+			OperationStatus: resource.OperationStatusInProgress,
+			NativeID:        volumeImage.ImageID,
+			StatusMessage:   fmt.Sprintf("uploading volume %s", volumeID),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of an image
+func (i *Image) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	id := request.NativeID
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	client, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	img, err := images.Get(ctx, client, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(imageToProperties(img))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update updates an existing image's name, visibility, min_disk, and tags
+func (i *Image) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeImage, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	id := request.NativeID
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeImage, resource.OperationErrorCodeInvalidRequest, id, err.Error()),
+		}, nil
+	}
+
+	client, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), id, err.Error()),
+		}, nil
+	}
+
+	// Build JSON-patch operations for mutable fields
+	var updateOpts images.UpdateOpts
+
+	if name, ok := props["name"].(string); ok && name != "" {
+		updateOpts = append(updateOpts, images.ReplaceImageName{NewName: name})
+	}
+
+	if visibility, ok := props["visibility"].(string); ok && visibility != "" {
+		updateOpts = append(updateOpts, images.UpdateVisibility{Visibility: images.ImageVisibility(visibility)})
+	}
+
+	if minDisk, ok := props["min_disk"].(float64); ok {
+		updateOpts = append(updateOpts, images.ReplaceImageMinDisk{NewMinDisk: int(minDisk)})
+	}
+
+	if _, hasTags := props["tags"]; hasTags {
+		tags := resources.ParseTags(props["tags"])
+		if tags == nil {
+			tags = []string{} // Empty slice to clear all tags
+		}
+		updateOpts = append(updateOpts, images.ReplaceImageTags{NewTags: tags})
+	}
+
+	var img *images.Image
+	if len(updateOpts) > 0 {
+		img, err = images.Update(ctx, client, id, updateOpts).Extract()
+	} else {
+		img, err = images.Get(ctx, client, id).Extract()
+	}
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), id, fmt.Sprintf("failed to update image: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(imageToProperties(img))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeImage, resource.OperationErrorCodeGeneralServiceException, id, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           img.ID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes an image
+func (i *Image) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeImage, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	id := request.NativeID
+
+	client, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), id, err.Error()),
+		}, nil
+	}
+
+	err = images.Delete(ctx, client, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeImage, errCode, id, fmt.Sprintf("failed to delete image: %v", err)),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        id,
+		},
+	}, nil
+}
+
+// Status waits for an uploaded image to become active
+func (i *Image) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	client, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, err.Error()),
+		}, nil
+	}
+
+	img, err := images.Get(ctx, client, request.NativeID).Extract()
+	if err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeImage, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to get image: %v", err)),
+		}, nil
+	}
+
+	switch img.Status {
+	case images.ImageStatusActive:
+		propsJSON, err := resources.MarshalProperties(imageToProperties(img))
+		if err != nil {
+			return &resource.StatusResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeImage, resource.OperationErrorCodeGeneralServiceException, img.ID, fmt.Sprintf("failed to marshal properties: %v", err)),
+			}, nil
+		}
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:          resource.OperationCheckStatus,
+				OperationStatus:    resource.OperationStatusSuccess,
+				RequestID:          request.RequestID,
+				NativeID:           img.ID,
+				ResourceProperties: []byte(propsJSON),
+			},
+		}, nil
+
+	case images.ImageStatusQueued, images.ImageStatusSaving, images.ImageStatusUploading, images.ImageStatusImporting:
+		return &resource.StatusResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationCheckStatus,
+				OperationStatus: resource.OperationStatusInProgress,
+				RequestID:       request.RequestID,
+				NativeID:        img.ID,
+				StatusMessage:   fmt.Sprintf("image status: %s", img.Status),
+			},
+		}, nil
+
+	default:
+		// killed, deleted, pending_delete, deactivated
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeImage, resource.OperationErrorCodeGeneralServiceException, img.ID, fmt.Sprintf("image entered status %s", img.Status)),
+		}, nil
+	}
+}
+
+// List discovers images owned by the project. Public images shared by OVH
+// are excluded since they are not managed by the stack.
+func (i *Image) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	listOpts := images.ListOpts{
+		Owner: i.Config.ProjectID,
+	}
+	if i.Config.ManagedByTag != "" {
+		listOpts.Tags = []string{i.Config.ManagedByTag}
+	}
+
+	client, err := i.Client.ImageClient()
+	if err != nil {
+		return &resource.ListResult{}, err
+	}
+
+	var nativeIDs []string
+	err = images.List(client, listOpts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		imgs, err := images.ExtractImages(page)
+		if err != nil {
+			return false, err
+		}
+		for _, img := range imgs {
+			nativeIDs = append(nativeIDs, img.ID)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
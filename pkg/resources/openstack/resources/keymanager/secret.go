@@ -0,0 +1,330 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package keymanager
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeSecret = "OVH::Storage::Secret"
+
+	// binaryContentType is the payload content type of raw key material,
+	// which Barbican only accepts base64 encoded
+	binaryContentType = "application/octet-stream"
+)
+
+// secretTypes are the secret types Barbican accepts, sorted for error messages
+var secretTypes = []string{
+	string(secrets.CertificateSecret),
+	string(secrets.OpaqueSecret),
+	string(secrets.PassphraseSecret),
+	string(secrets.PrivateSecret),
+	string(secrets.PublicSecret),
+	string(secrets.SymmetricSecret),
+}
+
+// Secret stores customer-supplied key material (BYOK) in Barbican. Secrets
+// are immutable: every property is create-only, and the payload is never
+// read back.
+type Secret struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// secretToProperties converts a Barbican secret's metadata to a properties map
+func secretToProperties(secret *secrets.Secret) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":          secretID(secret.SecretRef),
+		"secret_ref":  secret.SecretRef,
+		"secret_type": secret.SecretType,
+		"status":      secret.Status,
+	}
+
+	if secret.Name != "" {
+		props["name"] = secret.Name
+	}
+	if secret.Algorithm != "" {
+		props["algorithm"] = secret.Algorithm
+	}
+	if secret.BitLength > 0 {
+		props["bit_length"] = secret.BitLength
+	}
+	if secret.Mode != "" {
+		props["mode"] = secret.Mode
+	}
+	if contentType := secret.ContentTypes["default"]; contentType != "" {
+		props["payload_content_type"] = contentType
+	}
+	if !secret.Expiration.IsZero() {
+		props["expiration"] = secret.Expiration.UTC().Format(time.RFC3339)
+	}
+	if !secret.Created.IsZero() {
+		props["created"] = secret.Created.UTC().Format(time.RFC3339)
+	}
+
+	return props
+}
+
+// secretID returns the UUID at the end of a secret's href
+func secretID(secretRef string) string {
+	return path.Base(strings.TrimSuffix(secretRef, "/"))
+}
+
+// secretCreateOpts builds the create request from properties. Binary key
+// material defaults to base64 encoding, the only one Barbican accepts for it.
+func secretCreateOpts(props map[string]interface{}) (secrets.CreateOpts, error) {
+	var opts secrets.CreateOpts
+
+	if name, ok := props["name"].(string); ok {
+		opts.Name = name
+	}
+	if algorithm, ok := props["algorithm"].(string); ok {
+		opts.Algorithm = algorithm
+	}
+	if bitLength, ok := props["bit_length"].(float64); ok {
+		opts.BitLength = int(bitLength)
+	}
+	if mode, ok := props["mode"].(string); ok {
+		opts.Mode = mode
+	}
+
+	if secretType, ok := props["secret_type"].(string); ok && secretType != "" {
+		valid := false
+		for _, t := range secretTypes {
+			if t == secretType {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return opts, fmt.Errorf("secret_type %q is not valid; valid types are: %s", secretType, strings.Join(secretTypes, ", "))
+		}
+		opts.SecretType = secrets.SecretType(secretType)
+	}
+
+	if payload, ok := props["payload"].(string); ok && payload != "" {
+		opts.Payload = payload
+		opts.PayloadContentType, _ = props["payload_content_type"].(string)
+		opts.PayloadContentEncoding, _ = props["payload_content_encoding"].(string)
+		if opts.PayloadContentType == "" {
+			opts.PayloadContentType = binaryContentType
+		}
+		if opts.PayloadContentType == binaryContentType && opts.PayloadContentEncoding == "" {
+			opts.PayloadContentEncoding = "base64"
+		}
+	}
+
+	if expiration, ok := props["expiration"].(string); ok && expiration != "" {
+		expiresAt, err := time.Parse(time.RFC3339, expiration)
+		if err != nil {
+			return opts, fmt.Errorf("expiration %q is not an RFC 3339 timestamp", expiration)
+		}
+		opts.Expiration = &expiresAt
+	}
+
+	return opts, nil
+}
+
+// Register the Secret resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeSecret,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &Secret{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+	registry.RegisterSensitive(ResourceTypeSecret, "payload")
+}
+
+// Create stores a secret. Barbican stores it synchronously, so the secret is
+// read back and returned at once.
+func (s *Secret) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecret, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	createOpts, err := secretCreateOpts(props)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecret, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	client, err := s.Client.KeyManagerClient()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecret, resources.MapOpenStackErrorToOperationErrorCode(err), "", err.Error()),
+		}, nil
+	}
+
+	created, err := secrets.Create(ctx, client, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecret, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create secret: %s", resources.OpenStackErrorMessage(err))),
+		}, nil
+	}
+	id := secretID(created.SecretRef)
+
+	propsJSON, err := s.secretProperties(ctx, client, id)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecret, resources.MapOpenStackErrorToOperationErrorCode(err), id, fmt.Sprintf("failed to get secret: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           id,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves a secret's metadata; the payload is never returned
+func (s *Secret) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	client, err := s.Client.KeyManagerClient()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := s.secretProperties(ctx, client, request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update is not supported: Barbican secrets are immutable
+func (s *Secret) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	return &resource.UpdateResult{
+		ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecret, resource.OperationErrorCodeNotUpdatable, request.NativeID, "secrets cannot be updated; replace the secret instead"),
+	}, nil
+}
+
+// Delete removes a secret
+func (s *Secret) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecret, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	id := request.NativeID
+
+	client, err := s.Client.KeyManagerClient()
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecret, resources.MapOpenStackErrorToOperationErrorCode(err), id, err.Error()),
+		}, nil
+	}
+
+	err = secrets.Delete(ctx, client, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecret, errCode, id, fmt.Sprintf("failed to delete secret: %v", err)),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        id,
+		},
+	}, nil
+}
+
+// Status returns success immediately (secrets are stored synchronously)
+func (s *Secret) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// List discovers the secrets of the project
+func (s *Secret) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	client, err := s.Client.KeyManagerClient()
+	if err != nil {
+		return &resource.ListResult{}, err
+	}
+
+	var nativeIDs []string
+	err = secrets.List(client, secrets.ListOpts{}).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		list, err := secrets.ExtractSecrets(page)
+		if err != nil {
+			return false, err
+		}
+		for _, secret := range list {
+			nativeIDs = append(nativeIDs, secretID(secret.SecretRef))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
+
+// secretProperties fetches a secret's metadata as marshaled properties
+func (s *Secret) secretProperties(ctx context.Context, client *gophercloud.ServiceClient, id string) (string, error) {
+	secret, err := secrets.Get(ctx, client, id).Extract()
+	if err != nil {
+		return "", err
+	}
+	return resources.MarshalProperties(secretToProperties(secret))
+}
@@ -0,0 +1,64 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package keymanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCreateOpts(t *testing.T) {
+	opts, err := secretCreateOpts(map[string]interface{}{
+		"name":        "volume-key",
+		"secret_type": "symmetric",
+		"algorithm":   "aes",
+		"bit_length":  float64(256),
+		"mode":        "xts",
+		"payload":     "c2VjcmV0LWtleS1tYXRlcmlhbA==",
+		"expiration":  "2030-01-01T00:00:00Z",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "volume-key", opts.Name)
+	assert.Equal(t, secrets.SymmetricSecret, opts.SecretType)
+	assert.Equal(t, 256, opts.BitLength)
+	assert.Equal(t, "application/octet-stream", opts.PayloadContentType)
+	assert.Equal(t, "base64", opts.PayloadContentEncoding)
+	require.NotNil(t, opts.Expiration)
+	assert.True(t, opts.Expiration.Equal(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	// Text payloads are sent as given
+	opts, err = secretCreateOpts(map[string]interface{}{"payload": "hunter2", "payload_content_type": "text/plain"})
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain", opts.PayloadContentType)
+	assert.Empty(t, opts.PayloadContentEncoding)
+
+	_, err = secretCreateOpts(map[string]interface{}{"secret_type": "password"})
+	assert.ErrorContains(t, err, "valid types are: certificate, opaque")
+	_, err = secretCreateOpts(map[string]interface{}{"expiration": "next year"})
+	assert.ErrorContains(t, err, "RFC 3339")
+}
+
+func TestSecretToProperties(t *testing.T) {
+	props := secretToProperties(&secrets.Secret{
+		SecretRef:    "https://kms.example.net/v1/secrets/0c6e5d2b-7b8a-4c1e-9f3d-1a2b3c4d5e6f",
+		Name:         "volume-key",
+		SecretType:   "symmetric",
+		Status:       "ACTIVE",
+		Algorithm:    "aes",
+		BitLength:    256,
+		ContentTypes: map[string]string{"default": "application/octet-stream"},
+	})
+
+	assert.Equal(t, "0c6e5d2b-7b8a-4c1e-9f3d-1a2b3c4d5e6f", props["id"])
+	assert.Equal(t, "volume-key", props["name"])
+	assert.Equal(t, 256, props["bit_length"])
+	assert.Equal(t, "application/octet-stream", props["payload_content_type"])
+	assert.NotContains(t, props, "payload")
+	assert.NotContains(t, props, "expiration")
+}
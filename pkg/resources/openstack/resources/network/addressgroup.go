@@ -0,0 +1,444 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeAddressGroup = "OVH::Network::AddressGroup"
+
+	// addressGroupExtension is the Neutron extension that adds address groups
+	addressGroupExtension = "address-group"
+)
+
+// AddressGroup provisioner. An address group is a named set of CIDRs that
+// security group rules reference through remote_address_group_id, so a list
+// of prefixes is kept in one place instead of repeated in every rule.
+// gophercloud has no address group package, so the API is called directly.
+type AddressGroup struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// addressGroup is a Neutron address group as returned by the API
+type addressGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Addresses   []string `json:"addresses"`
+}
+
+// addressGroupToProperties converts an address group to a properties map.
+// Addresses are sorted, since Neutron keeps them as an unordered set.
+func addressGroupToProperties(group *addressGroup) map[string]interface{} {
+	addresses := make([]string, len(group.Addresses))
+	copy(addresses, group.Addresses)
+	sort.Strings(addresses)
+
+	props := map[string]interface{}{
+		"id":        group.ID,
+		"name":      group.Name,
+		"addresses": addresses,
+	}
+	if group.Description != "" {
+		props["description"] = group.Description
+	}
+	return props
+}
+
+// addressGroupAddresses reads the addresses property as CIDRs. Neutron
+// stores a bare IP as a /32 or /128, so bare IPs are written that way here
+// too and read back unchanged.
+func addressGroupAddresses(props map[string]interface{}) ([]string, error) {
+	items, _ := props["addresses"].([]interface{})
+	seen := make(map[string]bool, len(items))
+	addresses := make([]string, 0, len(items))
+	for i, item := range items {
+		address, _ := item.(string)
+		address = strings.TrimSpace(address)
+		if !strings.Contains(address, "/") {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				return nil, fmt.Errorf("addresses[%d]: %q is not an IP address or CIDR", i, address)
+			}
+			if ip.To4() != nil {
+				address += "/32"
+			} else {
+				address += "/128"
+			}
+		} else if _, _, err := net.ParseCIDR(address); err != nil {
+			return nil, fmt.Errorf("addresses[%d]: %q is not an IP address or CIDR", i, address)
+		}
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses, nil
+}
+
+// addressGroupChanges returns the addresses to add to and remove from a
+// group holding current so that it holds desired
+func addressGroupChanges(desired, current []string) (toAdd, toRemove []string) {
+	want := make(map[string]bool, len(desired))
+	for _, address := range desired {
+		want[address] = true
+	}
+	have := make(map[string]bool, len(current))
+	for _, address := range current {
+		have[address] = true
+		if !want[address] {
+			toRemove = append(toRemove, address)
+		}
+	}
+	for _, address := range desired {
+		if !have[address] {
+			toAdd = append(toAdd, address)
+		}
+	}
+	return toAdd, toRemove
+}
+
+// createAddressGroup creates an address group
+func createAddressGroup(ctx context.Context, netClient *gophercloud.ServiceClient, group map[string]interface{}) (*addressGroup, error) {
+	var body struct {
+		AddressGroup addressGroup `json:"address_group"`
+	}
+	_, err := netClient.Post(ctx, netClient.ServiceURL("address-groups"), map[string]interface{}{"address_group": group}, &body, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &body.AddressGroup, nil
+}
+
+// getAddressGroup fetches an address group
+func getAddressGroup(ctx context.Context, netClient *gophercloud.ServiceClient, id string) (*addressGroup, error) {
+	var body struct {
+		AddressGroup addressGroup `json:"address_group"`
+	}
+	_, err := netClient.Get(ctx, netClient.ServiceURL("address-groups", id), &body, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &body.AddressGroup, nil
+}
+
+// updateAddressGroup changes the name or description of an address group.
+// Addresses are changed through changeAddressGroupAddresses instead.
+func updateAddressGroup(ctx context.Context, netClient *gophercloud.ServiceClient, id string, group map[string]interface{}) error {
+	_, err := netClient.Put(ctx, netClient.ServiceURL("address-groups", id), map[string]interface{}{"address_group": group}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// changeAddressGroupAddresses adds or removes addresses with the
+// add_addresses or remove_addresses action
+func changeAddressGroupAddresses(ctx context.Context, netClient *gophercloud.ServiceClient, id, action string, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	_, err := netClient.Put(ctx, netClient.ServiceURL("address-groups", id, action), map[string]interface{}{"addresses": addresses}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// Register the AddressGroup resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeAddressGroup,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &AddressGroup{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates an address group
+func (a *AddressGroup) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	name, _ := props["name"].(string)
+	if name == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", "name is required"),
+		}, nil
+	}
+	addresses, err := addressGroupAddresses(props)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	if !a.Client.HasNetworkExtension(ctx, netClient, addressGroupExtension) {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", fmt.Sprintf("the network service at %s does not support address groups (the %s extension)", netClient.Endpoint, addressGroupExtension)),
+		}, nil
+	}
+
+	body := map[string]interface{}{
+		"name":      name,
+		"addresses": addresses,
+	}
+	if description, ok := props["description"].(string); ok {
+		body["description"] = description
+	}
+
+	group, err := createAddressGroup(ctx, netClient, body)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create address group: %s", resources.OpenStackErrorMessage(err))),
+		}, nil
+	}
+
+	nativeID := resources.RegionalNativeID(region, group.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(addressGroupToProperties(group), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressGroup, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of an address group
+func (a *AddressGroup) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	group, err := getAddressGroup(ctx, netClient, id)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(addressGroupToProperties(group), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update renames an address group and brings its addresses in line with
+// the desired list. Addresses are added before stale ones are removed, so
+// rules referencing the group never briefly match less than either list.
+func (a *AddressGroup) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+	addresses, err := addressGroupAddresses(props)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	current, err := getAddressGroup(ctx, netClient, id)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to get address group: %v", err)),
+		}, nil
+	}
+
+	fields := map[string]interface{}{}
+	if name, ok := props["name"].(string); ok && name != current.Name {
+		fields["name"] = name
+	}
+	if description, _ := props["description"].(string); description != current.Description {
+		fields["description"] = description
+	}
+	if len(fields) > 0 {
+		if err := updateAddressGroup(ctx, netClient, id, fields); err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update address group: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	toAdd, toRemove := addressGroupChanges(addresses, current.Addresses)
+	if err := changeAddressGroupAddresses(ctx, netClient, id, "add_addresses", toAdd); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to add addresses to address group: %s", resources.OpenStackErrorMessage(err))),
+		}, nil
+	}
+	if err := changeAddressGroupAddresses(ctx, netClient, id, "remove_addresses", toRemove); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to remove addresses from address group: %s", resources.OpenStackErrorMessage(err))),
+		}, nil
+	}
+
+	group, err := getAddressGroup(ctx, netClient, id)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to get address group: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(addressGroupToProperties(group), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressGroup, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes an address group. Neutron refuses while security group
+// rules still reference it.
+func (a *AddressGroup) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeAddressGroup, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	_, err = netClient.Delete(ctx, netClient.ServiceURL("address-groups", id), nil)
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeAddressGroup, errCode, request.NativeID, fmt.Sprintf("failed to delete address group: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (address groups are synchronous, so not used)
+func (a *AddressGroup) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers address groups. They cannot be tagged, so the managed-by
+// tag does not filter them. Endpoints without the extension have none.
+func (a *AddressGroup) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	netClient := a.Client.NetworkClient
+	if !a.Client.HasNetworkExtension(ctx, netClient, addressGroupExtension) {
+		return &resource.ListResult{}, nil
+	}
+
+	var body struct {
+		AddressGroups []addressGroup `json:"address_groups"`
+	}
+	if _, err := netClient.Get(ctx, netClient.ServiceURL("address-groups"), &body, nil); err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list address groups: %w", err)
+	}
+
+	nativeIDs := make([]string, 0, len(body.AddressGroups))
+	for _, group := range body.AddressGroups {
+		nativeIDs = append(nativeIDs, group.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
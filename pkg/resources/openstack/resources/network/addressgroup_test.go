@@ -0,0 +1,67 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressGroupAddresses(t *testing.T) {
+	addresses, err := addressGroupAddresses(map[string]interface{}{
+		"addresses": []interface{}{"203.0.113.0/24", "198.51.100.7", "2001:db8::1", " 203.0.113.0/24 "},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"198.51.100.7/32", "2001:db8::1/128", "203.0.113.0/24"}, addresses)
+
+	addresses, err = addressGroupAddresses(map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Empty(t, addresses)
+
+	_, err = addressGroupAddresses(map[string]interface{}{"addresses": []interface{}{"10.0.0.0/33"}})
+	assert.ErrorContains(t, err, "addresses[0]")
+	_, err = addressGroupAddresses(map[string]interface{}{"addresses": []interface{}{"10.0.0.1", "office"}})
+	assert.ErrorContains(t, err, `addresses[1]: "office"`)
+}
+
+func TestAddressGroupChanges(t *testing.T) {
+	toAdd, toRemove := addressGroupChanges(
+		[]string{"10.0.0.0/24", "10.0.1.0/24"},
+		[]string{"10.0.1.0/24", "10.0.2.0/24"},
+	)
+	assert.Equal(t, []string{"10.0.0.0/24"}, toAdd)
+	assert.Equal(t, []string{"10.0.2.0/24"}, toRemove)
+
+	toAdd, toRemove = addressGroupChanges([]string{"10.0.0.0/24"}, []string{"10.0.0.0/24"})
+	assert.Empty(t, toAdd)
+	assert.Empty(t, toRemove)
+}
+
+func TestRuleCreateOptsFromProperties_AddressGroup(t *testing.T) {
+	props := map[string]interface{}{
+		"security_group_id":       "sg-1",
+		"direction":               "ingress",
+		"ethertype":               "IPv4",
+		"protocol":                "tcp",
+		"port_range_min":          float64(22),
+		"port_range_max":          float64(22),
+		"remote_address_group_id": "ag-1",
+	}
+	opts, err := ruleCreateOptsFromProperties(props)
+	require.NoError(t, err)
+	assert.Equal(t, "ag-1", opts.RemoteAddressGroupID)
+
+	body, err := opts.ToSecGroupRuleCreateMap()
+	require.NoError(t, err)
+	rule := body["security_group_rule"].(map[string]any)
+	assert.Equal(t, "ag-1", rule["remote_address_group_id"])
+	assert.Equal(t, "sg-1", rule["security_group_id"])
+
+	props["remote_ip_prefix"] = "0.0.0.0/0"
+	_, err = ruleCreateOptsFromProperties(props)
+	assert.ErrorContains(t, err, "only one of")
+}
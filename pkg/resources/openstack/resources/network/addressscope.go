@@ -0,0 +1,274 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/addressscopes"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeAddressScope = "OVH::Network::AddressScope"
+)
+
+// AddressScope provisioner. An address scope groups subnet pools whose
+// prefixes must not overlap; routers only route between subnets of the same
+// scope without NAT.
+type AddressScope struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// addressScopeToProperties converts an OpenStack address scope to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+func addressScopeToProperties(scope *addressscopes.AddressScope) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         scope.ID,
+		"name":       scope.Name,
+		"ip_version": scope.IPVersion,
+		"shared":     scope.Shared,
+	}
+}
+
+// Register the AddressScope resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeAddressScope,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &AddressScope{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates an address scope
+func (a *AddressScope) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	name, _ := props["name"].(string)
+	ipVersion, _ := props["ip_version"].(float64)
+	if name == "" || (ipVersion != 4 && ipVersion != 6) {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, "", "name and ip_version (4 or 6) are required"),
+		}, nil
+	}
+
+	createOpts := addressscopes.CreateOpts{
+		Name:      name,
+		IPVersion: int(ipVersion),
+	}
+	createOpts.Shared, _ = props["shared"].(bool)
+
+	scope, err := addressscopes.Create(ctx, netClient, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressScope, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create address scope: %v", err)),
+		}, nil
+	}
+
+	nativeID := resources.RegionalNativeID(region, scope.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(addressScopeToProperties(scope), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeAddressScope, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of an address scope
+func (a *AddressScope) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	scope, err := addressscopes.Get(ctx, netClient, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(addressScopeToProperties(scope), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update renames an address scope or shares it with other projects. Neutron
+// refuses to unshare a scope that other projects' pools belong to.
+func (a *AddressScope) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	updateOpts := addressscopes.UpdateOpts{}
+	if name, ok := props["name"].(string); ok {
+		updateOpts.Name = &name
+	}
+	shared, _ := props["shared"].(bool)
+	updateOpts.Shared = &shared
+
+	scope, err := addressscopes.Update(ctx, netClient, id, updateOpts).Extract()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressScope, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update address scope: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(addressScopeToProperties(scope), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeAddressScope, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes an address scope. Neutron refuses while subnet pools still
+// belong to it.
+func (a *AddressScope) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := a.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeAddressScope, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = addressscopes.Delete(ctx, netClient, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeAddressScope, errCode, request.NativeID, fmt.Sprintf("failed to delete address scope: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (address scopes are synchronous, so not used)
+func (a *AddressScope) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers address scopes. They cannot be tagged, so the managed-by
+// tag does not filter them.
+func (a *AddressScope) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := addressscopes.List(a.Client.NetworkClient, addressscopes.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list address scopes: %w", err)
+	}
+
+	scopeList, err := addressscopes.ExtractAddressScopes(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract address scopes: %w", err)
+	}
+
+	nativeIDs := make([]string, 0, len(scopeList))
+	for _, scope := range scopeList {
+		nativeIDs = append(nativeIDs, scope.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -0,0 +1,204 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+)
+
+// Neutron does not enforce unique names, so a Create retried after its
+// response was lost would quietly make a duplicate network or security group.
+// Create first looks for the resource an earlier attempt made and adopts it.
+// A name alone is not enough to claim a resource: the desired tags must be
+// set and carried by the existing resource, so one the stack does not own is
+// never taken over, and every other desired property must match. Adoption is
+// skipped when the lookup fails or more than one resource matches.
+//
+// Tags are set only once the create call returns, so the resource left by a
+// create whose response was lost carries none. After such a failure, and on
+// the retry of that resource's Create (see prov.LostCreates), an untagged
+// resource matching the name and properties, made since the lost attempt,
+// is adopted as that orphan and tagged.
+
+// mayHaveCreated reports whether a failed create call could still have made
+// the resource: anything but a 4xx rejection, and a 409 conflict, which
+// reports a resource that already exists
+func mayHaveCreated(err error) bool {
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &respErr) {
+		return respErr.Actual == http.StatusConflict || respErr.Actual >= 500
+	}
+	return true
+}
+
+// isOrphan reports whether a resource can be the one a lost create made
+// since attempted: it has no tags and is not older than the attempt
+func isOrphan(tags []string, createdAt, attempted time.Time) bool {
+	return len(tags) == 0 && !createdAt.Before(attempted.Add(-prov.AdoptClockMargin))
+}
+
+// findNetworkToAdopt returns the single network matching the desired name,
+// tags and properties, or nil
+func findNetworkToAdopt(ctx context.Context, netClient *gophercloud.ServiceClient, props map[string]interface{}, tags []string) *networkWithMTU {
+	name, _ := props["name"].(string)
+	if name == "" || len(tags) == 0 {
+		return nil
+	}
+
+	allPages, err := networks.List(netClient, networks.ListOpts{Name: name, Tags: strings.Join(tags, ",")}).AllPages(ctx)
+	if err != nil {
+		return nil
+	}
+	var existing []networkWithMTU
+	if err := networks.ExtractNetworksInto(allPages, &existing); err != nil {
+		return nil
+	}
+
+	var match *networkWithMTU
+	for i := range existing {
+		if !networkMatches(existing[i], props) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = &existing[i]
+	}
+	return match
+}
+
+// networkMatches reports whether an existing network has every property
+// the desired one sets
+func networkMatches(existing networkWithMTU, props map[string]interface{}) bool {
+	if name, _ := props["name"].(string); existing.Name != name {
+		return false
+	}
+	if description, _ := props["description"].(string); existing.Description != description {
+		return false
+	}
+	if adminStateUp, ok := props["admin_state_up"].(bool); ok && existing.AdminStateUp != adminStateUp {
+		return false
+	}
+	if shared, ok := props["shared"].(bool); ok && existing.Shared != shared {
+		return false
+	}
+	if isExternal, ok := props["external"].(bool); ok && existing.External != isExternal {
+		return false
+	}
+	if mtuVal, ok := props["mtu"].(float64); ok && mtuVal > 0 && existing.MTU != int(mtuVal) {
+		return false
+	}
+	return true
+}
+
+// findOrphanedNetwork returns the single untagged network a lost create of
+// attemptKey left, matching the desired name and properties, or nil
+func findOrphanedNetwork(ctx context.Context, netClient *gophercloud.ServiceClient, props map[string]interface{}, attemptKey string) *networkWithMTU {
+	attempted, ok := prov.LostCreates.Since(attemptKey)
+	name, _ := props["name"].(string)
+	if !ok || name == "" {
+		return nil
+	}
+
+	allPages, err := networks.List(netClient, networks.ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return nil
+	}
+	var existing []networkWithMTU
+	if err := networks.ExtractNetworksInto(allPages, &existing); err != nil {
+		return nil
+	}
+
+	var match *networkWithMTU
+	for i := range existing {
+		if !isOrphan(existing[i].Tags, existing[i].CreatedAt, attempted) || !networkMatches(existing[i], props) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = &existing[i]
+	}
+	return match
+}
+
+// findSecurityGroupToAdopt returns the single security group matching the
+// desired name, tags and description, or nil
+func findSecurityGroupToAdopt(ctx context.Context, netClient *gophercloud.ServiceClient, props map[string]interface{}, tags []string) *groups.SecGroup {
+	name, _ := props["name"].(string)
+	if name == "" || len(tags) == 0 {
+		return nil
+	}
+
+	allPages, err := groups.List(netClient, groups.ListOpts{Name: name, Tags: strings.Join(tags, ",")}).AllPages(ctx)
+	if err != nil {
+		return nil
+	}
+	existing, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil
+	}
+
+	var match *groups.SecGroup
+	for i := range existing {
+		if !securityGroupMatches(existing[i], props) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = &existing[i]
+	}
+	return match
+}
+
+// securityGroupMatches reports whether an existing security group has the
+// desired name and description
+func securityGroupMatches(existing groups.SecGroup, props map[string]interface{}) bool {
+	name, _ := props["name"].(string)
+	description, _ := props["description"].(string)
+	return existing.Name == name && existing.Description == description
+}
+
+// findOrphanedSecurityGroup returns the single untagged security group a
+// lost create of attemptKey left, matching the desired name and
+// description, or nil
+func findOrphanedSecurityGroup(ctx context.Context, netClient *gophercloud.ServiceClient, props map[string]interface{}, attemptKey string) *groups.SecGroup {
+	attempted, ok := prov.LostCreates.Since(attemptKey)
+	name, _ := props["name"].(string)
+	if !ok || name == "" {
+		return nil
+	}
+
+	allPages, err := groups.List(netClient, groups.ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return nil
+	}
+	existing, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil
+	}
+
+	var match *groups.SecGroup
+	for i := range existing {
+		if !isOrphan(existing[i].Tags, existing[i].CreatedAt, attempted) || !securityGroupMatches(existing[i], props) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = &existing[i]
+	}
+	return match
+}
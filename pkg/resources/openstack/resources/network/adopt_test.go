@@ -0,0 +1,173 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/mtu"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkMatches(t *testing.T) {
+	existing := networkWithMTU{
+		Network:       networks.Network{Name: "app", AdminStateUp: true},
+		NetworkMTUExt: mtu.NetworkMTUExt{MTU: 1500},
+	}
+
+	assert.True(t, networkMatches(existing, map[string]interface{}{"name": "app"}))
+	assert.True(t, networkMatches(existing, map[string]interface{}{"name": "app", "admin_state_up": true, "mtu": float64(1500)}))
+
+	assert.False(t, networkMatches(existing, map[string]interface{}{"name": "db"}))
+	assert.False(t, networkMatches(existing, map[string]interface{}{"name": "app", "description": "app network"}))
+	assert.False(t, networkMatches(existing, map[string]interface{}{"name": "app", "shared": true}))
+	assert.False(t, networkMatches(existing, map[string]interface{}{"name": "app", "mtu": float64(9000)}))
+}
+
+func TestSecurityGroupMatches(t *testing.T) {
+	existing := groups.SecGroup{Name: "web", Description: "web tier"}
+
+	assert.True(t, securityGroupMatches(existing, map[string]interface{}{"name": "web", "description": "web tier"}))
+	assert.False(t, securityGroupMatches(existing, map[string]interface{}{"name": "web"}))
+	assert.False(t, securityGroupMatches(existing, map[string]interface{}{"name": "api", "description": "web tier"}))
+}
+
+// lossyNeutron serves network calls where every create is made but its
+// response is lost, as when the call times out at a proxy
+type lossyNeutron struct {
+	mu       sync.Mutex
+	networks []map[string]interface{}
+	posts    int
+	// hidden holds back created networks from listings until shown
+	hidden bool
+}
+
+func newLossyNeutron(t *testing.T, neutron *lossyNeutron) *Network {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		neutron.mu.Lock()
+		defer neutron.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/extensions":
+			json.NewEncoder(w).Encode(map[string]interface{}{"extensions": []interface{}{
+				map[string]interface{}{"alias": "standard-attr-tag"},
+			}})
+		case r.Method == "POST" && r.URL.Path == "/networks":
+			neutron.posts++
+			neutron.networks = append(neutron.networks, map[string]interface{}{
+				"id":         "net-orphan",
+				"name":       "app",
+				"tags":       []interface{}{},
+				"created_at": time.Now().UTC().Format(time.RFC3339),
+			})
+			w.WriteHeader(http.StatusGatewayTimeout)
+		case r.Method == "GET" && r.URL.Path == "/networks":
+			var list []map[string]interface{}
+			if !neutron.hidden && r.URL.Query().Get("tags") == "" {
+				for _, net := range neutron.networks {
+					if net["name"] == r.URL.Query().Get("name") {
+						list = append(list, net)
+					}
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"networks": list})
+		case r.Method == "PUT" && r.URL.Path == "/networks/net-orphan/tags":
+			var body struct {
+				Tags []interface{} `json:"tags"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			neutron.networks[0]["tags"] = body.Tags
+			json.NewEncoder(w).Encode(map[string]interface{}{"tags": body.Tags})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	netClient := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: server.URL + "/"}
+	return &Network{Client: &openstack.Client{NetworkClient: netClient}, Config: &openstack.Config{}}
+}
+
+func networkCreateRequest(t *testing.T) *resource.CreateRequest {
+	t.Helper()
+	request := &resource.CreateRequest{
+		ResourceType: ResourceTypeNetwork,
+		Label:        "app-network",
+		Properties:   json.RawMessage(`{"name": "app", "tags": ["managed-by=formae"]}`),
+	}
+	t.Cleanup(func() { prov.LostCreates.Clear(prov.CreateAttemptKey(request, "")) })
+	return request
+}
+
+func TestNetwork_CreateAdoptsUntaggedOrphanOfLostCreate(t *testing.T) {
+	neutron := &lossyNeutron{}
+	n := newLossyNeutron(t, neutron)
+
+	result, err := n.Create(context.Background(), networkCreateRequest(t))
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "net-orphan", result.ProgressResult.NativeID)
+	assert.Equal(t, 1, neutron.posts)
+	assert.Equal(t, []interface{}{"managed-by=formae"}, neutron.networks[0]["tags"], "the orphan is tagged once adopted")
+}
+
+func TestNetwork_RetriedCreateAdoptsUntaggedOrphan(t *testing.T) {
+	neutron := &lossyNeutron{hidden: true}
+	n := newLossyNeutron(t, neutron)
+	request := networkCreateRequest(t)
+
+	result, err := n.Create(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+
+	// The orphan shows up in listings by the time formae retries
+	neutron.hidden = false
+	result, err = n.Create(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "net-orphan", result.ProgressResult.NativeID)
+	assert.Equal(t, 1, neutron.posts, "the retry must not create a second network")
+}
+
+func TestFindOrphanedNetwork_NeedsLostCreate(t *testing.T) {
+	neutron := &lossyNeutron{networks: []map[string]interface{}{{
+		"id":         "net-other",
+		"name":       "app",
+		"tags":       []interface{}{},
+		"created_at": time.Now().UTC().Format(time.RFC3339),
+	}}}
+	n := newLossyNeutron(t, neutron)
+
+	assert.Nil(t, findOrphanedNetwork(context.Background(), n.Client.NetworkClient, map[string]interface{}{"name": "app"}, "network|app-network|"))
+
+	// Created before the lost attempt, so it is not that attempt's orphan
+	key := "network|app-network|"
+	prov.LostCreates.Record(key)
+	t.Cleanup(func() { prov.LostCreates.Clear(key) })
+	neutron.networks[0]["created_at"] = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	assert.Nil(t, findOrphanedNetwork(context.Background(), n.Client.NetworkClient, map[string]interface{}{"name": "app"}, key))
+}
+
+func TestMayHaveCreated(t *testing.T) {
+	assert.True(t, mayHaveCreated(context.DeadlineExceeded))
+	assert.True(t, mayHaveCreated(gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusConflict}))
+	assert.True(t, mayHaveCreated(gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusServiceUnavailable}))
+	assert.False(t, mayHaveCreated(gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusBadRequest}))
+}
@@ -0,0 +1,98 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// Neutron extensions that expose which agents host a network or router
+const (
+	dhcpAgentSchedulerExtension = "dhcp_agent_scheduler"
+	l3AgentSchedulerExtension   = "l3_agent_scheduler"
+)
+
+// networkAgent is a Neutron agent hosting a network or router
+type networkAgent struct {
+	ID           string `json:"id"`
+	Host         string `json:"host"`
+	Alive        bool   `json:"alive"`
+	AdminStateUp bool   `json:"admin_state_up"`
+	HAState      string `json:"ha_state"`
+}
+
+// agentAccess remembers the Neutron endpoints that refused to list agents,
+// so a credential without the admin role pays for one refused call per
+// process rather than one per Read
+var agentAccess = struct {
+	sync.Mutex
+	denied map[string]bool
+}{denied: make(map[string]bool)}
+
+// agentBindings lists the agents hosting a network or router for
+// troubleshooting, e.g. GET /v2.0/networks/{id}/dhcp-agents. Listing agents
+// is admin-only by default, so a refusal, or an endpoint without the
+// scheduler extension, omits the bindings (ok is false) instead of failing
+// the Read.
+func agentBindings(ctx context.Context, client *openstack.Client, netClient *gophercloud.ServiceClient, extension string, path ...string) (bindings []interface{}, ok bool) {
+	agentAccess.Lock()
+	denied := agentAccess.denied[netClient.Endpoint]
+	agentAccess.Unlock()
+	if denied || !client.HasNetworkExtension(ctx, netClient, extension) {
+		return nil, false
+	}
+
+	var body struct {
+		Agents []networkAgent `json:"agents"`
+	}
+	if _, err := netClient.Get(ctx, netClient.ServiceURL(path...), &body, nil); err != nil {
+		switch resources.MapOpenStackErrorToOperationErrorCode(err) {
+		case resource.OperationErrorCodeAccessDenied:
+			agentAccess.Lock()
+			agentAccess.denied[netClient.Endpoint] = true
+			agentAccess.Unlock()
+		case resource.OperationErrorCodeNotFound:
+			// The network or router went away after it was read
+		default:
+			warnings.Warnf(ctx, "failed to list agents for %v: %v", path, err)
+		}
+		return nil, false
+	}
+	return agentsToProperties(body.Agents), true
+}
+
+// agentsToProperties converts agents to a list of properties, sorted by host
+func agentsToProperties(agents []networkAgent) []interface{} {
+	sort.Slice(agents, func(i, j int) bool {
+		if agents[i].Host != agents[j].Host {
+			return agents[i].Host < agents[j].Host
+		}
+		return agents[i].ID < agents[j].ID
+	})
+
+	bindings := make([]interface{}, 0, len(agents))
+	for _, agent := range agents {
+		binding := map[string]interface{}{
+			"id":             agent.ID,
+			"host":           agent.Host,
+			"alive":          agent.Alive,
+			"admin_state_up": agent.AdminStateUp,
+		}
+		// Only L3 agents of HA routers report an active or standby state
+		if agent.HAState != "" {
+			binding["ha_state"] = agent.HAState
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
@@ -0,0 +1,25 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentsToProperties(t *testing.T) {
+	bindings := agentsToProperties([]networkAgent{
+		{ID: "a2", Host: "net-2", Alive: false, AdminStateUp: true, HAState: "standby"},
+		{ID: "a1", Host: "net-1", Alive: true, AdminStateUp: true},
+	})
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "a1", "host": "net-1", "alive": true, "admin_state_up": true},
+		map[string]interface{}{"id": "a2", "host": "net-2", "alive": false, "admin_state_up": true, "ha_state": "standby"},
+	}, bindings)
+
+	assert.Empty(t, agentsToProperties(nil))
+}
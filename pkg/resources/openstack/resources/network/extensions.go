@@ -0,0 +1,19 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+)
+
+// tagsSupported reports whether netClient's Neutron endpoint supports tags.
+// Without the extension, tags are neither set nor read, and the tags
+// property is left out of the resource's properties.
+func tagsSupported(ctx context.Context, client *openstack.Client, netClient *gophercloud.ServiceClient) bool {
+	return client.HasNetworkExtension(ctx, netClient, openstack.NetworkTagExtension)
+}
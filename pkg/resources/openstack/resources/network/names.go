@@ -0,0 +1,33 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// idsByName returns the IDs of the resources a name-filtered listing finds.
+// It backs the ResolveName methods. Unlike List, the listing is not narrowed
+// to the ManagedByTag, so untagged resources match too and existing
+// infrastructure can be imported by name. kind names the resources in errors.
+func idsByName[T any](ctx context.Context, pager pagination.Pager, kind string, extract func(pagination.Page) ([]T, error), id func(T) string) ([]string, error) {
+	allPages, err := pager.AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", kind, err)
+	}
+	list, err := extract(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", kind, err)
+	}
+
+	ids := make([]string, 0, len(list))
+	for _, item := range list {
+		ids = append(ids, id(item))
+	}
+	return ids, nil
+}
@@ -0,0 +1,45 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveName_MatchesUntagged(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"routers": []interface{}{
+			map[string]interface{}{"id": "router-1", "name": "edge"},
+			map[string]interface{}{"id": "router-2", "name": "edge", "tags": []string{"formae"}},
+		}})
+	}))
+	t.Cleanup(server.Close)
+
+	router := &Router{
+		Client: &openstack.Client{NetworkClient: &gophercloud.ServiceClient{
+			ProviderClient: &gophercloud.ProviderClient{},
+			Endpoint:       server.URL + "/",
+		}},
+		Config: &openstack.Config{ManagedByTag: "formae"},
+	}
+
+	ids, err := router.ResolveName(context.Background(), &resource.ListRequest{}, "edge")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"router-1", "router-2"}, ids)
+	assert.Equal(t, "name=edge", query, "the lookup must not filter on the managed-by tag")
+}
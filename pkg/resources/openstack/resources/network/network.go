@@ -8,7 +8,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/mtu"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
@@ -18,13 +18,19 @@ import (
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 )
 
-// networkWithMTU embeds networks.Network and mtu.NetworkMTUExt to properly
-// extract the MTU field from OpenStack API responses.
+// networkWithMTU embeds networks.Network, mtu.NetworkMTUExt and
+// external.NetworkExternalExt to properly extract the MTU and router:external
+// fields from OpenStack API responses.
 type networkWithMTU struct {
 	networks.Network
 	mtu.NetworkMTUExt
+	external.NetworkExternalExt
 }
 
+// adminNetworkHint explains a refused external or shared network, which
+// Neutron's default policy reserves for admins
+const adminNetworkHint = "external and shared networks require the admin role"
+
 const (
 	ResourceTypeNetwork = "OVH::Network::Network"
 )
@@ -44,6 +50,7 @@ func networkToProperties(net *networkWithMTU) map[string]interface{} {
 		"description":    net.Description,
 		"admin_state_up": net.AdminStateUp,
 		"shared":         net.Shared,
+		"external":       net.External,
 	}
 
 	// Add MTU if non-zero
@@ -61,6 +68,17 @@ func networkToProperties(net *networkWithMTU) map[string]interface{} {
 	return props
 }
 
+// networkErrorMessage describes a failed create or update, explaining the
+// admin role requirement when Neutron refused an external or shared network
+func networkErrorMessage(action string, props map[string]interface{}, err error) string {
+	isExternal, _ := props["external"].(bool)
+	shared, _ := props["shared"].(bool)
+	if (isExternal || shared) && resources.MapOpenStackErrorToOperationErrorCode(err) == resource.OperationErrorCodeAccessDenied {
+		return fmt.Sprintf("failed to %s network: %s: %v", action, adminNetworkHint, err)
+	}
+	return fmt.Sprintf("failed to %s network: %v", action, err)
+}
+
 // Register the Network resource type
 func init() {
 	registry.RegisterOpenStack(
@@ -91,6 +109,15 @@ func (n *Network) Create(ctx context.Context, request *resource.CreateRequest) (
 		}, nil
 	}
 
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := n.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeNetwork, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
 	// Build create options
 	createOpts := networks.CreateOpts{}
 
@@ -114,57 +141,86 @@ func (n *Network) Create(ctx context.Context, request *resource.CreateRequest) (
 		createOpts.Shared = &shared
 	}
 
-	// Wrap with MTU extension if MTU is specified
+	// Wrap with the external extension if router:external is specified
 	var finalCreateOpts networks.CreateOptsBuilder = createOpts
+	if isExternal, ok := props["external"].(bool); ok {
+		finalCreateOpts = external.CreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			External:          &isExternal,
+		}
+	}
+
+	// Wrap with MTU extension if MTU is specified
 	if mtuVal, ok := props["mtu"].(float64); ok && mtuVal > 0 {
 		finalCreateOpts = mtu.CreateOptsExt{
-			CreateOptsBuilder: createOpts,
+			CreateOptsBuilder: finalCreateOpts,
 			MTU:               int(mtuVal),
 		}
 	}
 
-	// Create the network via OpenStack
-	net, err := networks.Create(ctx, n.Client.NetworkClient, finalCreateOpts).Extract()
-	if err != nil {
-		return &resource.CreateResult{
-			ProgressResult: &resource.ProgressResult{
-				Operation:       resource.OperationCreate,
-				OperationStatus: resource.OperationStatusFailure,
-				ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
-				StatusMessage:   fmt.Sprintf("failed to create network: %v", err),
-			},
-		}, nil
+	// A retried Create adopts the network an earlier attempt made: a tagged
+	// one, or the untagged orphan of an attempt whose response was lost
+	tags := resources.ParseTags(props["tags"])
+	withTags := len(tags) > 0 && tagsSupported(ctx, n.Client, netClient)
+	attemptKey := prov.CreateAttemptKey(request, region)
+	var net networkWithMTU
+	adopted := false
+	if withTags {
+		if existing := findNetworkToAdopt(ctx, netClient, props, tags); existing != nil {
+			net = *existing
+			adopted = true
+		}
+	}
+	orphan := false
+	if !adopted {
+		if existing := findOrphanedNetwork(ctx, netClient, props, attemptKey); existing != nil {
+			net = *existing
+			orphan = true
+		}
 	}
 
-	// Set tags if provided (must be done after creation via attributestags API)
-	tags := resources.ParseTags(props["tags"])
-	if len(tags) > 0 {
-		_, err = attributestags.ReplaceAll(ctx, n.Client.NetworkClient, "networks", net.ID, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
+	// Create the network via OpenStack using ExtractInto to get the extension fields
+	if !adopted && !orphan {
+		err = networks.Create(ctx, netClient, finalCreateOpts).ExtractInto(&net)
+		if err != nil && mayHaveCreated(err) {
+			prov.LostCreates.Record(attemptKey)
+			if existing := findOrphanedNetwork(ctx, netClient, props, attemptKey); existing != nil {
+				net, err = *existing, nil
+			}
+		}
 		if err != nil {
-			// Log warning but don't fail - network was created successfully
-			// Tags can be set on subsequent update
-			fmt.Printf("warning: failed to set tags on network %s: %v\n", net.ID, err)
-		} else {
-			net.Tags = tags
+			return &resource.CreateResult{
+				ProgressResult: &resource.ProgressResult{
+					Operation:       resource.OperationCreate,
+					OperationStatus: resource.OperationStatusFailure,
+					ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
+					StatusMessage:   networkErrorMessage("create", props, err),
+				},
+			}, nil
 		}
 	}
+	prov.LostCreates.Clear(attemptKey)
 
-	// Build networkWithMTU from result, including requested MTU value
-	netWithMTU := &networkWithMTU{Network: *net}
+	// Set tags if provided (must be done after creation via attributestags API)
+	if withTags && !adopted {
+		if set, ok := replaceTags(ctx, netClient, "networks", "network", net.ID, tags); ok {
+			net.Tags = set
+		}
+	}
+
+	// Report the requested MTU value
 	if mtuVal, ok := props["mtu"].(float64); ok && mtuVal > 0 {
-		netWithMTU.MTU = int(mtuVal)
+		net.MTU = int(mtuVal)
 	}
 
 	// Convert network to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(networkToProperties(netWithMTU))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(networkToProperties(&net), region))
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        net.ID,
+				NativeID:        resources.RegionalNativeID(region, net.ID),
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -176,7 +232,7 @@ func (n *Network) Create(ctx context.Context, request *resource.CreateRequest) (
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           net.ID,
+			NativeID:           resources.RegionalNativeID(region, net.ID),
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -185,33 +241,43 @@ func (n *Network) Create(ctx context.Context, request *resource.CreateRequest) (
 // Read retrieves the current state of a network
 func (n *Network) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
 	// Get the network ID from NativeID
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
 	if id == "" {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeInvalidRequest,
 		}, nil // Don't return Go error for expected errors
 	}
 
+	netClient, err := n.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
 	// Get the network from OpenStack using ExtractInto to get MTU extension field
 	var net networkWithMTU
-	err := networks.Get(ctx, n.Client.NetworkClient, id).ExtractInto(&net)
+	err = networks.Get(ctx, netClient, id).ExtractInto(&net)
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
 		}, nil // Don't return Go error for expected errors like NotFound
 	}
 
-	// Explicitly fetch tags - OpenStack often doesn't include them in the standard GET response
-	tags, err := attributestags.List(ctx, n.Client.NetworkClient, "networks", id).Extract()
-	if err != nil {
-		// Log warning but continue - tags are optional
-		fmt.Printf("warning: failed to fetch tags for network %s: %v\n", id, err)
-	} else {
-		net.Tags = tags
+	if tagsSupported(ctx, n.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "networks", "network", id); ok {
+			net.Tags = tags
+		}
+	}
+
+	// Report the DHCP agents serving the network when the credential may see them
+	props := networkToProperties(&net)
+	if agents, ok := agentBindings(ctx, n.Client, netClient, dhcpAgentSchedulerExtension, "networks", id, "dhcp-agents"); ok {
+		props["dhcp_agents"] = agents
 	}
 
 	// Convert network to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(networkToProperties(&net))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(props, region))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
@@ -232,7 +298,14 @@ func (n *Network) Update(ctx context.Context, request *resource.UpdateRequest) (
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := n.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeNetwork, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Parse request properties
 	props, err := resources.ParseProperties(request.DesiredProperties)
@@ -258,45 +331,58 @@ func (n *Network) Update(ctx context.Context, request *resource.UpdateRequest) (
 		updateOpts.AdminStateUp = &adminStateUp
 	}
 
-	// Update the network via OpenStack using ExtractInto to get MTU extension field
+	var priorProps map[string]interface{}
+	if len(request.PriorProperties) > 0 {
+		priorProps, _ = resources.ParseProperties(request.PriorProperties)
+	}
+
+	// shared and external are admin-only even when unchanged, so they are
+	// only sent when they change
+	if shared, ok := props["shared"].(bool); ok && shared != priorProps["shared"] {
+		updateOpts.Shared = &shared
+	}
+
+	var finalUpdateOpts networks.UpdateOptsBuilder = updateOpts
+	if isExternal, ok := props["external"].(bool); ok && isExternal != priorProps["external"] {
+		finalUpdateOpts = external.UpdateOptsExt{
+			UpdateOptsBuilder: updateOpts,
+			External:          &isExternal,
+		}
+	}
+
+	// Update the network via OpenStack using ExtractInto to get the extension fields
 	var net networkWithMTU
-	err = networks.Update(ctx, n.Client.NetworkClient, id, updateOpts).ExtractInto(&net)
+	err = networks.Update(ctx, netClient, id, finalUpdateOpts).ExtractInto(&net)
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationUpdate,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
-				StatusMessage:   fmt.Sprintf("failed to update network: %v", err),
+				StatusMessage:   networkErrorMessage("update", props, err),
 			},
 		}, nil
 	}
 
-	// Update tags if provided (via attributestags API)
-	if _, hasTags := props["tags"]; hasTags {
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, n.Client, netClient) {
 		tags := resources.ParseTags(props["tags"])
 		if tags == nil {
-			tags = []string{} // Empty slice to clear all tags
+			tags = []string{} // Empty slice clears tags removed from the desired state
 		}
-		updatedTags, err := attributestags.ReplaceAll(ctx, n.Client.NetworkClient, "networks", id, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - network was updated successfully
-			fmt.Printf("warning: failed to update tags on network %s: %v\n", id, err)
-		} else {
-			net.Tags = updatedTags
+		if set, ok := replaceTags(ctx, netClient, "networks", "network", id, tags); ok {
+			net.Tags = set
 		}
 	}
 
 	// Convert network to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(networkToProperties(&net))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(networkToProperties(&net), region))
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationUpdate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        net.ID,
+				NativeID:        request.NativeID,
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -308,7 +394,7 @@ func (n *Network) Update(ctx context.Context, request *resource.UpdateRequest) (
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           net.ID,
+			NativeID:           request.NativeID,
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -323,10 +409,17 @@ func (n *Network) Delete(ctx context.Context, request *resource.DeleteRequest) (
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := n.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeNetwork, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Delete the network from OpenStack
-	err := networks.Delete(ctx, n.Client.NetworkClient, id).ExtractErr()
+	err = networks.Delete(ctx, netClient, id).ExtractErr()
 	if err != nil {
 		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
 		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
@@ -336,7 +429,7 @@ func (n *Network) Delete(ctx context.Context, request *resource.DeleteRequest) (
 				ProgressResult: &resource.ProgressResult{
 					Operation:       resource.OperationDelete,
 					OperationStatus: resource.OperationStatusSuccess,
-					NativeID:        id,
+					NativeID:        request.NativeID,
 				},
 			}, nil
 		}
@@ -347,7 +440,7 @@ func (n *Network) Delete(ctx context.Context, request *resource.DeleteRequest) (
 				Operation:       resource.OperationDelete,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       errCode,
-				StatusMessage:   fmt.Sprintf("failed to delete network: %v", err),
+				StatusMessage:   fmt.Sprintf("failed to delete network: %s", resources.OpenStackErrorMessage(err)),
 			},
 		}, nil
 	}
@@ -357,7 +450,7 @@ func (n *Network) Delete(ctx context.Context, request *resource.DeleteRequest) (
 		ProgressResult: &resource.ProgressResult{
 			Operation:       resource.OperationDelete,
 			OperationStatus: resource.OperationStatusSuccess,
-			NativeID:        id,
+			NativeID:        request.NativeID,
 		},
 	}, nil
 }
@@ -370,7 +463,7 @@ func (n *Network) Status(ctx context.Context, request *resource.StatusRequest) (
 // List discovers networks
 func (n *Network) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
 	// List all networks using pagination
-	allPages, err := networks.List(n.Client.NetworkClient, networks.ListOpts{}).AllPages(ctx)
+	allPages, err := networks.List(n.Client.NetworkClient, networks.ListOpts{Tags: n.Config.ManagedByTag}).AllPages(ctx)
 	if err != nil {
 		return &resource.ListResult{}, fmt.Errorf("failed to list networks: %w", err)
 	}
@@ -392,3 +485,9 @@ func (n *Network) List(ctx context.Context, request *resource.ListRequest) (*res
 		NativeIDs: nativeIDs,
 	}, nil
 }
+
+// ResolveName returns the IDs of the networks called name
+func (n *Network) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	return idsByName(ctx, networks.List(n.Client.NetworkClient, networks.ListOpts{Name: name}), "networks", networks.ExtractNetworks,
+		func(net networks.Network) string { return net.ID })
+}
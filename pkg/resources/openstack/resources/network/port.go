@@ -7,9 +7,12 @@ package network
 import (
 	"context"
 	"fmt"
+	"strconv"
 
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/pagination"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
@@ -21,6 +24,14 @@ const (
 	ResourceTypePort = "OVH::Network::Port"
 )
 
+// portWithDNS embeds ports.Port, dns.PortDNSExt and policies.QoSPolicyExt to
+// extract the dns_name, dns_assignment and qos_policy_id extension fields.
+type portWithDNS struct {
+	ports.Port
+	dns.PortDNSExt
+	policies.QoSPolicyExt
+}
+
 // Port provisioner
 type Port struct {
 	Client *openstack.Client
@@ -29,7 +40,7 @@ type Port struct {
 
 // portToProperties converts an OpenStack port to a properties map.
 // This is used by Create, Read, Update, and List to ensure consistent property marshaling.
-func portToProperties(port *ports.Port) map[string]interface{} {
+func portToProperties(port *portWithDNS) map[string]interface{} {
 	props := map[string]interface{}{
 		"id":             port.ID,
 		"network_id":     port.NetworkID,
@@ -74,6 +85,27 @@ func portToProperties(port *ports.Port) map[string]interface{} {
 		props["allowed_address_pairs"] = pairs
 	}
 
+	// Add internal DNS name and the hostnames Neutron assigned from it
+	if port.DNSName != "" {
+		props["dns_name"] = port.DNSName
+	}
+	if len(port.DNSAssignment) > 0 {
+		assignments := make([]map[string]interface{}, 0, len(port.DNSAssignment))
+		for _, a := range port.DNSAssignment {
+			assignments = append(assignments, map[string]interface{}{
+				"hostname":   a["hostname"],
+				"ip_address": a["ip_address"],
+				"fqdn":       a["fqdn"],
+			})
+		}
+		props["dns_assignment"] = assignments
+	}
+
+	// Add the QoS policy applied to the port
+	if port.QoSPolicyID != "" {
+		props["qos_policy_id"] = port.QoSPolicyID
+	}
+
 	// Add tags if present
 	if len(port.Tags) > 0 {
 		props["tags"] = port.Tags
@@ -92,6 +124,7 @@ func init() {
 			resource.OperationUpdate,
 			resource.OperationDelete,
 			resource.OperationList,
+			resource.OperationCheckStatus,
 		},
 		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
 			return &Port{
@@ -112,6 +145,15 @@ func (p *Port) Create(ctx context.Context, request *resource.CreateRequest) (*re
 		}, nil
 	}
 
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := p.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypePort, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
 	// Build create options - NetworkID is required
 	networkID, ok := props["network_id"].(string)
 	if !ok || networkID == "" {
@@ -136,20 +178,7 @@ func (p *Port) Create(ctx context.Context, request *resource.CreateRequest) (*re
 
 	// Add optional fixed_ips (for subnet association)
 	if fixedIPsRaw, ok := props["fixed_ips"].([]interface{}); ok && len(fixedIPsRaw) > 0 {
-		fixedIPs := make([]ports.IP, 0, len(fixedIPsRaw))
-		for _, fipRaw := range fixedIPsRaw {
-			if fipMap, ok := fipRaw.(map[string]interface{}); ok {
-				ip := ports.IP{}
-				if subnetID, ok := fipMap["subnet_id"].(string); ok {
-					ip.SubnetID = subnetID
-				}
-				if ipAddr, ok := fipMap["ip_address"].(string); ok {
-					ip.IPAddress = ipAddr
-				}
-				fixedIPs = append(fixedIPs, ip)
-			}
-		}
-		createOpts.FixedIPs = fixedIPs
+		createOpts.FixedIPs = portFixedIPs(fixedIPsRaw, nil)
 	}
 
 	// Add optional security groups
@@ -191,8 +220,26 @@ func (p *Port) Create(ctx context.Context, request *resource.CreateRequest) (*re
 		createOpts.AllowedAddressPairs = pairs
 	}
 
-	// Create the port via OpenStack
-	port, err := ports.Create(ctx, p.Client.NetworkClient, createOpts).Extract()
+	// Wrap with DNS extension if dns_name is specified
+	var finalCreateOpts ports.CreateOptsBuilder = createOpts
+	if dnsName, ok := props["dns_name"].(string); ok && dnsName != "" {
+		finalCreateOpts = dns.PortCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			DNSName:           dnsName,
+		}
+	}
+
+	// Wrap with QoS extension if qos_policy_id is specified
+	if qosPolicyID, ok := props["qos_policy_id"].(string); ok && qosPolicyID != "" {
+		finalCreateOpts = policies.PortCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			QoSPolicyID:       qosPolicyID,
+		}
+	}
+
+	// Create the port via OpenStack using ExtractInto to get DNS extension fields
+	var port portWithDNS
+	err = ports.Create(ctx, netClient, finalCreateOpts).ExtractInto(&port)
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -206,26 +253,20 @@ func (p *Port) Create(ctx context.Context, request *resource.CreateRequest) (*re
 
 	// Set tags if provided (must be done after creation via attributestags API)
 	tags := resources.ParseTags(props["tags"])
-	if len(tags) > 0 {
-		_, err = attributestags.ReplaceAll(ctx, p.Client.NetworkClient, "ports", port.ID, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - port was created successfully
-			fmt.Printf("warning: failed to set tags on port %s: %v\n", port.ID, err)
-		} else {
-			port.Tags = tags
+	if len(tags) > 0 && tagsSupported(ctx, p.Client, netClient) {
+		if set, ok := replaceTags(ctx, netClient, "ports", "port", port.ID, tags); ok {
+			port.Tags = set
 		}
 	}
 
 	// Convert port to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(portToProperties(port))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(portToProperties(&port), region))
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        port.ID,
+				NativeID:        resources.RegionalNativeID(region, port.ID),
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -237,7 +278,7 @@ func (p *Port) Create(ctx context.Context, request *resource.CreateRequest) (*re
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           port.ID,
+			NativeID:           resources.RegionalNativeID(region, port.ID),
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -246,32 +287,37 @@ func (p *Port) Create(ctx context.Context, request *resource.CreateRequest) (*re
 // Read retrieves the current state of a port
 func (p *Port) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
 	// Get the port ID from NativeID
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
 	if id == "" {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeInvalidRequest,
 		}, nil // Don't return Go error for expected errors
 	}
 
-	// Get the port from OpenStack
-	port, err := ports.Get(ctx, p.Client.NetworkClient, id).Extract()
+	netClient, err := p.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	// Get the port from OpenStack using ExtractInto to get DNS extension fields
+	var port portWithDNS
+	err = ports.Get(ctx, netClient, id).ExtractInto(&port)
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
 		}, nil // Don't return Go error for expected errors like NotFound
 	}
 
-	// Explicitly fetch tags - OpenStack often doesn't include them in the standard GET response
-	tags, err := attributestags.List(ctx, p.Client.NetworkClient, "ports", id).Extract()
-	if err != nil {
-		// Log warning but continue - tags are optional
-		fmt.Printf("warning: failed to fetch tags for port %s: %v\n", id, err)
-	} else {
-		port.Tags = tags
+	if tagsSupported(ctx, p.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "ports", "port", id); ok {
+			port.Tags = tags
+		}
 	}
 
 	// Convert port to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(portToProperties(port))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(portToProperties(&port), region))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
@@ -292,7 +338,14 @@ func (p *Port) Update(ctx context.Context, request *resource.UpdateRequest) (*re
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := p.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypePort, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Parse request properties
 	props, err := resources.ParseProperties(request.DesiredProperties)
@@ -327,6 +380,19 @@ func (p *Port) Update(ctx context.Context, request *resource.UpdateRequest) (*re
 		updateOpts.SecurityGroups = &securityGroups
 	}
 
+	// Re-IP the port or add and remove fixed IPs. Neutron releases addresses
+	// left out of the list, so entries without ip_address keep the port's
+	// current address on that subnet instead of being given a new one.
+	if fixedIPsRaw, ok := props["fixed_ips"].([]interface{}); ok {
+		var current []interface{}
+		if len(request.PriorProperties) > 0 {
+			if priorProps, err := resources.ParseProperties(request.PriorProperties); err == nil {
+				current, _ = priorProps["fixed_ips"].([]interface{})
+			}
+		}
+		updateOpts.FixedIPs = portFixedIPs(fixedIPsRaw, current)
+	}
+
 	// Update allowed_address_pairs if provided
 	if pairsRaw, ok := props["allowed_address_pairs"].([]interface{}); ok {
 		pairs := make([]ports.AddressPair, 0, len(pairsRaw))
@@ -345,8 +411,25 @@ func (p *Port) Update(ctx context.Context, request *resource.UpdateRequest) (*re
 		updateOpts.AllowedAddressPairs = &pairs
 	}
 
+	// Wrap with DNS extension if dns_name is present; an empty name clears it
+	var finalUpdateOpts ports.UpdateOptsBuilder = updateOpts
+	if dnsName, ok := props["dns_name"].(string); ok {
+		finalUpdateOpts = dns.PortUpdateOptsExt{
+			UpdateOptsBuilder: updateOpts,
+			DNSName:           &dnsName,
+		}
+	}
+
+	// Wrap with QoS extension; an omitted qos_policy_id detaches the policy
+	qosPolicyID, _ := props["qos_policy_id"].(string)
+	finalUpdateOpts = policies.PortUpdateOptsExt{
+		UpdateOptsBuilder: finalUpdateOpts,
+		QoSPolicyID:       &qosPolicyID,
+	}
+
 	// Update the port via OpenStack
-	port, err := ports.Update(ctx, p.Client.NetworkClient, id, updateOpts).Extract()
+	var port portWithDNS
+	err = ports.Update(ctx, netClient, id, finalUpdateOpts).ExtractInto(&port)
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -358,31 +441,25 @@ func (p *Port) Update(ctx context.Context, request *resource.UpdateRequest) (*re
 		}, nil
 	}
 
-	// Update tags if provided (via attributestags API)
-	if _, hasTags := props["tags"]; hasTags {
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, p.Client, netClient) {
 		tags := resources.ParseTags(props["tags"])
 		if tags == nil {
-			tags = []string{} // Empty slice to clear all tags
+			tags = []string{} // Empty slice clears tags removed from the desired state
 		}
-		updatedTags, err := attributestags.ReplaceAll(ctx, p.Client.NetworkClient, "ports", id, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - port was updated successfully
-			fmt.Printf("warning: failed to update tags on port %s: %v\n", id, err)
-		} else {
-			port.Tags = updatedTags
+		if set, ok := replaceTags(ctx, netClient, "ports", "port", id, tags); ok {
+			port.Tags = set
 		}
 	}
 
 	// Convert port to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(portToProperties(port))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(portToProperties(&port), region))
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationUpdate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        port.ID,
+				NativeID:        request.NativeID,
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -394,12 +471,51 @@ func (p *Port) Update(ctx context.Context, request *resource.UpdateRequest) (*re
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           port.ID,
+			NativeID:           request.NativeID,
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
 }
 
+// portFixedIPs converts the fixed_ips property to Neutron fixed IPs. An
+// entry without ip_address takes an unclaimed address on its subnet from
+// current, the port's fixed IPs as last read, so that updating the list does
+// not reallocate addresses Neutron picked; with none left Neutron allocates one.
+func portFixedIPs(desired, current []interface{}) []ports.IP {
+	fixedIPs := make([]ports.IP, 0, len(desired))
+	claimed := make(map[string]bool)
+	for _, fipRaw := range desired {
+		if fipMap, ok := fipRaw.(map[string]interface{}); ok {
+			ip := ports.IP{}
+			if subnetID, ok := fipMap["subnet_id"].(string); ok {
+				ip.SubnetID = subnetID
+			}
+			if ipAddr, ok := fipMap["ip_address"].(string); ok {
+				ip.IPAddress = ipAddr
+				claimed[ipAddr] = true
+			}
+			fixedIPs = append(fixedIPs, ip)
+		}
+	}
+
+	for i := range fixedIPs {
+		if fixedIPs[i].IPAddress != "" {
+			continue
+		}
+		for _, fipRaw := range current {
+			fipMap, _ := fipRaw.(map[string]interface{})
+			subnetID, _ := fipMap["subnet_id"].(string)
+			ipAddr, _ := fipMap["ip_address"].(string)
+			if subnetID == fixedIPs[i].SubnetID && ipAddr != "" && !claimed[ipAddr] {
+				fixedIPs[i].IPAddress = ipAddr
+				claimed[ipAddr] = true
+				break
+			}
+		}
+	}
+	return fixedIPs
+}
+
 // Delete removes a port
 func (p *Port) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
 	// Get the port ID from NativeID
@@ -409,10 +525,17 @@ func (p *Port) Delete(ctx context.Context, request *resource.DeleteRequest) (*re
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := p.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypePort, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Delete the port from OpenStack
-	err := ports.Delete(ctx, p.Client.NetworkClient, id).ExtractErr()
+	err = ports.Delete(ctx, netClient, id).ExtractErr()
 	if err != nil {
 		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
 		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
@@ -421,7 +544,7 @@ func (p *Port) Delete(ctx context.Context, request *resource.DeleteRequest) (*re
 				ProgressResult: &resource.ProgressResult{
 					Operation:       resource.OperationDelete,
 					OperationStatus: resource.OperationStatusSuccess,
-					NativeID:        id,
+					NativeID:        request.NativeID,
 				},
 			}, nil
 		}
@@ -432,52 +555,110 @@ func (p *Port) Delete(ctx context.Context, request *resource.DeleteRequest) (*re
 				Operation:       resource.OperationDelete,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       errCode,
-				StatusMessage:   fmt.Sprintf("failed to delete port: %v", err),
+				StatusMessage:   fmt.Sprintf("failed to delete port: %s", resources.OpenStackErrorMessage(err)),
 			},
 		}, nil
 	}
 
-	// Return success
+	// The port may linger while still referenced; wait until it is gone
+	_, err = ports.Get(ctx, netClient, id).Extract()
 	return &resource.DeleteResult{
-		ProgressResult: &resource.ProgressResult{
-			Operation:       resource.OperationDelete,
-			OperationStatus: resource.OperationStatusSuccess,
-			NativeID:        id,
-		},
+		ProgressResult: resources.DeletionProgress(resource.OperationDelete, ResourceTypePort, request.NativeID, err),
 	}, nil
 }
 
-// Status checks the status of a long-running operation (ports are synchronous, so not used)
+// Status waits for a deleted port to disappear; create and update are synchronous
 func (p *Port) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
-	return nil, fmt.Errorf("not implemented")
-}
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypePort, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
 
-// List discovers ports
-func (p *Port) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
-	// List all ports using pagination
-	allPages, err := ports.List(p.Client.NetworkClient, ports.ListOpts{}).AllPages(ctx)
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := p.Client.NetworkClientFor(region)
 	if err != nil {
-		return &resource.ListResult{}, fmt.Errorf("failed to list ports: %w", err)
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypePort, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
 	}
 
-	// Extract ports from pages
-	portList, err := ports.ExtractPorts(allPages)
+	_, err = ports.Get(ctx, netClient, id).Extract()
+	result := resources.DeletionProgress(resource.OperationCheckStatus, ResourceTypePort, request.NativeID, err)
+	result.RequestID = request.RequestID
+	return &resource.StatusResult{
+		ProgressResult: result,
+	}, nil
+}
+
+// List discovers ports
+// List discovers ports. Additional properties narrow the listing:
+// network_id, device_owner and status filter on the server, limit sets the
+// page size, and standalone_only=false also returns ports attached to
+// instances or routers, which are skipped by default.
+func (p *Port) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	listOpts, standaloneOnly, err := portListOpts(request.AdditionalProperties, p.Config.ManagedByTag)
 	if err != nil {
-		return &resource.ListResult{}, fmt.Errorf("failed to extract ports: %w", err)
+		return &resource.ListResult{}, err
 	}
 
-	// Collect NativeIDs for discovery (skip ports attached to devices)
-	nativeIDs := make([]string, 0, len(portList))
-	for _, port := range portList {
-		// Skip ports that are attached to devices (like instances or routers)
-		// These are managed by their parent resources
-		if port.DeviceID != "" {
-			continue
+	var nativeIDs []string
+	err = ports.List(p.Client.NetworkClient, listOpts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		portList, err := ports.ExtractPorts(page)
+		if err != nil {
+			return false, fmt.Errorf("failed to extract ports: %w", err)
+		}
+		for _, port := range portList {
+			// Ports attached to devices are managed by their parent resources
+			if standaloneOnly && port.DeviceID != "" {
+				continue
+			}
+			nativeIDs = append(nativeIDs, port.ID)
 		}
-		nativeIDs = append(nativeIDs, port.ID)
+		return true, nil
+	})
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list ports: %w", err)
 	}
 
 	return &resource.ListResult{
 		NativeIDs: nativeIDs,
 	}, nil
 }
+
+// ResolveName returns the IDs of the ports called name
+func (p *Port) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	return idsByName(ctx, ports.List(p.Client.NetworkClient, ports.ListOpts{Name: name}), "ports", ports.ExtractPorts,
+		func(port ports.Port) string { return port.ID })
+}
+
+// portListOpts builds the port listing filters from a List request's
+// additional properties and reports whether device ports are skipped
+func portListOpts(additional map[string]string, managedByTag string) (ports.ListOpts, bool, error) {
+	listOpts := ports.ListOpts{
+		Tags:        managedByTag,
+		NetworkID:   additional["network_id"],
+		DeviceOwner: additional["device_owner"],
+		Status:      additional["status"],
+	}
+
+	if limit := additional["limit"]; limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return ports.ListOpts{}, false, fmt.Errorf("invalid limit %q: must be a positive integer", limit)
+		}
+		listOpts.Limit = n
+	}
+
+	standaloneOnly := true
+	if value := additional["standalone_only"]; value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return ports.ListOpts{}, false, fmt.Errorf("invalid standalone_only %q: must be true or false", value)
+		}
+		standaloneOnly = parsed
+	}
+
+	return listOpts, standaloneOnly, nil
+}
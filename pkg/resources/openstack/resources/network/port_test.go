@@ -0,0 +1,142 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortListOpts(t *testing.T) {
+	listOpts, standaloneOnly, err := portListOpts(nil, "managed")
+	require.NoError(t, err)
+	assert.True(t, standaloneOnly)
+	assert.Equal(t, "managed", listOpts.Tags)
+	assert.Zero(t, listOpts.Limit)
+
+	listOpts, standaloneOnly, err = portListOpts(map[string]string{
+		"network_id":      "net-1",
+		"device_owner":    "network:router_interface",
+		"status":          "ACTIVE",
+		"limit":           "200",
+		"standalone_only": "false",
+	}, "")
+	require.NoError(t, err)
+	assert.False(t, standaloneOnly)
+	assert.Equal(t, "net-1", listOpts.NetworkID)
+	assert.Equal(t, "network:router_interface", listOpts.DeviceOwner)
+	assert.Equal(t, "ACTIVE", listOpts.Status)
+	assert.Equal(t, 200, listOpts.Limit)
+
+	_, _, err = portListOpts(map[string]string{"limit": "0"}, "")
+	assert.Error(t, err)
+	_, _, err = portListOpts(map[string]string{"standalone_only": "maybe"}, "")
+	assert.Error(t, err)
+}
+
+func TestPortFixedIPs(t *testing.T) {
+	fip := func(subnetID, ip string) map[string]interface{} {
+		m := map[string]interface{}{"subnet_id": subnetID}
+		if ip != "" {
+			m["ip_address"] = ip
+		}
+		return m
+	}
+	current := []interface{}{fip("sub-a", "10.0.0.5"), fip("sub-a", "10.0.0.6"), fip("sub-b", "10.1.0.5")}
+
+	tests := []struct {
+		name    string
+		desired []interface{}
+		want    []ports.IP
+	}{
+		{
+			name:    "keeps addresses Neutron picked",
+			desired: []interface{}{fip("sub-a", ""), fip("sub-b", "")},
+			want:    []ports.IP{{SubnetID: "sub-a", IPAddress: "10.0.0.5"}, {SubnetID: "sub-b", IPAddress: "10.1.0.5"}},
+		},
+		{
+			name:    "re-IP within a subnet",
+			desired: []interface{}{fip("sub-a", "10.0.0.9")},
+			want:    []ports.IP{{SubnetID: "sub-a", IPAddress: "10.0.0.9"}},
+		},
+		{
+			name:    "explicit address is not handed out twice",
+			desired: []interface{}{fip("sub-a", ""), fip("sub-a", "10.0.0.5")},
+			want:    []ports.IP{{SubnetID: "sub-a", IPAddress: "10.0.0.6"}, {SubnetID: "sub-a", IPAddress: "10.0.0.5"}},
+		},
+		{
+			name:    "added fixed IP is allocated",
+			desired: []interface{}{fip("sub-b", "10.1.0.5"), fip("sub-b", "")},
+			want:    []ports.IP{{SubnetID: "sub-b", IPAddress: "10.1.0.5"}, {SubnetID: "sub-b"}},
+		},
+		{
+			name:    "removing every fixed IP",
+			desired: []interface{}{},
+			want:    []ports.IP{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, portFixedIPs(tt.desired, current))
+		})
+	}
+}
+
+func TestPort_DeleteWaitsUntilGone(t *testing.T) {
+	// The port outlives its DELETE for one more GET
+	lingering := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/ports/port-1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/ports/port-1" && lingering > 0:
+			lingering--
+			json.NewEncoder(w).Encode(map[string]interface{}{"port": map[string]interface{}{"id": "port-1"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	p := &Port{
+		Client: &openstack.Client{NetworkClient: &gophercloud.ServiceClient{
+			ProviderClient: &gophercloud.ProviderClient{},
+			Endpoint:       server.URL + "/",
+		}},
+		Config: &openstack.Config{},
+	}
+
+	assert.Contains(t, registry.GetOperations(ResourceTypePort), resource.OperationCheckStatus)
+	assert.Contains(t, registry.GetOperations(ResourceTypeSubnet), resource.OperationCheckStatus)
+
+	deleted, err := p.Delete(context.Background(), &resource.DeleteRequest{NativeID: "port-1"})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, deleted.ProgressResult.OperationStatus)
+
+	request := &resource.StatusRequest{RequestID: "req-1", NativeID: "port-1"}
+	status, err := p.Status(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusInProgress, status.ProgressResult.OperationStatus)
+
+	status, err = p.Status(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, status.ProgressResult.OperationStatus)
+	assert.Equal(t, "req-1", status.ProgressResult.RequestID)
+
+	status, err = p.Status(context.Background(), &resource.StatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationErrorCodeInvalidRequest, status.ProgressResult.ErrorCode)
+}
@@ -0,0 +1,325 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeFloatingIPPortForwarding = "OVH::Network::FloatingIPPortForwarding"
+)
+
+// FloatingIPPortForwarding provisioner. Port forwardings are nested under a
+// floating IP, so the native ID is "floatingipId/portForwardingId", prefixed
+// with the region when it differs from the default.
+type FloatingIPPortForwarding struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// portForwardingNativeID builds the native ID of a port forwarding
+func portForwardingNativeID(region, floatingIPID, id string) string {
+	return resources.RegionalNativeID(region, floatingIPID+"/"+id)
+}
+
+// parsePortForwardingNativeID splits a native ID built by portForwardingNativeID
+func parsePortForwardingNativeID(nativeID string) (region, floatingIPID, id string, err error) {
+	parts := strings.Split(nativeID, "/")
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return "", parts[0], parts[1], nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		return parts[0], parts[1], parts[2], nil
+	}
+	return "", "", "", fmt.Errorf("invalid port forwarding native ID %q: expected [region/]floatingipId/portForwardingId", nativeID)
+}
+
+// portForwardingToProperties converts an OpenStack port forwarding to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+func portForwardingToProperties(floatingIPID string, pf *portforwarding.PortForwarding) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":                  pf.ID,
+		"floatingip_id":       floatingIPID,
+		"protocol":            pf.Protocol,
+		"internal_port":       pf.InternalPort,
+		"external_port":       pf.ExternalPort,
+		"internal_ip_address": pf.InternalIPAddress,
+		"internal_port_id":    pf.InternalPortID,
+	}
+	if pf.Description != "" {
+		props["description"] = pf.Description
+	}
+	return props
+}
+
+// Register the FloatingIPPortForwarding resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeFloatingIPPortForwarding,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &FloatingIPPortForwarding{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create adds a port forwarding rule to a floating IP
+func (f *FloatingIPPortForwarding) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := f.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	floatingIPID, _ := props["floatingip_id"].(string)
+	if floatingIPID == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, "", "floatingip_id is required"),
+		}, nil
+	}
+
+	createOpts := portforwarding.CreateOpts{}
+	createOpts.Protocol, _ = props["protocol"].(string)
+	createOpts.InternalIPAddress, _ = props["internal_ip_address"].(string)
+	createOpts.InternalPortID, _ = props["internal_port_id"].(string)
+	createOpts.Description, _ = props["description"].(string)
+	if v, ok := props["internal_port"].(float64); ok {
+		createOpts.InternalPort = int(v)
+	}
+	if v, ok := props["external_port"].(float64); ok {
+		createOpts.ExternalPort = int(v)
+	}
+
+	if createOpts.Protocol == "" || createOpts.InternalIPAddress == "" || createOpts.InternalPortID == "" ||
+		createOpts.InternalPort == 0 || createOpts.ExternalPort == 0 {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, "",
+				"protocol, internal_port, external_port, internal_ip_address and internal_port_id are required"),
+		}, nil
+	}
+
+	pf, err := portforwarding.Create(ctx, netClient, floatingIPID, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeFloatingIPPortForwarding, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create port forwarding: %v", err)),
+		}, nil
+	}
+
+	nativeID := portForwardingNativeID(region, floatingIPID, pf.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(portForwardingToProperties(floatingIPID, pf), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of a port forwarding
+func (f *FloatingIPPortForwarding) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, floatingIPID, id, err := parsePortForwardingNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := f.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	pf, err := portforwarding.Get(ctx, netClient, floatingIPID, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(portForwardingToProperties(floatingIPID, pf), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update changes the ports, target or description of a port forwarding
+func (f *FloatingIPPortForwarding) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	region, floatingIPID, id, err := parsePortForwardingNativeID(request.NativeID)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	netClient, err := f.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	updateOpts := portforwarding.UpdateOpts{}
+	updateOpts.Protocol, _ = props["protocol"].(string)
+	updateOpts.InternalIPAddress, _ = props["internal_ip_address"].(string)
+	updateOpts.InternalPortID, _ = props["internal_port_id"].(string)
+	if v, ok := props["internal_port"].(float64); ok {
+		updateOpts.InternalPort = int(v)
+	}
+	if v, ok := props["external_port"].(float64); ok {
+		updateOpts.ExternalPort = int(v)
+	}
+	// An omitted description clears it, matching what Read reports
+	description, _ := props["description"].(string)
+	updateOpts.Description = &description
+
+	pf, err := portforwarding.Update(ctx, netClient, floatingIPID, id, updateOpts).Extract()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeFloatingIPPortForwarding, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update port forwarding: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(portForwardingToProperties(floatingIPID, pf), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes a port forwarding
+func (f *FloatingIPPortForwarding) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	region, floatingIPID, id, err := parsePortForwardingNativeID(request.NativeID)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	netClient, err := f.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeFloatingIPPortForwarding, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = portforwarding.Delete(ctx, netClient, floatingIPID, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeFloatingIPPortForwarding, errCode, request.NativeID, fmt.Sprintf("failed to delete port forwarding: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (port forwardings are synchronous, so not used)
+func (f *FloatingIPPortForwarding) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers port forwardings on every floating IP in the default region
+func (f *FloatingIPPortForwarding) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := floatingips.List(f.Client.NetworkClient, floatingips.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list floating IPs: %w", err)
+	}
+
+	fips, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract floating IPs: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, fip := range fips {
+		pfPages, err := portforwarding.List(f.Client.NetworkClient, portforwarding.ListOpts{}, fip.ID).AllPages(ctx)
+		if err != nil {
+			return &resource.ListResult{}, fmt.Errorf("failed to list port forwardings of %s: %w", fip.ID, err)
+		}
+		pfs, err := portforwarding.ExtractPortForwardings(pfPages)
+		if err != nil {
+			return &resource.ListResult{}, fmt.Errorf("failed to extract port forwardings of %s: %w", fip.ID, err)
+		}
+		for _, pf := range pfs {
+			nativeIDs = append(nativeIDs, portForwardingNativeID("", fip.ID, pf.ID))
+		}
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -0,0 +1,313 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeQoSBandwidthLimitRule = "OVH::Network::QoSBandwidthLimitRule"
+)
+
+// QoSBandwidthLimitRule provisioner. Rules are nested under a QoS policy, so
+// the native ID is "qosPolicyId/ruleId", prefixed with the region when it
+// differs from the default.
+type QoSBandwidthLimitRule struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// bandwidthLimitRuleNativeID builds the native ID of a bandwidth limit rule
+func bandwidthLimitRuleNativeID(region, policyID, id string) string {
+	return resources.RegionalNativeID(region, policyID+"/"+id)
+}
+
+// parseBandwidthLimitRuleNativeID splits a native ID built by bandwidthLimitRuleNativeID
+func parseBandwidthLimitRuleNativeID(nativeID string) (region, policyID, id string, err error) {
+	parts := strings.Split(nativeID, "/")
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return "", parts[0], parts[1], nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		return parts[0], parts[1], parts[2], nil
+	}
+	return "", "", "", fmt.Errorf("invalid bandwidth limit rule native ID %q: expected [region/]qosPolicyId/ruleId", nativeID)
+}
+
+// bandwidthLimitRuleToProperties converts an OpenStack bandwidth limit rule to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+func bandwidthLimitRuleToProperties(policyID string, rule *rules.BandwidthLimitRule) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             rule.ID,
+		"qos_policy_id":  policyID,
+		"max_kbps":       rule.MaxKBps,
+		"max_burst_kbps": rule.MaxBurstKBps,
+		"direction":      rule.Direction,
+	}
+}
+
+// Register the QoSBandwidthLimitRule resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeQoSBandwidthLimitRule,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &QoSBandwidthLimitRule{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create adds a bandwidth limit rule to a QoS policy
+func (q *QoSBandwidthLimitRule) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	policyID, _ := props["qos_policy_id"].(string)
+	if policyID == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, "", "qos_policy_id is required"),
+		}, nil
+	}
+
+	createOpts := rules.CreateBandwidthLimitRuleOpts{}
+	if v, ok := props["max_kbps"].(float64); ok {
+		createOpts.MaxKBps = int(v)
+	}
+	if v, ok := props["max_burst_kbps"].(float64); ok {
+		createOpts.MaxBurstKBps = int(v)
+	}
+	createOpts.Direction, _ = props["direction"].(string)
+
+	if createOpts.MaxKBps == 0 {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, "", "max_kbps is required"),
+		}, nil
+	}
+
+	rule, err := rules.CreateBandwidthLimitRule(ctx, netClient, policyID, createOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSBandwidthLimitRule, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create bandwidth limit rule: %v", err)),
+		}, nil
+	}
+
+	nativeID := bandwidthLimitRuleNativeID(region, policyID, rule.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(bandwidthLimitRuleToProperties(policyID, rule), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of a bandwidth limit rule
+func (q *QoSBandwidthLimitRule) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, policyID, id, err := parseBandwidthLimitRuleNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	rule, err := rules.GetBandwidthLimitRule(ctx, netClient, policyID, id).ExtractBandwidthLimitRule()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(bandwidthLimitRuleToProperties(policyID, rule), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update changes the rate, burst or direction of a bandwidth limit rule
+func (q *QoSBandwidthLimitRule) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	region, policyID, id, err := parseBandwidthLimitRuleNativeID(request.NativeID)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	updateOpts := rules.UpdateBandwidthLimitRuleOpts{}
+	if v, ok := props["max_kbps"].(float64); ok {
+		maxKBps := int(v)
+		updateOpts.MaxKBps = &maxKBps
+	}
+	// An omitted burst resets it to the Neutron default, matching what Read reports
+	maxBurstKBps := 0
+	if v, ok := props["max_burst_kbps"].(float64); ok {
+		maxBurstKBps = int(v)
+	}
+	updateOpts.MaxBurstKBps = &maxBurstKBps
+	updateOpts.Direction, _ = props["direction"].(string)
+
+	rule, err := rules.UpdateBandwidthLimitRule(ctx, netClient, policyID, id, updateOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSBandwidthLimitRule, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update bandwidth limit rule: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(bandwidthLimitRuleToProperties(policyID, rule), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes a bandwidth limit rule
+func (q *QoSBandwidthLimitRule) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	region, policyID, id, err := parseBandwidthLimitRuleNativeID(request.NativeID)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeQoSBandwidthLimitRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = rules.DeleteBandwidthLimitRule(ctx, netClient, policyID, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeQoSBandwidthLimitRule, errCode, request.NativeID, fmt.Sprintf("failed to delete bandwidth limit rule: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (bandwidth limit rules are synchronous, so not used)
+func (q *QoSBandwidthLimitRule) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers bandwidth limit rules on every managed QoS policy in the default region
+func (q *QoSBandwidthLimitRule) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := policies.List(q.Client.NetworkClient, policies.ListOpts{Tags: q.Config.ManagedByTag}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list QoS policies: %w", err)
+	}
+
+	policyList, err := policies.ExtractPolicies(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract QoS policies: %w", err)
+	}
+
+	var nativeIDs []string
+	for _, policy := range policyList {
+		rulePages, err := rules.ListBandwidthLimitRules(q.Client.NetworkClient, policy.ID, rules.BandwidthLimitRulesListOpts{}).AllPages(ctx)
+		if err != nil {
+			return &resource.ListResult{}, fmt.Errorf("failed to list bandwidth limit rules of %s: %w", policy.ID, err)
+		}
+		ruleList, err := rules.ExtractBandwidthLimitRules(rulePages)
+		if err != nil {
+			return &resource.ListResult{}, fmt.Errorf("failed to extract bandwidth limit rules of %s: %w", policy.ID, err)
+		}
+		for _, rule := range ruleList {
+			nativeIDs = append(nativeIDs, bandwidthLimitRuleNativeID("", policy.ID, rule.ID))
+		}
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -0,0 +1,303 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeQoSPolicy = "OVH::Network::QoSPolicy"
+)
+
+// QoSPolicy provisioner. A policy groups the QoS rules, such as bandwidth
+// limits, applied to the ports that reference it through qos_policy_id.
+type QoSPolicy struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// qosPolicyToProperties converts an OpenStack QoS policy to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+// Rules are managed as separate resources and are not reported here.
+func qosPolicyToProperties(policy *policies.Policy) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":          policy.ID,
+		"name":        policy.Name,
+		"description": policy.Description,
+		"shared":      policy.Shared,
+	}
+
+	if len(policy.Tags) > 0 {
+		props["tags"] = policy.Tags
+	}
+
+	return props
+}
+
+// Register the QoSPolicy resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeQoSPolicy,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &QoSPolicy{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates a QoS policy
+func (q *QoSPolicy) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	name, _ := props["name"].(string)
+	if name == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, "", "name is required"),
+		}, nil
+	}
+
+	createOpts := policies.CreateOpts{Name: name}
+	createOpts.Description, _ = props["description"].(string)
+	createOpts.Shared, _ = props["shared"].(bool)
+
+	policy, err := policies.Create(ctx, netClient, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSPolicy, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create QoS policy: %v", err)),
+		}, nil
+	}
+
+	// Set tags if provided (must be done after creation via attributestags API)
+	tags := resources.ParseTags(props["tags"])
+	if len(tags) > 0 && tagsSupported(ctx, q.Client, netClient) {
+		if set, ok := replaceTags(ctx, netClient, "policies", "QoS policy", policy.ID, tags); ok {
+			policy.Tags = set
+		}
+	}
+
+	nativeID := resources.RegionalNativeID(region, policy.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(qosPolicyToProperties(policy), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeQoSPolicy, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of a QoS policy
+func (q *QoSPolicy) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	policy, err := policies.Get(ctx, netClient, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	if tagsSupported(ctx, q.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "policies", "QoS policy", id); ok {
+			policy.Tags = tags
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(qosPolicyToProperties(policy), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update changes the name, description, sharing and tags of a QoS policy
+func (q *QoSPolicy) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	updateOpts := policies.UpdateOpts{}
+	if name, ok := props["name"].(string); ok {
+		updateOpts.Name = name
+	}
+	// An omitted description clears it, matching what Read reports
+	description, _ := props["description"].(string)
+	updateOpts.Description = &description
+	shared, _ := props["shared"].(bool)
+	updateOpts.Shared = &shared
+
+	policy, err := policies.Update(ctx, netClient, id, updateOpts).Extract()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSPolicy, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update QoS policy: %v", err)),
+		}, nil
+	}
+
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, q.Client, netClient) {
+		tags := resources.ParseTags(props["tags"])
+		if tags == nil {
+			tags = []string{}
+		}
+		if set, ok := replaceTags(ctx, netClient, "policies", "QoS policy", id, tags); ok {
+			policy.Tags = set
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(qosPolicyToProperties(policy), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeQoSPolicy, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes a QoS policy. Neutron refuses while a port or network still
+// uses it, and deletes the policy's rules along with it.
+func (q *QoSPolicy) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := q.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeQoSPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = policies.Delete(ctx, netClient, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeQoSPolicy, errCode, request.NativeID, fmt.Sprintf("failed to delete QoS policy: %v", err)),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (QoS policies are synchronous, so not used)
+func (q *QoSPolicy) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers QoS policies
+func (q *QoSPolicy) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := policies.List(q.Client.NetworkClient, policies.ListOpts{Tags: q.Config.ManagedByTag}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list QoS policies: %w", err)
+	}
+
+	policyList, err := policies.ExtractPolicies(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract QoS policies: %w", err)
+	}
+
+	nativeIDs := make([]string, 0, len(policyList))
+	for _, policy := range policyList {
+		nativeIDs = append(nativeIDs, policy.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
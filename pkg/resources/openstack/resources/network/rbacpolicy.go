@@ -0,0 +1,276 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeRBACPolicy = "OVH::Network::RBACPolicy"
+)
+
+// RBACPolicy provisioner. An RBAC policy grants one other project access to
+// a network or QoS policy, without sharing it with every project.
+type RBACPolicy struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// rbacPolicyToProperties converts an OpenStack RBAC policy to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+func rbacPolicyToProperties(policy *rbacpolicies.RBACPolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            policy.ID,
+		"object_type":   policy.ObjectType,
+		"object_id":     policy.ObjectID,
+		"action":        string(policy.Action),
+		"target_tenant": policy.TargetTenant,
+	}
+}
+
+// Register the RBACPolicy resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeRBACPolicy,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &RBACPolicy{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates an RBAC policy
+func (r *RBACPolicy) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	objectType, _ := props["object_type"].(string)
+	objectID, _ := props["object_id"].(string)
+	action, _ := props["action"].(string)
+	targetTenant, _ := props["target_tenant"].(string)
+	if objectType == "" || objectID == "" || action == "" || targetTenant == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, "", "object_type, object_id, action and target_tenant are required"),
+		}, nil
+	}
+
+	policy, err := rbacpolicies.Create(ctx, netClient, rbacpolicies.CreateOpts{
+		ObjectType:   objectType,
+		ObjectID:     objectID,
+		Action:       rbacpolicies.PolicyAction(action),
+		TargetTenant: targetTenant,
+	}).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeRBACPolicy, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create RBAC policy: %v", err)),
+		}, nil
+	}
+
+	nativeID := resources.RegionalNativeID(region, policy.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(rbacPolicyToProperties(policy), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeRBACPolicy, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of an RBAC policy
+func (r *RBACPolicy) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	policy, err := rbacpolicies.Get(ctx, netClient, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(rbacPolicyToProperties(policy), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update moves an RBAC policy to another target project. Neutron refuses
+// while the current target still uses the shared object, e.g. has ports on
+// a shared network.
+func (r *RBACPolicy) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	targetTenant, _ := props["target_tenant"].(string)
+	if targetTenant == "" {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, "target_tenant is required"),
+		}, nil
+	}
+
+	policy, err := rbacpolicies.Update(ctx, netClient, id, rbacpolicies.UpdateOpts{TargetTenant: targetTenant}).Extract()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRBACPolicy, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update RBAC policy: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(rbacPolicyToProperties(policy), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRBACPolicy, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete revokes an RBAC policy. Neutron refuses while the target project
+// still uses the shared object.
+func (r *RBACPolicy) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeRBACPolicy, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = rbacpolicies.Delete(ctx, netClient, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeRBACPolicy, errCode, request.NativeID, fmt.Sprintf("failed to delete RBAC policy: %v", err)),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (RBAC policies are synchronous, so not used)
+func (r *RBACPolicy) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers RBAC policies. They cannot be tagged, so the managed-by
+// tag does not filter them.
+func (r *RBACPolicy) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := rbacpolicies.List(r.Client.NetworkClient, rbacpolicies.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list RBAC policies: %w", err)
+	}
+
+	policyList, err := rbacpolicies.ExtractRBACPolicies(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract RBAC policies: %w", err)
+	}
+
+	nativeIDs := make([]string, 0, len(policyList))
+	for _, policy := range policyList {
+		nativeIDs = append(nativeIDs, policy.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
@@ -99,6 +98,15 @@ func (r *Router) Create(ctx context.Context, request *resource.CreateRequest) (*
 		}, nil
 	}
 
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeRouter, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
 	// Build create options
 	createOpts := routers.CreateOpts{}
 
@@ -133,7 +141,7 @@ func (r *Router) Create(ctx context.Context, request *resource.CreateRequest) (*
 	}
 
 	// Create the router via OpenStack
-	router, err := routers.Create(ctx, r.Client.NetworkClient, createOpts).Extract()
+	router, err := routers.Create(ctx, netClient, createOpts).Extract()
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -147,26 +155,20 @@ func (r *Router) Create(ctx context.Context, request *resource.CreateRequest) (*
 
 	// Set tags if provided (must be done after creation via attributestags API)
 	tags := resources.ParseTags(props["tags"])
-	if len(tags) > 0 {
-		_, err = attributestags.ReplaceAll(ctx, r.Client.NetworkClient, "routers", router.ID, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - router was created successfully
-			fmt.Printf("warning: failed to set tags on router %s: %v\n", router.ID, err)
-		} else {
-			router.Tags = tags
+	if len(tags) > 0 && tagsSupported(ctx, r.Client, netClient) {
+		if set, ok := replaceTags(ctx, netClient, "routers", "router", router.ID, tags); ok {
+			router.Tags = set
 		}
 	}
 
 	// Convert router to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(routerToProperties(router))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(routerToProperties(router), region))
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        router.ID,
+				NativeID:        resources.RegionalNativeID(region, router.ID),
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -178,7 +180,7 @@ func (r *Router) Create(ctx context.Context, request *resource.CreateRequest) (*
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           router.ID,
+			NativeID:           resources.RegionalNativeID(region, router.ID),
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -187,32 +189,42 @@ func (r *Router) Create(ctx context.Context, request *resource.CreateRequest) (*
 // Read retrieves the current state of a router
 func (r *Router) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
 	// Get the router ID from NativeID
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
 	if id == "" {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeInvalidRequest,
 		}, nil // Don't return Go error for expected errors
 	}
 
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
 	// Get the router from OpenStack
-	router, err := routers.Get(ctx, r.Client.NetworkClient, id).Extract()
+	router, err := routers.Get(ctx, netClient, id).Extract()
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
 		}, nil // Don't return Go error for expected errors like NotFound
 	}
 
-	// Explicitly fetch tags - OpenStack often doesn't include them in the standard GET response
-	tags, err := attributestags.List(ctx, r.Client.NetworkClient, "routers", id).Extract()
-	if err != nil {
-		// Log warning but continue - tags are optional
-		fmt.Printf("warning: failed to fetch tags for router %s: %v\n", id, err)
-	} else {
-		router.Tags = tags
+	if tagsSupported(ctx, r.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "routers", "router", id); ok {
+			router.Tags = tags
+		}
+	}
+
+	// Report the L3 agents hosting the router when the credential may see them
+	props := routerToProperties(router)
+	if agents, ok := agentBindings(ctx, r.Client, netClient, l3AgentSchedulerExtension, "routers", id, "l3-agents"); ok {
+		props["l3_agents"] = agents
 	}
 
 	// Convert router to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(routerToProperties(router))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(props, region))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
@@ -233,7 +245,14 @@ func (r *Router) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeRouter, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Parse request properties
 	props, err := resources.ParseProperties(request.DesiredProperties)
@@ -293,7 +312,7 @@ func (r *Router) Update(ctx context.Context, request *resource.UpdateRequest) (*
 	}
 
 	// Update the router via OpenStack
-	router, err := routers.Update(ctx, r.Client.NetworkClient, id, updateOpts).Extract()
+	router, err := routers.Update(ctx, netClient, id, updateOpts).Extract()
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -305,31 +324,25 @@ func (r *Router) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		}, nil
 	}
 
-	// Update tags if provided (via attributestags API)
-	if _, hasTags := props["tags"]; hasTags {
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, r.Client, netClient) {
 		tags := resources.ParseTags(props["tags"])
 		if tags == nil {
-			tags = []string{} // Empty slice to clear all tags
+			tags = []string{} // Empty slice clears tags removed from the desired state
 		}
-		updatedTags, err := attributestags.ReplaceAll(ctx, r.Client.NetworkClient, "routers", id, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - router was updated successfully
-			fmt.Printf("warning: failed to update tags on router %s: %v\n", id, err)
-		} else {
-			router.Tags = updatedTags
+		if set, ok := replaceTags(ctx, netClient, "routers", "router", id, tags); ok {
+			router.Tags = set
 		}
 	}
 
 	// Convert router to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(routerToProperties(router))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(routerToProperties(router), region))
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationUpdate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        router.ID,
+				NativeID:        request.NativeID,
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -341,7 +354,7 @@ func (r *Router) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           router.ID,
+			NativeID:           request.NativeID,
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -356,10 +369,17 @@ func (r *Router) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := r.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeRouter, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Delete the router from OpenStack
-	err := routers.Delete(ctx, r.Client.NetworkClient, id).ExtractErr()
+	err = routers.Delete(ctx, netClient, id).ExtractErr()
 	if err != nil {
 		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
 		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
@@ -369,7 +389,7 @@ func (r *Router) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 				ProgressResult: &resource.ProgressResult{
 					Operation:       resource.OperationDelete,
 					OperationStatus: resource.OperationStatusSuccess,
-					NativeID:        id,
+					NativeID:        request.NativeID,
 				},
 			}, nil
 		}
@@ -380,7 +400,7 @@ func (r *Router) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 				Operation:       resource.OperationDelete,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       errCode,
-				StatusMessage:   fmt.Sprintf("failed to delete router: %v", err),
+				StatusMessage:   fmt.Sprintf("failed to delete router: %s", resources.OpenStackErrorMessage(err)),
 			},
 		}, nil
 	}
@@ -390,7 +410,7 @@ func (r *Router) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 		ProgressResult: &resource.ProgressResult{
 			Operation:       resource.OperationDelete,
 			OperationStatus: resource.OperationStatusSuccess,
-			NativeID:        id,
+			NativeID:        request.NativeID,
 		},
 	}, nil
 }
@@ -403,7 +423,7 @@ func (r *Router) Status(ctx context.Context, request *resource.StatusRequest) (*
 // List discovers routers
 func (r *Router) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
 	// List all routers using pagination
-	allPages, err := routers.List(r.Client.NetworkClient, routers.ListOpts{}).AllPages(ctx)
+	allPages, err := routers.List(r.Client.NetworkClient, routers.ListOpts{Tags: r.Config.ManagedByTag}).AllPages(ctx)
 	if err != nil {
 		return &resource.ListResult{}, fmt.Errorf("failed to list routers: %w", err)
 	}
@@ -425,3 +445,9 @@ func (r *Router) List(ctx context.Context, request *resource.ListRequest) (*reso
 		NativeIDs: nativeIDs,
 	}, nil
 }
+
+// ResolveName returns the IDs of the routers called name
+func (r *Router) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	return idsByName(ctx, routers.List(r.Client.NetworkClient, routers.ListOpts{Name: name}), "routers", routers.ExtractRouters,
+		func(router routers.Router) string { return router.ID })
+}
@@ -0,0 +1,83 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ruleOwnership records which resource manages each security group rule,
+// and each group whose rules are managed as a SecurityGroupRuleSet, as seen
+// by this process. Neutron rejects an identical rule as a duplicate, and the
+// existing rule is only adopted when no other resource manages it. Entries
+// are keyed by native ID; an empty owner is a managed resource known from a
+// Read, which does not carry the resource's label.
+type ruleOwnership struct {
+	mu     sync.Mutex
+	owners map[string]string
+}
+
+func newRuleOwnership() *ruleOwnership {
+	return &ruleOwnership{owners: make(map[string]string)}
+}
+
+// ruleOwners is shared by the SecurityGroupRule and SecurityGroupRuleSet provisioners
+var ruleOwners = newRuleOwnership()
+
+// ruleOwner identifies the resource with the given type and label
+func ruleOwner(resourceType, label string) string {
+	return resourceType + "/" + label
+}
+
+// claim records owner as the manager of nativeID
+func (o *ruleOwnership) claim(nativeID, owner string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.owners[nativeID] = owner
+}
+
+// seen records nativeID as managed, keeping its owner if already known
+func (o *ruleOwnership) seen(nativeID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.owners[nativeID]; !ok {
+		o.owners[nativeID] = ""
+	}
+}
+
+// release forgets nativeID once its resource is deleted
+func (o *ruleOwnership) release(nativeID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.owners, nativeID)
+}
+
+// otherOwner returns the resource other than owner that manages any of
+// nativeIDs, if there is one
+func (o *ruleOwnership) otherOwner(owner string, nativeIDs ...string) (string, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, id := range nativeIDs {
+		if current, ok := o.owners[id]; ok && current != owner {
+			if current == "" {
+				current = "another resource"
+			}
+			return current, true
+		}
+	}
+	return "", false
+}
+
+// ruleOwnedError is returned when the identical rule Neutron already has is
+// managed by another resource
+type ruleOwnedError struct {
+	ruleID string
+	owner  string
+}
+
+func (e *ruleOwnedError) Error() string {
+	return fmt.Sprintf("an identical security group rule %s is already managed by %s; declare it once", e.ruleID, e.owner)
+}
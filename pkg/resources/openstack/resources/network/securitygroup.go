@@ -8,8 +8,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
@@ -74,6 +75,15 @@ func (s *SecurityGroup) Create(ctx context.Context, request *resource.CreateRequ
 		}, nil
 	}
 
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroup, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
 	// Extract security group name (required)
 	name, ok := props["name"].(string)
 	if !ok || name == "" {
@@ -97,41 +107,83 @@ func (s *SecurityGroup) Create(ctx context.Context, request *resource.CreateRequ
 		createOpts.Description = description
 	}
 
+	// A retried Create adopts the security group an earlier attempt made: a
+	// tagged one, or the untagged orphan of an attempt whose response was lost
+	tags := resources.ParseTags(props["tags"])
+	withTags := len(tags) > 0 && tagsSupported(ctx, s.Client, netClient)
+	attemptKey := prov.CreateAttemptKey(request, region)
+	var sg *groups.SecGroup
+	if withTags {
+		sg = findSecurityGroupToAdopt(ctx, netClient, props, tags)
+	}
+	adopted := sg != nil
+	if !adopted {
+		sg = findOrphanedSecurityGroup(ctx, netClient, props, attemptKey)
+	}
+
 	// Create the security group via OpenStack
-	sg, err := groups.Create(ctx, s.Client.NetworkClient, createOpts).Extract()
-	if err != nil {
-		return &resource.CreateResult{
-			ProgressResult: &resource.ProgressResult{
-				Operation:       resource.OperationCreate,
-				OperationStatus: resource.OperationStatusFailure,
-				ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
-				StatusMessage:   fmt.Sprintf("failed to create security group: %v", err),
-			},
-		}, nil
+	if sg == nil {
+		sg, err = groups.Create(ctx, netClient, createOpts).Extract()
+		if err != nil && mayHaveCreated(err) {
+			prov.LostCreates.Record(attemptKey)
+			if existing := findOrphanedSecurityGroup(ctx, netClient, props, attemptKey); existing != nil {
+				sg, err = existing, nil
+			}
+		}
+		if err != nil {
+			return &resource.CreateResult{
+				ProgressResult: &resource.ProgressResult{
+					Operation:       resource.OperationCreate,
+					OperationStatus: resource.OperationStatusFailure,
+					ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
+					StatusMessage:   fmt.Sprintf("failed to create security group: %v", err),
+				},
+			}, nil
+		}
 	}
+	prov.LostCreates.Clear(attemptKey)
 
 	// Set tags if provided (must be done after creation via attributestags API)
-	tags := resources.ParseTags(props["tags"])
-	if len(tags) > 0 {
-		_, err = attributestags.ReplaceAll(ctx, s.Client.NetworkClient, "security-groups", sg.ID, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - security group was created successfully
-			fmt.Printf("warning: failed to set tags on security group %s: %v\n", sg.ID, err)
-		} else {
-			sg.Tags = tags
+	if withTags && !adopted {
+		if set, ok := replaceTags(ctx, netClient, "security-groups", "security group", sg.ID, tags); ok {
+			sg.Tags = set
+		}
+	}
+
+	// Remove the egress allow-all rules OpenStack adds to every new group.
+	// Only a group this Create or its lost attempt made is cleared; an
+	// adopted tagged one may already hold managed rules.
+	deleteDefaults, _ := props["delete_default_rules"].(bool)
+	if deleteDefaults && !adopted {
+		if err := deleteDefaultRules(ctx, netClient, sg.ID); err != nil {
+			// A failed Create is not tracked, so remove the group rather than orphan it
+			message := fmt.Sprintf("failed to delete default rules of security group %s: %v", sg.ID, err)
+			if deleteErr := groups.Delete(ctx, netClient, sg.ID).ExtractErr(); deleteErr != nil {
+				message += fmt.Sprintf("; the group could not be removed either: %v", deleteErr)
+			}
+			return &resource.CreateResult{
+				ProgressResult: &resource.ProgressResult{
+					Operation:       resource.OperationCreate,
+					OperationStatus: resource.OperationStatusFailure,
+					ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
+					StatusMessage:   message,
+				},
+			}, nil
 		}
 	}
 
 	// Convert security group to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(securityGroupToProperties(sg))
+	sgProps := securityGroupToProperties(sg)
+	if deleteDefaults {
+		sgProps["delete_default_rules"] = true
+	}
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(sgProps, region))
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        sg.ID,
+				NativeID:        resources.RegionalNativeID(region, sg.ID),
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -143,32 +195,69 @@ func (s *SecurityGroup) Create(ctx context.Context, request *resource.CreateRequ
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           sg.ID,
+			NativeID:           resources.RegionalNativeID(region, sg.ID),
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
 }
 
+// deleteDefaultRules deletes every rule of a freshly created security group.
+// A new group only holds the defaults OpenStack added (egress allow-all for
+// IPv4 and IPv6), so rules managed by SecurityGroupRule are never touched.
+func deleteDefaultRules(ctx context.Context, netClient *gophercloud.ServiceClient, sgID string) error {
+	allPages, err := rules.List(netClient, rules.ListOpts{SecGroupID: sgID}).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	sgRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract rules: %w", err)
+	}
+
+	for _, rule := range sgRules {
+		err := rules.Delete(ctx, netClient, rule.ID).ExtractErr()
+		if err != nil && resources.MapOpenStackErrorToOperationErrorCode(err) != resource.OperationErrorCodeNotFound {
+			return fmt.Errorf("failed to delete rule %s: %w", rule.ID, err)
+		}
+	}
+
+	return nil
+}
+
 // Read retrieves the current state of a security group
 func (s *SecurityGroup) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
 	// Get the security group ID from NativeID
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
 	if id == "" {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeInvalidRequest,
 		}, nil // Don't return Go error for expected errors
 	}
 
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
 	// Get the security group from OpenStack
-	sg, err := groups.Get(ctx, s.Client.NetworkClient, id).Extract()
+	sg, err := groups.Get(ctx, netClient, id).Extract()
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
 		}, nil // Don't return Go error for expected errors like NotFound
 	}
 
+	if tagsSupported(ctx, s.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "security-groups", "security group", id); ok {
+			sg.Tags = tags
+		}
+	}
+
 	// Convert security group to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(securityGroupToProperties(sg))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(securityGroupToProperties(sg), region))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
@@ -189,7 +278,14 @@ func (s *SecurityGroup) Update(ctx context.Context, request *resource.UpdateRequ
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroup, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Parse request properties
 	props, err := resources.ParseProperties(request.DesiredProperties)
@@ -212,7 +308,7 @@ func (s *SecurityGroup) Update(ctx context.Context, request *resource.UpdateRequ
 	}
 
 	// Update the security group via OpenStack
-	sg, err := groups.Update(ctx, s.Client.NetworkClient, id, updateOpts).Extract()
+	sg, err := groups.Update(ctx, netClient, id, updateOpts).Extract()
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -224,31 +320,25 @@ func (s *SecurityGroup) Update(ctx context.Context, request *resource.UpdateRequ
 		}, nil
 	}
 
-	// Update tags if provided (via attributestags API)
-	if _, hasTags := props["tags"]; hasTags {
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, s.Client, netClient) {
 		tags := resources.ParseTags(props["tags"])
 		if tags == nil {
-			tags = []string{} // Empty slice to clear all tags
+			tags = []string{} // Empty slice clears tags removed from the desired state
 		}
-		updatedTags, err := attributestags.ReplaceAll(ctx, s.Client.NetworkClient, "security-groups", id, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - security group was updated successfully
-			fmt.Printf("warning: failed to update tags on security group %s: %v\n", id, err)
-		} else {
-			sg.Tags = updatedTags
+		if set, ok := replaceTags(ctx, netClient, "security-groups", "security group", id, tags); ok {
+			sg.Tags = set
 		}
 	}
 
 	// Convert security group to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(securityGroupToProperties(sg))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(securityGroupToProperties(sg), region))
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationUpdate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        sg.ID,
+				NativeID:        request.NativeID,
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -260,7 +350,7 @@ func (s *SecurityGroup) Update(ctx context.Context, request *resource.UpdateRequ
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           sg.ID,
+			NativeID:           request.NativeID,
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -275,20 +365,28 @@ func (s *SecurityGroup) Delete(ctx context.Context, request *resource.DeleteRequ
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecurityGroup, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Delete the security group from OpenStack
-	err := groups.Delete(ctx, s.Client.NetworkClient, id).ExtractErr()
+	err = groups.Delete(ctx, netClient, id).ExtractErr()
 	if err != nil {
 		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
 		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
 		if errCode == resource.OperationErrorCodeNotFound {
 			// Resource already deleted - this is a success
+			groupTeardowns.groupDeleted(request.NativeID)
 			return &resource.DeleteResult{
 				ProgressResult: &resource.ProgressResult{
 					Operation:       resource.OperationDelete,
 					OperationStatus: resource.OperationStatusSuccess,
-					NativeID:        id,
+					NativeID:        request.NativeID,
 				},
 			}, nil
 		}
@@ -299,17 +397,20 @@ func (s *SecurityGroup) Delete(ctx context.Context, request *resource.DeleteRequ
 				Operation:       resource.OperationDelete,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       errCode,
-				StatusMessage:   fmt.Sprintf("failed to delete security group: %v", err),
+				StatusMessage:   fmt.Sprintf("failed to delete security group: %s", resources.OpenStackErrorMessage(err)),
 			},
 		}, nil
 	}
 
+	// Its rules are gone with it
+	groupTeardowns.groupDeleted(request.NativeID)
+
 	// Return success
 	return &resource.DeleteResult{
 		ProgressResult: &resource.ProgressResult{
 			Operation:       resource.OperationDelete,
 			OperationStatus: resource.OperationStatusSuccess,
-			NativeID:        id,
+			NativeID:        request.NativeID,
 		},
 	}, nil
 }
@@ -322,7 +423,7 @@ func (s *SecurityGroup) Status(ctx context.Context, request *resource.StatusRequ
 // List discovers security groups
 func (s *SecurityGroup) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
 	// List all security groups using pagination
-	allPages, err := groups.List(s.Client.NetworkClient, groups.ListOpts{}).AllPages(ctx)
+	allPages, err := groups.List(s.Client.NetworkClient, groups.ListOpts{Tags: s.Config.ManagedByTag}).AllPages(ctx)
 	if err != nil {
 		return &resource.ListResult{}, fmt.Errorf("failed to list security groups: %w", err)
 	}
@@ -343,3 +444,9 @@ func (s *SecurityGroup) List(ctx context.Context, request *resource.ListRequest)
 		NativeIDs: nativeIDs,
 	}, nil
 }
+
+// ResolveName returns the IDs of the security groups called name
+func (s *SecurityGroup) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	return idsByName(ctx, groups.List(s.Client.NetworkClient, groups.ListOpts{Name: name}), "security groups", groups.ExtractGroups,
+		func(sg groups.SecGroup) string { return sg.ID })
+}
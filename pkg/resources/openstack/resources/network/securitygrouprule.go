@@ -6,13 +6,16 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
 	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 )
 
@@ -59,58 +62,103 @@ func securityGroupRuleToProperties(rule *rules.SecGroupRule) map[string]any {
 	return props
 }
 
-// Register the SecurityGroupRule resource type
-func init() {
-	registry.RegisterOpenStack(
-		ResourceTypeSecurityGroupRule,
-		[]resource.Operation{
-			resource.OperationCreate,
-			resource.OperationRead,
-			resource.OperationDelete,
-			resource.OperationList,
-		},
-		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
-			return &SecurityGroupRule{
-				Client: client,
-				Config: cfg,
-			}
-		},
-	)
+// secGroupRule is a rule along with its remote_address_group_id, which
+// gophercloud's rules package does not decode
+type secGroupRule struct {
+	rules.SecGroupRule
+	RemoteAddressGroupID string
 }
 
-// Create creates a new security group rule
-func (s *SecurityGroupRule) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
-	// Parse request properties
-	props, err := resources.ParseProperties(request.Properties)
+// secGroupRuleResult is a create or get result for a rule
+type secGroupRuleResult interface {
+	Extract() (*rules.SecGroupRule, error)
+	ExtractIntoStructPtr(to any, label string) error
+}
+
+// extractRule extracts a rule and its remote address group from a result
+func extractRule(r secGroupRuleResult) (*secGroupRule, error) {
+	rule, err := r.Extract()
 	if err != nil {
-		return &resource.CreateResult{
-			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
-		}, nil
+		return nil, err
+	}
+	var extra struct {
+		RemoteAddressGroupID string `json:"remote_address_group_id"`
+	}
+	if err := r.ExtractIntoStructPtr(&extra, "security_group_rule"); err != nil {
+		return nil, err
+	}
+	return &secGroupRule{SecGroupRule: *rule, RemoteAddressGroupID: extra.RemoteAddressGroupID}, nil
+}
+
+// secGroupRuleToProperties converts a rule to a properties map, including its remote address group
+func secGroupRuleToProperties(rule *secGroupRule) map[string]any {
+	props := securityGroupRuleToProperties(&rule.SecGroupRule)
+	if rule.RemoteAddressGroupID != "" {
+		props["remote_address_group_id"] = rule.RemoteAddressGroupID
+	}
+	return props
+}
+
+// ruleCreateOpts adds remote_address_group_id to the create options, which
+// gophercloud's rules.CreateOpts does not send
+type ruleCreateOpts struct {
+	rules.CreateOpts
+	RemoteAddressGroupID string
+}
+
+// ToSecGroupRuleCreateMap builds the request body for the rule
+func (opts ruleCreateOpts) ToSecGroupRuleCreateMap() (map[string]any, error) {
+	b, err := opts.CreateOpts.ToSecGroupRuleCreateMap()
+	if err != nil || opts.RemoteAddressGroupID == "" {
+		return b, err
+	}
+	rule, ok := b["security_group_rule"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected security group rule request body")
+	}
+	rule["remote_address_group_id"] = opts.RemoteAddressGroupID
+	return b, nil
+}
+
+// ruleCreateOptsFromProperties builds the create options for a standalone
+// rule, which may target an address group as well as a prefix or a group
+func ruleCreateOptsFromProperties(props map[string]interface{}) (ruleCreateOpts, error) {
+	createOpts, err := securityGroupRuleCreateOpts(props)
+	if err != nil {
+		return ruleCreateOpts{}, err
+	}
+	opts := ruleCreateOpts{CreateOpts: createOpts}
+	opts.RemoteAddressGroupID, _ = props["remote_address_group_id"].(string)
+
+	remotes := 0
+	for _, remote := range []string{createOpts.RemoteIPPrefix, createOpts.RemoteGroupID, opts.RemoteAddressGroupID} {
+		if remote != "" {
+			remotes++
+		}
+	}
+	if remotes > 1 {
+		return ruleCreateOpts{}, fmt.Errorf("only one of remote_ip_prefix, remote_group_id and remote_address_group_id can be set")
 	}
+	return opts, nil
+}
 
-	// Extract required fields
+// securityGroupRuleCreateOpts builds the create options for a rule from its properties
+func securityGroupRuleCreateOpts(props map[string]interface{}) (rules.CreateOpts, error) {
 	secGroupID, ok := props["security_group_id"].(string)
 	if !ok || secGroupID == "" {
-		return &resource.CreateResult{
-			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", "security_group_id is required"),
-		}, nil
+		return rules.CreateOpts{}, fmt.Errorf("security_group_id is required")
 	}
 
 	direction, ok := props["direction"].(string)
 	if !ok || direction == "" {
-		return &resource.CreateResult{
-			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", "direction is required"),
-		}, nil
+		return rules.CreateOpts{}, fmt.Errorf("direction is required")
 	}
 
 	ethertype, ok := props["ethertype"].(string)
 	if !ok || ethertype == "" {
-		return &resource.CreateResult{
-			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", "ethertype is required"),
-		}, nil
+		return rules.CreateOpts{}, fmt.Errorf("ethertype is required")
 	}
 
-	// Build create options
 	createOpts := rules.CreateOpts{
 		SecGroupID: secGroupID,
 		Direction:  rules.RuleDirection(direction),
@@ -123,13 +171,11 @@ func (s *SecurityGroupRule) Create(ctx context.Context, request *resource.Create
 	}
 
 	if portMin, ok := props["port_range_min"].(float64); ok {
-		portMinInt := int(portMin)
-		createOpts.PortRangeMin = portMinInt
+		createOpts.PortRangeMin = int(portMin)
 	}
 
 	if portMax, ok := props["port_range_max"].(float64); ok {
-		portMaxInt := int(portMax)
-		createOpts.PortRangeMax = portMaxInt
+		createOpts.PortRangeMax = int(portMax)
 	}
 
 	if remoteIPPrefix, ok := props["remote_ip_prefix"].(string); ok && remoteIPPrefix != "" {
@@ -144,39 +190,163 @@ func (s *SecurityGroupRule) Create(ctx context.Context, request *resource.Create
 		createOpts.Description = description
 	}
 
-	// Create the security group rule via OpenStack
-	rule, err := rules.Create(ctx, s.Client.NetworkClient, createOpts).Extract()
+	return createOpts, nil
+}
+
+// ruleMatches reports whether an existing rule allows exactly the traffic described by opts.
+// Neutron treats such rules as duplicates regardless of description.
+func ruleMatches(rule rules.SecGroupRule, opts rules.CreateOpts) bool {
+	return rule.SecGroupID == opts.SecGroupID &&
+		rule.Direction == string(opts.Direction) &&
+		rule.EtherType == string(opts.EtherType) &&
+		rule.Protocol == string(opts.Protocol) &&
+		rule.PortRangeMin == opts.PortRangeMin &&
+		rule.PortRangeMax == opts.PortRangeMax &&
+		rule.RemoteIPPrefix == opts.RemoteIPPrefix &&
+		rule.RemoteGroupID == opts.RemoteGroupID
+}
+
+// createOrAdoptRule creates a rule, or returns the existing identical rule when
+// Neutron rejects the create as a duplicate. Adopting lets a create whose
+// response was lost be retried, and a rule that exists outside formae be
+// imported; a duplicate that another resource manages is a conflict instead,
+// so that two resources never own the same rule.
+func createOrAdoptRule(ctx context.Context, netClient *gophercloud.ServiceClient, region string, opts ruleCreateOpts, owner string) (*secGroupRule, error) {
+	rule, err := extractRule(rules.Create(ctx, netClient, opts))
+	if err == nil {
+		return rule, nil
+	}
+	if resources.MapOpenStackErrorToOperationErrorCode(err) != resource.OperationErrorCodeAlreadyExists {
+		return nil, err
+	}
+
+	allPages, listErr := rules.List(netClient, rules.ListOpts{
+		SecGroupID: opts.SecGroupID,
+		Direction:  string(opts.Direction),
+		EtherType:  string(opts.EtherType),
+	}).AllPages(ctx)
+	if listErr != nil {
+		return nil, err
+	}
+	existing, listErr := rules.ExtractRules(allPages)
+	if listErr != nil {
+		return nil, err
+	}
+	for i := range existing {
+		if !ruleMatches(existing[i], opts.CreateOpts) {
+			continue
+		}
+		// Listed rules lack the address group, so fetch the candidate to compare it
+		candidate, getErr := extractRule(rules.Get(ctx, netClient, existing[i].ID))
+		if getErr != nil || candidate.RemoteAddressGroupID != opts.RemoteAddressGroupID {
+			continue
+		}
+		if other, ok := ruleOwners.otherOwner(owner, resources.RegionalNativeID(region, candidate.ID), resources.RegionalNativeID(region, candidate.SecGroupID)); ok {
+			return nil, &ruleOwnedError{ruleID: candidate.ID, owner: other}
+		}
+		return candidate, nil
+	}
+	return nil, err
+}
+
+// ruleErrorCode maps a rule create error to an operation error code
+func ruleErrorCode(err error) resource.OperationErrorCode {
+	var owned *ruleOwnedError
+	if errors.As(err, &owned) {
+		return resource.OperationErrorCodeResourceConflict
+	}
+	return resources.MapOpenStackErrorToOperationErrorCode(err)
+}
+
+// sameTraffic reports whether two rules differ at most in their description
+func sameTraffic(a, b ruleCreateOpts) bool {
+	a.Description, b.Description = "", ""
+	return a == b
+}
+
+// Register the SecurityGroupRule resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeSecurityGroupRule,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &SecurityGroupRule{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates a new security group rule
+func (s *SecurityGroupRule) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	// Parse request properties
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	createOpts, err := ruleCreateOptsFromProperties(props)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// Create the security group rule via OpenStack, adopting an identical existing rule
+	owner := ruleOwner(ResourceTypeSecurityGroupRule, request.Label)
+	rule, err := createOrAdoptRule(ctx, netClient, region, createOpts, owner)
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				ErrorCode:       resources.MapOpenStackErrorToOperationErrorCode(err),
+				ErrorCode:       ruleErrorCode(err),
 				StatusMessage:   fmt.Sprintf("failed to create security group rule: %v", err),
 			},
 		}, nil
 	}
 
 	// Convert rule to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(securityGroupRuleToProperties(rule))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(secGroupRuleToProperties(rule), region))
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        rule.ID,
+				NativeID:        resources.RegionalNativeID(region, rule.ID),
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
 		}, nil
 	}
 
+	groupTeardowns.ruleSeen(resources.RegionalNativeID(region, rule.ID), resources.RegionalNativeID(region, rule.SecGroupID))
+	ruleOwners.claim(resources.RegionalNativeID(region, rule.ID), owner)
+
 	// Return success with properties
 	return &resource.CreateResult{
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           rule.ID,
+			NativeID:           resources.RegionalNativeID(region, rule.ID),
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -185,23 +355,32 @@ func (s *SecurityGroupRule) Create(ctx context.Context, request *resource.Create
 // Read retrieves the current state of a security group rule
 func (s *SecurityGroupRule) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
 	// Get the security group rule ID from NativeID
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
 	if id == "" {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeInvalidRequest,
 		}, nil // Don't return Go error for expected errors
 	}
 
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
 	// Get the security group rule from OpenStack
-	rule, err := rules.Get(ctx, s.Client.NetworkClient, id).Extract()
+	rule, err := extractRule(rules.Get(ctx, netClient, id))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
 		}, nil // Don't return Go error for expected errors like NotFound
 	}
+	groupTeardowns.ruleSeen(request.NativeID, resources.RegionalNativeID(region, rule.SecGroupID))
+	ruleOwners.seen(request.NativeID)
 
 	// Convert rule to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(securityGroupRuleToProperties(rule))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(secGroupRuleToProperties(rule), region))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
@@ -213,18 +392,93 @@ func (s *SecurityGroupRule) Read(ctx context.Context, request *resource.ReadRequ
 	}, nil
 }
 
-// Update returns an error because security group rules are immutable in OpenStack.
-// To change a rule, users must delete and recreate it.
+// Update replaces a rule, since security group rules are immutable in OpenStack.
+// The new rule is created before the old one is deleted so that changing a rule
+// never leaves a window where the traffic it covers is blocked; for a moment both
+// rules are in place. The replacement's ID is returned as the new NativeID.
+// A change to the description alone keeps the rule: Neutron cannot update it,
+// and replacing a rule with one Neutron sees as its duplicate is not possible.
 func (s *SecurityGroupRule) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
-	// Security group rules are immutable in OpenStack - cannot be updated
+	region, oldID := resources.ParseRegionalNativeID(request.NativeID)
+	if oldID == "" {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, "native ID is required"),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	createOpts, err := ruleCreateOptsFromProperties(props)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	owner := ruleOwner(ResourceTypeSecurityGroupRule, request.Label)
+	var rule *secGroupRule
+	if priorProps, err := resources.ParseProperties(request.PriorProperties); err == nil {
+		if priorOpts, err := ruleCreateOptsFromProperties(priorProps); err == nil && sameTraffic(priorOpts, createOpts) {
+			rule, err = extractRule(rules.Get(ctx, netClient, oldID))
+			if err != nil {
+				return &resource.UpdateResult{
+					ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to get security group rule: %s", resources.OpenStackErrorMessage(err))),
+				}, nil
+			}
+			if rule.Description != createOpts.Description {
+				warnings.Warnf(ctx, "security group rule %s keeps its description %q: Neutron cannot change it without replacing the rule", oldID, rule.Description)
+			}
+		}
+	}
+
+	if rule == nil {
+		rule, err = createOrAdoptRule(ctx, netClient, region, createOpts, owner)
+		if err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, ruleErrorCode(err), request.NativeID, fmt.Sprintf("failed to create replacement security group rule: %v", err)),
+			}, nil
+		}
+
+		if err := rules.Delete(ctx, netClient, oldID).ExtractErr(); err != nil &&
+			resources.MapOpenStackErrorToOperationErrorCode(err) != resource.OperationErrorCodeNotFound {
+			// The replacement is in place, so report it as the resource and surface the stale rule
+			ruleOwners.claim(resources.RegionalNativeID(region, rule.ID), owner)
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resources.MapOpenStackErrorToOperationErrorCode(err), resources.RegionalNativeID(region, rule.ID), fmt.Sprintf("replacement rule %s created but failed to delete old rule %s: %v", rule.ID, oldID, err)),
+			}, nil
+		}
+		ruleOwners.release(request.NativeID)
+	}
+
+	nativeID := resources.RegionalNativeID(region, rule.ID)
+	groupTeardowns.ruleSeen(nativeID, resources.RegionalNativeID(region, rule.SecGroupID))
+	ruleOwners.claim(nativeID, owner)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(secGroupRuleToProperties(rule), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
 	return &resource.UpdateResult{
-		ProgressResult: resources.NewFailureResultWithMessage(
-			resource.OperationUpdate,
-			ResourceTypeSecurityGroupRule,
-			resource.OperationErrorCodeInvalidRequest,
-			request.NativeID,
-			"security group rules are immutable and cannot be updated; delete and recreate instead",
-		),
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
 	}, nil
 }
 
@@ -237,20 +491,40 @@ func (s *SecurityGroupRule) Delete(ctx context.Context, request *resource.Delete
 		}, nil
 	}
 
-	id := request.NativeID
+	// The rule went with its security group; skip the call that would 404
+	if groupTeardowns.ruleDeleted(request.NativeID) {
+		ruleOwners.release(request.NativeID)
+		return &resource.DeleteResult{
+			ProgressResult: &resource.ProgressResult{
+				Operation:       resource.OperationDelete,
+				OperationStatus: resource.OperationStatusSuccess,
+				NativeID:        request.NativeID,
+			},
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecurityGroupRule, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Delete the security group rule from OpenStack
-	err := rules.Delete(ctx, s.Client.NetworkClient, id).ExtractErr()
+	err = rules.Delete(ctx, netClient, id).ExtractErr()
 	if err != nil {
 		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
 		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
 		if errCode == resource.OperationErrorCodeNotFound {
 			// Resource already deleted - this is a success
+			ruleOwners.release(request.NativeID)
 			return &resource.DeleteResult{
 				ProgressResult: &resource.ProgressResult{
 					Operation:       resource.OperationDelete,
 					OperationStatus: resource.OperationStatusSuccess,
-					NativeID:        id,
+					NativeID:        request.NativeID,
 				},
 			}, nil
 		}
@@ -261,17 +535,19 @@ func (s *SecurityGroupRule) Delete(ctx context.Context, request *resource.Delete
 				Operation:       resource.OperationDelete,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       errCode,
-				StatusMessage:   fmt.Sprintf("failed to delete security group rule: %v", err),
+				StatusMessage:   fmt.Sprintf("failed to delete security group rule: %s", resources.OpenStackErrorMessage(err)),
 			},
 		}, nil
 	}
 
+	ruleOwners.release(request.NativeID)
+
 	// Return success
 	return &resource.DeleteResult{
 		ProgressResult: &resource.ProgressResult{
 			Operation:       resource.OperationDelete,
 			OperationStatus: resource.OperationStatusSuccess,
-			NativeID:        id,
+			NativeID:        request.NativeID,
 		},
 	}, nil
 }
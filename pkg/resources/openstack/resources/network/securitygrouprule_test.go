@@ -0,0 +1,197 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ruleNeutron keeps security group rules and rejects duplicates as Neutron
+// does. Deleting a security group deletes its rules.
+type ruleNeutron struct {
+	mu      sync.Mutex
+	rules   map[string]map[string]interface{}
+	posts   int
+	deletes int
+}
+
+func newRuleNeutron(t *testing.T) (*ruleNeutron, *SecurityGroupRule) {
+	t.Helper()
+	neutron := &ruleNeutron{rules: make(map[string]map[string]interface{})}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		neutron.mu.Lock()
+		defer neutron.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		id := strings.TrimPrefix(r.URL.Path, "/security-group-rules/")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/security-group-rules":
+			neutron.posts++
+			var body struct {
+				Rule map[string]interface{} `json:"security_group_rule"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			for existingID, existing := range neutron.rules {
+				if existing["security_group_id"] == body.Rule["security_group_id"] &&
+					existing["direction"] == body.Rule["direction"] &&
+					existing["ethertype"] == body.Rule["ethertype"] &&
+					existing["protocol"] == body.Rule["protocol"] {
+					w.WriteHeader(http.StatusConflict)
+					json.NewEncoder(w).Encode(map[string]interface{}{"NeutronError": map[string]interface{}{
+						"type":    "SecurityGroupRuleExists",
+						"message": fmt.Sprintf("Security group rule already exists. Rule id is %s.", existingID),
+					}})
+					return
+				}
+			}
+			body.Rule["id"] = fmt.Sprintf("rule-%d", neutron.posts)
+			neutron.rules[body.Rule["id"].(string)] = body.Rule
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"security_group_rule": body.Rule})
+		case r.Method == http.MethodGet && r.URL.Path == "/security-group-rules":
+			list := []interface{}{}
+			for _, rule := range neutron.rules {
+				list = append(list, rule)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"security_group_rules": list})
+		case r.Method == http.MethodGet && neutron.rules[id] != nil:
+			json.NewEncoder(w).Encode(map[string]interface{}{"security_group_rule": neutron.rules[id]})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/security-groups/"):
+			group := strings.TrimPrefix(r.URL.Path, "/security-groups/")
+			for ruleID, rule := range neutron.rules {
+				if rule["security_group_id"] == group {
+					delete(neutron.rules, ruleID)
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && neutron.rules[id] != nil:
+			neutron.deletes++
+			delete(neutron.rules, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	netClient := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: server.URL + "/"}
+	return neutron, &SecurityGroupRule{Client: &openstack.Client{NetworkClient: netClient}, Config: &openstack.Config{}}
+}
+
+func ruleCreateRequest(label, description string) *resource.CreateRequest {
+	return &resource.CreateRequest{
+		ResourceType: ResourceTypeSecurityGroupRule,
+		Label:        label,
+		Properties: json.RawMessage(fmt.Sprintf(`{"security_group_id": "sg-owned", "direction": "ingress", "ethertype": "IPv4", "protocol": "tcp", "description": %q}`,
+			description)),
+	}
+}
+
+func TestSecurityGroupRule_CreateAdoptsUnmanagedDuplicate(t *testing.T) {
+	neutron, s := newRuleNeutron(t)
+	neutron.rules["rule-existing"] = map[string]interface{}{
+		"id": "rule-existing", "security_group_id": "sg-owned", "direction": "ingress", "ethertype": "IPv4", "protocol": "tcp",
+	}
+	t.Cleanup(func() { ruleOwners.release("rule-existing") })
+
+	result, err := s.Create(context.Background(), ruleCreateRequest("ssh", "ssh"))
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "rule-existing", result.ProgressResult.NativeID)
+
+	// A retry of the same resource adopts the rule it now owns
+	result, err = s.Create(context.Background(), ruleCreateRequest("ssh", "ssh"))
+	require.NoError(t, err)
+	assert.Equal(t, "rule-existing", result.ProgressResult.NativeID)
+}
+
+func TestSecurityGroupRule_CreateConflictsWithManagedDuplicate(t *testing.T) {
+	_, s := newRuleNeutron(t)
+
+	first, err := s.Create(context.Background(), ruleCreateRequest("ssh", "ssh"))
+	require.NoError(t, err)
+	require.Equal(t, resource.OperationStatusSuccess, first.ProgressResult.OperationStatus)
+	t.Cleanup(func() { ruleOwners.release(first.ProgressResult.NativeID) })
+
+	second, err := s.Create(context.Background(), ruleCreateRequest("ssh-again", "ssh again"))
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusFailure, second.ProgressResult.OperationStatus)
+	assert.Equal(t, resource.OperationErrorCodeResourceConflict, second.ProgressResult.ErrorCode)
+	assert.Contains(t, second.ProgressResult.StatusMessage, ruleOwner(ResourceTypeSecurityGroupRule, "ssh"))
+}
+
+func TestSecurityGroupRule_CreateConflictsWithRuleSet(t *testing.T) {
+	neutron, s := newRuleNeutron(t)
+	neutron.rules["rule-set"] = map[string]interface{}{
+		"id": "rule-set", "security_group_id": "sg-owned", "direction": "ingress", "ethertype": "IPv4", "protocol": "tcp",
+	}
+	// The group's rules are managed as a set, known from a Read
+	ruleOwners.seen("sg-owned")
+	t.Cleanup(func() { ruleOwners.release("sg-owned") })
+
+	result, err := s.Create(context.Background(), ruleCreateRequest("ssh", "ssh"))
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationErrorCodeResourceConflict, result.ProgressResult.ErrorCode)
+}
+
+func TestSecurityGroupRule_UpdateDescriptionKeepsRule(t *testing.T) {
+	neutron, s := newRuleNeutron(t)
+
+	created, err := s.Create(context.Background(), ruleCreateRequest("ssh", "ssh"))
+	require.NoError(t, err)
+	nativeID := created.ProgressResult.NativeID
+	t.Cleanup(func() { ruleOwners.release(nativeID) })
+
+	result, err := s.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          nativeID,
+		ResourceType:      ResourceTypeSecurityGroupRule,
+		Label:             "ssh",
+		PriorProperties:   ruleCreateRequest("ssh", "ssh").Properties,
+		DesiredProperties: ruleCreateRequest("ssh", "ssh from the bastion").Properties,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, nativeID, result.ProgressResult.NativeID)
+	assert.Equal(t, 1, neutron.posts, "the rule must not be recreated")
+	assert.Zero(t, neutron.deletes)
+}
+
+func TestSecurityGroupRule_UpdateReplacesRule(t *testing.T) {
+	neutron, s := newRuleNeutron(t)
+
+	created, err := s.Create(context.Background(), ruleCreateRequest("ssh", "ssh"))
+	require.NoError(t, err)
+	oldID := created.ProgressResult.NativeID
+
+	result, err := s.Update(context.Background(), &resource.UpdateRequest{
+		NativeID:          oldID,
+		ResourceType:      ResourceTypeSecurityGroupRule,
+		Label:             "ssh",
+		PriorProperties:   ruleCreateRequest("ssh", "ssh").Properties,
+		DesiredProperties: json.RawMessage(`{"security_group_id": "sg-owned", "direction": "ingress", "ethertype": "IPv4", "protocol": "udp"}`),
+	})
+	require.NoError(t, err)
+	newID := result.ProgressResult.NativeID
+	t.Cleanup(func() { ruleOwners.release(newID) })
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.NotEqual(t, oldID, newID)
+	assert.NotContains(t, neutron.rules, oldID)
+
+	_, managed := ruleOwners.otherOwner("", oldID)
+	assert.False(t, managed, "the replaced rule is released")
+}
@@ -0,0 +1,374 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeSecurityGroupRuleSet = "OVH::Network::SecurityGroupRuleSet"
+)
+
+// SecurityGroupRuleSet manages every rule of one security group as a unit.
+// The set is authoritative: rules in the group that are not in the set,
+// including the default egress rules Neutron creates, are removed.
+// The NativeID is the security group's (regional) ID.
+type SecurityGroupRuleSet struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// ruleSetToProperties converts the rules of a group to a properties map.
+// Rules are sorted so that reads are stable regardless of creation order.
+func ruleSetToProperties(secGroupID string, ruleList []rules.SecGroupRule) map[string]any {
+	sorted := make([]rules.SecGroupRule, len(ruleList))
+	copy(sorted, ruleList)
+	sort.Slice(sorted, func(i, j int) bool {
+		return ruleSortKey(sorted[i]) < ruleSortKey(sorted[j])
+	})
+
+	ruleProps := make([]any, 0, len(sorted))
+	ruleIDs := make([]any, 0, len(sorted))
+	for i := range sorted {
+		props := securityGroupRuleToProperties(&sorted[i])
+		delete(props, "id")
+		delete(props, "security_group_id")
+		ruleProps = append(ruleProps, props)
+		ruleIDs = append(ruleIDs, sorted[i].ID)
+	}
+
+	return map[string]any{
+		"security_group_id": secGroupID,
+		"rules":             ruleProps,
+		"rule_ids":          ruleIDs,
+	}
+}
+
+// ruleSortKey orders rules by the traffic they allow
+func ruleSortKey(rule rules.SecGroupRule) string {
+	return fmt.Sprintf("%s|%s|%s|%05d|%05d|%s|%s",
+		rule.Direction, rule.EtherType, rule.Protocol, rule.PortRangeMin, rule.PortRangeMax,
+		rule.RemoteIPPrefix, rule.RemoteGroupID)
+}
+
+// ruleSetCreateOpts builds create options for every rule in the set
+func ruleSetCreateOpts(props map[string]interface{}) (string, []rules.CreateOpts, error) {
+	secGroupID, ok := props["security_group_id"].(string)
+	if !ok || secGroupID == "" {
+		return "", nil, fmt.Errorf("security_group_id is required")
+	}
+
+	ruleList, _ := props["rules"].([]interface{})
+	opts := make([]rules.CreateOpts, 0, len(ruleList))
+	for i, item := range ruleList {
+		ruleProps, ok := item.(map[string]interface{})
+		if !ok {
+			return "", nil, fmt.Errorf("rules[%d] must be an object", i)
+		}
+		withGroup := make(map[string]interface{}, len(ruleProps)+1)
+		for k, v := range ruleProps {
+			withGroup[k] = v
+		}
+		withGroup["security_group_id"] = secGroupID
+
+		ruleOpts, err := securityGroupRuleCreateOpts(withGroup)
+		if err != nil {
+			return "", nil, fmt.Errorf("rules[%d]: %w", i, err)
+		}
+		opts = append(opts, ruleOpts)
+	}
+	return secGroupID, opts, nil
+}
+
+// diffRuleSet returns the desired rules missing from the group, and the
+// existing rules the set does not contain. Descriptions are not compared.
+func diffRuleSet(desired []rules.CreateOpts, existing []rules.SecGroupRule) (toCreate []rules.CreateOpts, toDelete []rules.SecGroupRule) {
+	matched := make([]bool, len(existing))
+	for _, opts := range desired {
+		found := false
+		for i := range existing {
+			if !matched[i] && ruleMatches(existing[i], opts) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			toCreate = append(toCreate, opts)
+		}
+	}
+	for i := range existing {
+		if !matched[i] {
+			toDelete = append(toDelete, existing[i])
+		}
+	}
+	return toCreate, toDelete
+}
+
+// listGroupRules returns every rule of a security group
+func listGroupRules(ctx context.Context, netClient *gophercloud.ServiceClient, secGroupID string) ([]rules.SecGroupRule, error) {
+	allPages, err := rules.List(netClient, rules.ListOpts{SecGroupID: secGroupID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rules.ExtractRules(allPages)
+}
+
+// reconcileRuleSet creates the missing rules, then deletes the extra ones.
+// If a create fails, the rules created so far are deleted again so the group
+// is left as it was; extras are only removed once every create succeeded.
+func reconcileRuleSet(ctx context.Context, netClient *gophercloud.ServiceClient, secGroupID string, desired []rules.CreateOpts) ([]rules.SecGroupRule, error) {
+	existing, err := listGroupRules(ctx, netClient, secGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security group rules: %w", err)
+	}
+
+	toCreate, toDelete := diffRuleSet(desired, existing)
+
+	var created []string
+	for _, opts := range toCreate {
+		rule, err := rules.Create(ctx, netClient, opts).Extract()
+		if err != nil {
+			for _, id := range created {
+				if delErr := rules.Delete(ctx, netClient, id).ExtractErr(); delErr != nil {
+					warnings.Warnf(ctx, "failed to roll back security group rule %s: %v", id, delErr)
+				}
+			}
+			return nil, fmt.Errorf("failed to create security group rule: %w", err)
+		}
+		created = append(created, rule.ID)
+	}
+
+	for _, rule := range toDelete {
+		err := rules.Delete(ctx, netClient, rule.ID).ExtractErr()
+		if err != nil && resources.MapOpenStackErrorToOperationErrorCode(err) != resource.OperationErrorCodeNotFound {
+			return nil, fmt.Errorf("failed to delete security group rule %s: %w", rule.ID, err)
+		}
+	}
+
+	return listGroupRules(ctx, netClient, secGroupID)
+}
+
+// Register the SecurityGroupRuleSet resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeSecurityGroupRuleSet,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &SecurityGroupRuleSet{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create reconciles the group's rules with the set
+func (s *SecurityGroupRuleSet) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	secGroupID, desired, err := ruleSetCreateOpts(props)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	ruleList, err := reconcileRuleSet(ctx, netClient, secGroupID, desired)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRuleSet, resources.MapOpenStackErrorToOperationErrorCode(err), "", err.Error()),
+		}, nil
+	}
+
+	nativeID := resources.RegionalNativeID(region, secGroupID)
+	ruleOwners.claim(nativeID, ruleOwner(ResourceTypeSecurityGroupRuleSet, request.Label))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(ruleSetToProperties(secGroupID, ruleList), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read returns every rule of the security group
+func (s *SecurityGroupRuleSet) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, secGroupID := resources.ParseRegionalNativeID(request.NativeID)
+	if secGroupID == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	ruleList, err := listGroupRules(ctx, netClient, secGroupID)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+	ruleOwners.seen(request.NativeID)
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(ruleSetToProperties(secGroupID, ruleList), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update adds the missing rules and removes the extra ones
+func (s *SecurityGroupRuleSet) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	region, secGroupID := resources.ParseRegionalNativeID(request.NativeID)
+	if secGroupID == "" {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, request.NativeID, "native ID is required"),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	// The security group is fixed by the NativeID
+	props["security_group_id"] = secGroupID
+	_, desired, err := ruleSetCreateOpts(props)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	ruleList, err := reconcileRuleSet(ctx, netClient, secGroupID, desired)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRuleSet, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, err.Error()),
+		}, nil
+	}
+	ruleOwners.claim(request.NativeID, ruleOwner(ResourceTypeSecurityGroupRuleSet, request.Label))
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(ruleSetToProperties(secGroupID, ruleList), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes every rule of the security group. The group itself is kept.
+func (s *SecurityGroupRuleSet) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, secGroupID := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecurityGroupRuleSet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	if _, err := reconcileRuleSet(ctx, netClient, secGroupID, nil); err != nil {
+		// The group is gone, and its rules with it
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSecurityGroupRuleSet, errCode, request.NativeID, err.Error()),
+			}, nil
+		}
+	}
+	ruleOwners.release(request.NativeID)
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (rule sets are synchronous, so not used)
+func (s *SecurityGroupRuleSet) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List is not supported: the rules are discovered individually as SecurityGroupRule resources
+func (s *SecurityGroupRuleSet) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	return &resource.ListResult{}, nil
+}
@@ -0,0 +1,151 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleSetCreateOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		props   map[string]interface{}
+		want    []rules.CreateOpts
+		wantErr string
+	}{
+		{
+			name: "rules inherit the group",
+			props: map[string]interface{}{
+				"security_group_id": "sg-1",
+				"rules": []interface{}{
+					map[string]interface{}{"direction": "ingress", "ethertype": "IPv4", "protocol": "tcp", "port_range_min": float64(22), "port_range_max": float64(22)},
+					map[string]interface{}{"direction": "egress", "ethertype": "IPv6"},
+				},
+			},
+			want: []rules.CreateOpts{
+				{SecGroupID: "sg-1", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22},
+				{SecGroupID: "sg-1", Direction: "egress", EtherType: "IPv6"},
+			},
+		},
+		{
+			name:  "empty set",
+			props: map[string]interface{}{"security_group_id": "sg-1"},
+			want:  []rules.CreateOpts{},
+		},
+		{
+			name:    "missing group",
+			props:   map[string]interface{}{"rules": []interface{}{}},
+			wantErr: "security_group_id is required",
+		},
+		{
+			name:    "rule not an object",
+			props:   map[string]interface{}{"security_group_id": "sg-1", "rules": []interface{}{"ingress"}},
+			wantErr: "rules[0] must be an object",
+		},
+		{
+			name: "invalid rule",
+			props: map[string]interface{}{
+				"security_group_id": "sg-1",
+				"rules":             []interface{}{map[string]interface{}{"ethertype": "IPv4"}},
+			},
+			wantErr: "rules[0]: direction is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secGroupID, opts, err := ruleSetCreateOpts(tt.props)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "sg-1", secGroupID)
+			assert.Equal(t, tt.want, opts)
+		})
+	}
+}
+
+func TestDiffRuleSet(t *testing.T) {
+	ssh := rules.CreateOpts{SecGroupID: "sg-1", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22}
+	https := rules.CreateOpts{SecGroupID: "sg-1", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 443, PortRangeMax: 443}
+	existingSSH := rules.SecGroupRule{ID: "r-ssh", SecGroupID: "sg-1", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, Description: "old"}
+	defaultEgress := rules.SecGroupRule{ID: "r-egress", SecGroupID: "sg-1", Direction: "egress", EtherType: "IPv4"}
+
+	tests := []struct {
+		name       string
+		desired    []rules.CreateOpts
+		existing   []rules.SecGroupRule
+		wantCreate []rules.CreateOpts
+		wantDelete []string
+	}{
+		{name: "in sync", desired: []rules.CreateOpts{ssh}, existing: []rules.SecGroupRule{existingSSH}},
+		{name: "missing rule", desired: []rules.CreateOpts{ssh, https}, existing: []rules.SecGroupRule{existingSSH}, wantCreate: []rules.CreateOpts{https}},
+		{name: "extra rule", desired: []rules.CreateOpts{ssh}, existing: []rules.SecGroupRule{existingSSH, defaultEgress}, wantDelete: []string{"r-egress"}},
+		{name: "duplicate desired", desired: []rules.CreateOpts{ssh, ssh}, existing: []rules.SecGroupRule{existingSSH}, wantCreate: []rules.CreateOpts{ssh}},
+		{name: "empty set", existing: []rules.SecGroupRule{existingSSH}, wantDelete: []string{"r-ssh"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toCreate, toDelete := diffRuleSet(tt.desired, tt.existing)
+			assert.Equal(t, tt.wantCreate, toCreate)
+
+			var deleted []string
+			for _, rule := range toDelete {
+				deleted = append(deleted, rule.ID)
+			}
+			assert.Equal(t, tt.wantDelete, deleted)
+		})
+	}
+}
+
+func TestReconcileRuleSet_RollsBackCreatedRules(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET":
+			fmt.Fprint(w, `{"security_group_rules": []}`)
+		case r.Method == "POST" && len(calls) == 2:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"security_group_rule": {"id": "r-1", "security_group_id": "sg-1", "direction": "ingress", "ethertype": "IPv4"}}`)
+		case r.Method == "POST":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"NeutronError": {"message": "invalid rule"}}`)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+	desired := []rules.CreateOpts{
+		{SecGroupID: "sg-1", Direction: "ingress", EtherType: "IPv4"},
+		{SecGroupID: "sg-1", Direction: "ingress", EtherType: "IPv6", Protocol: "bogus"},
+	}
+
+	_, err := reconcileRuleSet(context.Background(), client, "sg-1", desired)
+	require.Error(t, err)
+
+	assert.Equal(t, []string{
+		"GET /security-group-rules",
+		"POST /security-group-rules",
+		"POST /security-group-rules",
+		"DELETE /security-group-rules/r-1",
+	}, calls)
+}
@@ -7,10 +7,14 @@ package network
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/v2"
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/gophercloud/gophercloud/v2/pagination"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
 	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
@@ -30,6 +34,11 @@ type Subnet struct {
 
 // subnetToProperties converts an OpenStack subnet to a properties map.
 // This is used by Create, Read, Update, and List to ensure consistent property marshaling.
+//
+// The inclusion rules are fixed so re-reading an unchanged subnet yields the
+// same properties: list properties (dns_nameservers, allocation_pools,
+// host_routes) are always present, empty when unset, and the gateway is
+// reported either as gateway_ip or as no_gateway, never both.
 func subnetToProperties(subnet *subnets.Subnet) map[string]interface{} {
 	props := map[string]interface{}{
 		"id":          subnet.ID,
@@ -37,27 +46,56 @@ func subnetToProperties(subnet *subnets.Subnet) map[string]interface{} {
 		"name":        subnet.Name,
 		"cidr":        subnet.CIDR,
 		"ip_version":  subnet.IPVersion,
-		"gateway_ip":  subnet.GatewayIP,
 		"enable_dhcp": subnet.EnableDHCP,
 	}
 
+	// Subnets allocated from a pool report the pool they came from
+	if subnet.SubnetPoolID != "" {
+		props["subnetpool_id"] = subnet.SubnetPoolID
+	}
+
+	if subnet.IPv6RAMode != "" {
+		props["ipv6_ra_mode"] = subnet.IPv6RAMode
+	}
+	if subnet.IPv6AddressMode != "" {
+		props["ipv6_address_mode"] = subnet.IPv6AddressMode
+	}
+
+	// A subnet without gateway reports no_gateway so it round-trips
+	if subnet.GatewayIP != "" {
+		props["gateway_ip"] = subnet.GatewayIP
+	} else {
+		props["no_gateway"] = true
+	}
+
 	// Add description if present
 	if subnet.Description != "" {
 		props["description"] = subnet.Description
 	}
 
-	// Always include dns_nameservers - if OpenStack returns empty/nil, include empty array
-	// This ensures the property exists for comparison with expected state
-	if subnet.DNSNameservers != nil {
-		props["dns_nameservers"] = subnet.DNSNameservers
-	} else {
-		props["dns_nameservers"] = []string{}
+	nameservers := subnet.DNSNameservers
+	if nameservers == nil {
+		nameservers = []string{}
+	}
+	props["dns_nameservers"] = nameservers
+
+	pools := make([]map[string]interface{}, 0, len(subnet.AllocationPools))
+	for _, pool := range subnet.AllocationPools {
+		pools = append(pools, map[string]interface{}{
+			"start": pool.Start,
+			"end":   pool.End,
+		})
 	}
+	props["allocation_pools"] = pools
 
-	// Include allocation_pools if present
-	if len(subnet.AllocationPools) > 0 {
-		props["allocation_pools"] = subnet.AllocationPools
+	routes := make([]map[string]interface{}, 0, len(subnet.HostRoutes))
+	for _, route := range subnet.HostRoutes {
+		routes = append(routes, map[string]interface{}{
+			"destination": route.DestinationCIDR,
+			"nexthop":     route.NextHop,
+		})
 	}
+	props["host_routes"] = routes
 
 	// Add tags if present
 	if len(subnet.Tags) > 0 {
@@ -67,6 +105,180 @@ func subnetToProperties(subnet *subnets.Subnet) map[string]interface{} {
 	return props
 }
 
+// ipv6Modes are the values of ipv6_ra_mode and ipv6_address_mode
+var ipv6Modes = map[string]bool{
+	"slaac":            true,
+	"dhcpv6-stateful":  true,
+	"dhcpv6-stateless": true,
+}
+
+// subnetIPv6Modes returns the ipv6_ra_mode and ipv6_address_mode options.
+// They only apply to IPv6 subnets, and when both are set Neutron requires
+// them to match.
+func subnetIPv6Modes(props map[string]interface{}, ipVersion gophercloud.IPVersion) (raMode, addressMode string, err error) {
+	raMode, _ = props["ipv6_ra_mode"].(string)
+	addressMode, _ = props["ipv6_address_mode"].(string)
+	if raMode == "" && addressMode == "" {
+		return "", "", nil
+	}
+	if ipVersion != gophercloud.IPv6 {
+		return "", "", fmt.Errorf("ipv6_ra_mode and ipv6_address_mode require ip_version 6")
+	}
+	for property, mode := range map[string]string{"ipv6_ra_mode": raMode, "ipv6_address_mode": addressMode} {
+		if mode != "" && !ipv6Modes[mode] {
+			return "", "", fmt.Errorf("%s must be slaac, dhcpv6-stateful or dhcpv6-stateless, got %q", property, mode)
+		}
+	}
+	if raMode != "" && addressMode != "" && raMode != addressMode {
+		return "", "", fmt.Errorf("ipv6_ra_mode %q and ipv6_address_mode %q must match", raMode, addressMode)
+	}
+	return raMode, addressMode, nil
+}
+
+// subnetGatewayIP returns the gateway_ip option for create and update.
+// nil leaves the gateway to OpenStack's default; an empty string, which
+// gophercloud sends as null, creates or turns the subnet gatewayless.
+func subnetGatewayIP(props map[string]interface{}) (*string, error) {
+	gatewayIP, hasGateway := props["gateway_ip"].(string)
+	if noGateway, _ := props["no_gateway"].(bool); noGateway {
+		if gatewayIP != "" {
+			return nil, fmt.Errorf("gateway_ip %q cannot be set together with no_gateway", gatewayIP)
+		}
+		disabled := ""
+		return &disabled, nil
+	}
+	if hasGateway {
+		return &gatewayIP, nil
+	}
+	return nil, nil
+}
+
+// parseHostRoutes converts host_routes properties to gophercloud host routes.
+// Entries missing a destination or nexthop are skipped.
+func parseHostRoutes(v []interface{}) []subnets.HostRoute {
+	routes := make([]subnets.HostRoute, 0, len(v))
+	for _, route := range v {
+		if routeMap, ok := route.(map[string]interface{}); ok {
+			destination, destOk := routeMap["destination"].(string)
+			nexthop, nexthopOk := routeMap["nexthop"].(string)
+			if destOk && nexthopOk {
+				routes = append(routes, subnets.HostRoute{
+					DestinationCIDR: destination,
+					NextHop:         nexthop,
+				})
+			}
+		}
+	}
+	return routes
+}
+
+// parseAllocationPools converts the allocation_pools property to Neutron
+// allocation pools, skipping entries without a start and end
+func parseAllocationPools(v []interface{}) []subnets.AllocationPool {
+	pools := make([]subnets.AllocationPool, 0, len(v))
+	for _, pool := range v {
+		poolMap, ok := pool.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		start, startOk := poolMap["start"].(string)
+		end, endOk := poolMap["end"].(string)
+		if startOk && endOk {
+			pools = append(pools, subnets.AllocationPool{Start: start, End: end})
+		}
+	}
+	return pools
+}
+
+// checkAllocationPools reports whether pools differ from the subnet's
+// current pools and, if so, the addresses already allocated from the current
+// pools that would fall outside every new pool. Addresses outside the
+// current pools, such as the gateway or fixed IPs set by hand, are not
+// checked.
+func (s *Subnet) checkAllocationPools(ctx context.Context, netClient *gophercloud.ServiceClient, id string, pools []subnets.AllocationPool) (changed bool, stranded []string, err error) {
+	current, err := subnets.Get(ctx, netClient, id).Extract()
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get subnet: %w", err)
+	}
+	if sameAllocationPools(current.AllocationPools, pools) {
+		return false, nil, nil
+	}
+
+	var allocated []string
+	listOpts := ports.ListOpts{FixedIPs: []ports.FixedIPOpts{{SubnetID: id}}}
+	err = ports.List(netClient, listOpts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		list, err := ports.ExtractPorts(page)
+		if err != nil {
+			return false, err
+		}
+		for _, port := range list {
+			for _, ip := range port.FixedIPs {
+				if ip.SubnetID == id {
+					allocated = append(allocated, ip.IPAddress)
+				}
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to list ports on subnet: %w", err)
+	}
+	return true, strandedAddresses(allocated, current.AllocationPools, pools), nil
+}
+
+// sameAllocationPools reports whether a and b hold the same pools in any order
+func sameAllocationPools(a, b []subnets.AllocationPool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[subnets.AllocationPool]int, len(a))
+	for _, pool := range a {
+		seen[pool]++
+	}
+	for _, pool := range b {
+		if seen[pool] == 0 {
+			return false
+		}
+		seen[pool]--
+	}
+	return true
+}
+
+// strandedAddresses returns, sorted, the allocated addresses inside the
+// current pools but outside every desired pool
+func strandedAddresses(allocated []string, current, desired []subnets.AllocationPool) []string {
+	var stranded []string
+	for _, address := range allocated {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			continue
+		}
+		if inAllocationPools(addr, current) && !inAllocationPools(addr, desired) {
+			stranded = append(stranded, address)
+		}
+	}
+	sort.Strings(stranded)
+	return stranded
+}
+
+// inAllocationPools reports whether addr falls within one of pools
+func inAllocationPools(addr netip.Addr, pools []subnets.AllocationPool) bool {
+	for _, pool := range pools {
+		start, err := netip.ParseAddr(pool.Start)
+		if err != nil {
+			continue
+		}
+		end, err := netip.ParseAddr(pool.End)
+		if err != nil {
+			continue
+		}
+		if start.BitLen() == addr.BitLen() && start.Compare(addr) <= 0 && addr.Compare(end) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Register the Subnet resource type
 func init() {
 	registry.RegisterOpenStack(
@@ -77,6 +289,7 @@ func init() {
 			resource.OperationUpdate,
 			resource.OperationDelete,
 			resource.OperationList,
+			resource.OperationCheckStatus,
 		},
 		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
 			return &Subnet{
@@ -97,7 +310,17 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 		}, nil
 	}
 
-	// Build create options - NetworkID and CIDR are required
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// Build create options - NetworkID is required, and either CIDR or a
+	// subnet pool to allocate the CIDR from
 	networkID, ok := props["network_id"].(string)
 	if !ok || networkID == "" {
 		return &resource.CreateResult{
@@ -105,16 +328,23 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 		}, nil
 	}
 
-	cidr, ok := props["cidr"].(string)
-	if !ok || cidr == "" {
+	cidr, _ := props["cidr"].(string)
+	subnetPoolID, _ := props["subnetpool_id"].(string)
+	if cidr == "" && subnetPoolID == "" {
 		return &resource.CreateResult{
-			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, "", "cidr is required"),
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, "", "cidr or subnetpool_id is required"),
 		}, nil
 	}
 
 	createOpts := subnets.CreateOpts{
-		NetworkID: networkID,
-		CIDR:      cidr,
+		NetworkID:    networkID,
+		CIDR:         cidr,
+		SubnetPoolID: subnetPoolID,
+	}
+
+	// Add optional prefixlen; without it the pool's default_prefixlen is used
+	if prefixlen, ok := props["prefixlen"].(float64); ok && subnetPoolID != "" {
+		createOpts.Prefixlen = int(prefixlen)
 	}
 
 	// Add optional name
@@ -140,10 +370,24 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 		createOpts.IPVersion = gophercloud.IPv4
 	}
 
-	// Add optional gateway_ip
-	if gatewayIP, ok := props["gateway_ip"].(string); ok {
-		createOpts.GatewayIP = &gatewayIP
+	// Add optional IPv6 router advertisement and address modes
+	raMode, addressMode, err := subnetIPv6Modes(props, createOpts.IPVersion)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+	createOpts.IPv6RAMode = raMode
+	createOpts.IPv6AddressMode = addressMode
+
+	// Add optional gateway_ip; no_gateway disables it
+	gatewayIP, err := subnetGatewayIP(props)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
 	}
+	createOpts.GatewayIP = gatewayIP
 
 	// Add optional enable_dhcp (defaults to true if not specified)
 	if enableDHCP, ok := props["enable_dhcp"].(bool); ok {
@@ -163,26 +407,20 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 
 	// Add optional allocation_pools
 	if pools, ok := props["allocation_pools"].([]interface{}); ok {
-		allocationPools := make([]subnets.AllocationPool, 0, len(pools))
-		for _, pool := range pools {
-			if poolMap, ok := pool.(map[string]interface{}); ok {
-				start, startOk := poolMap["start"].(string)
-				end, endOk := poolMap["end"].(string)
-				if startOk && endOk {
-					allocationPools = append(allocationPools, subnets.AllocationPool{
-						Start: start,
-						End:   end,
-					})
-				}
-			}
-		}
-		if len(allocationPools) > 0 {
+		if allocationPools := parseAllocationPools(pools); len(allocationPools) > 0 {
 			createOpts.AllocationPools = allocationPools
 		}
 	}
 
+	// Add optional host_routes
+	if routes, ok := props["host_routes"].([]interface{}); ok {
+		if hostRoutes := parseHostRoutes(routes); len(hostRoutes) > 0 {
+			createOpts.HostRoutes = hostRoutes
+		}
+	}
+
 	// Create the subnet via OpenStack
-	subnet, err := subnets.Create(ctx, s.Client.NetworkClient, createOpts).Extract()
+	subnet, err := subnets.Create(ctx, netClient, createOpts).Extract()
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -196,26 +434,20 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 
 	// Set tags if provided (must be done after creation via attributestags API)
 	tags := resources.ParseTags(props["tags"])
-	if len(tags) > 0 {
-		_, err = attributestags.ReplaceAll(ctx, s.Client.NetworkClient, "subnets", subnet.ID, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - subnet was created successfully
-			fmt.Printf("warning: failed to set tags on subnet %s: %v\n", subnet.ID, err)
-		} else {
-			subnet.Tags = tags
+	if len(tags) > 0 && tagsSupported(ctx, s.Client, netClient) {
+		if set, ok := replaceTags(ctx, netClient, "subnets", "subnet", subnet.ID, tags); ok {
+			subnet.Tags = set
 		}
 	}
 
 	// Convert subnet to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(subnetToProperties(subnet))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(subnetToProperties(subnet), region))
 	if err != nil {
 		return &resource.CreateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationCreate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        subnet.ID,
+				NativeID:        resources.RegionalNativeID(region, subnet.ID),
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -227,7 +459,7 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationCreate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           subnet.ID,
+			NativeID:           resources.RegionalNativeID(region, subnet.ID),
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -236,32 +468,36 @@ func (s *Subnet) Create(ctx context.Context, request *resource.CreateRequest) (*
 // Read retrieves the current state of a subnet
 func (s *Subnet) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
 	// Get the subnet ID from NativeID
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
 	if id == "" {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeInvalidRequest,
 		}, nil // Don't return Go error for expected errors
 	}
 
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
 	// Get the subnet from OpenStack
-	subnet, err := subnets.Get(ctx, s.Client.NetworkClient, id).Extract()
+	subnet, err := subnets.Get(ctx, netClient, id).Extract()
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
 		}, nil // Don't return Go error for expected errors like NotFound
 	}
 
-	// Explicitly fetch tags - OpenStack often doesn't include them in the standard GET response
-	tags, err := attributestags.List(ctx, s.Client.NetworkClient, "subnets", id).Extract()
-	if err != nil {
-		// Log warning but continue - tags are optional
-		fmt.Printf("warning: failed to fetch tags for subnet %s: %v\n", id, err)
-	} else {
-		subnet.Tags = tags
+	if tagsSupported(ctx, s.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "subnets", "subnet", id); ok {
+			subnet.Tags = tags
+		}
 	}
 
 	// Convert subnet to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(subnetToProperties(subnet))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(subnetToProperties(subnet), region))
 	if err != nil {
 		return &resource.ReadResult{
 			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
@@ -282,7 +518,14 @@ func (s *Subnet) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Parse request properties
 	props, err := resources.ParseProperties(request.DesiredProperties)
@@ -304,8 +547,14 @@ func (s *Subnet) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		updateOpts.Description = &description
 	}
 
-	if gatewayIP, ok := props["gateway_ip"].(string); ok {
-		updateOpts.GatewayIP = &gatewayIP
+	gatewayIP, err := subnetGatewayIP(props)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+	if gatewayIP != nil {
+		updateOpts.GatewayIP = gatewayIP
 	}
 
 	if enableDHCP, ok := props["enable_dhcp"].(bool); ok {
@@ -322,8 +571,36 @@ func (s *Subnet) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		updateOpts.DNSNameservers = &nameservers
 	}
 
+	// An empty list clears the routes
+	if routes, ok := props["host_routes"].([]interface{}); ok {
+		hostRoutes := parseHostRoutes(routes)
+		updateOpts.HostRoutes = &hostRoutes
+	}
+
+	// Pools are resized in place. An empty list is ignored rather than
+	// leaving the subnet with no address to allocate.
+	if pools, ok := props["allocation_pools"].([]interface{}); ok {
+		if allocationPools := parseAllocationPools(pools); len(allocationPools) > 0 {
+			changed, stranded, err := s.checkAllocationPools(ctx, netClient, id, allocationPools)
+			if err != nil {
+				return &resource.UpdateResult{
+					ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnet, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, err.Error()),
+				}, nil
+			}
+			if len(stranded) > 0 {
+				return &resource.UpdateResult{
+					ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, request.NativeID,
+						fmt.Sprintf("allocation_pools would leave allocated addresses outside every pool: %s; release them or keep them in a pool", strings.Join(stranded, ", "))),
+				}, nil
+			}
+			if changed {
+				updateOpts.AllocationPools = allocationPools
+			}
+		}
+	}
+
 	// Update the subnet via OpenStack
-	subnet, err := subnets.Update(ctx, s.Client.NetworkClient, id, updateOpts).Extract()
+	subnet, err := subnets.Update(ctx, netClient, id, updateOpts).Extract()
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
@@ -335,31 +612,25 @@ func (s *Subnet) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		}, nil
 	}
 
-	// Update tags if provided (via attributestags API)
-	if _, hasTags := props["tags"]; hasTags {
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, s.Client, netClient) {
 		tags := resources.ParseTags(props["tags"])
 		if tags == nil {
-			tags = []string{} // Empty slice to clear all tags
+			tags = []string{} // Empty slice clears tags removed from the desired state
 		}
-		updatedTags, err := attributestags.ReplaceAll(ctx, s.Client.NetworkClient, "subnets", id, attributestags.ReplaceAllOpts{
-			Tags: tags,
-		}).Extract()
-		if err != nil {
-			// Log warning but don't fail - subnet was updated successfully
-			fmt.Printf("warning: failed to update tags on subnet %s: %v\n", id, err)
-		} else {
-			subnet.Tags = updatedTags
+		if set, ok := replaceTags(ctx, netClient, "subnets", "subnet", id, tags); ok {
+			subnet.Tags = set
 		}
 	}
 
 	// Convert subnet to properties and marshal to JSON
-	propsJSON, err := resources.MarshalProperties(subnetToProperties(subnet))
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(subnetToProperties(subnet), region))
 	if err != nil {
 		return &resource.UpdateResult{
 			ProgressResult: &resource.ProgressResult{
 				Operation:       resource.OperationUpdate,
 				OperationStatus: resource.OperationStatusFailure,
-				NativeID:        subnet.ID,
+				NativeID:        request.NativeID,
 				ErrorCode:       resource.OperationErrorCodeGeneralServiceException,
 				StatusMessage:   fmt.Sprintf("failed to marshal properties: %v", err),
 			},
@@ -371,7 +642,7 @@ func (s *Subnet) Update(ctx context.Context, request *resource.UpdateRequest) (*
 		ProgressResult: &resource.ProgressResult{
 			Operation:          resource.OperationUpdate,
 			OperationStatus:    resource.OperationStatusSuccess,
-			NativeID:           subnet.ID,
+			NativeID:           request.NativeID,
 			ResourceProperties: []byte(propsJSON),
 		},
 	}, nil
@@ -386,10 +657,17 @@ func (s *Subnet) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 		}, nil
 	}
 
-	id := request.NativeID
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
 
 	// Delete the subnet from OpenStack
-	err := subnets.Delete(ctx, s.Client.NetworkClient, id).ExtractErr()
+	err = subnets.Delete(ctx, netClient, id).ExtractErr()
 	if err != nil {
 		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
 		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
@@ -399,7 +677,7 @@ func (s *Subnet) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 				ProgressResult: &resource.ProgressResult{
 					Operation:       resource.OperationDelete,
 					OperationStatus: resource.OperationStatusSuccess,
-					NativeID:        id,
+					NativeID:        request.NativeID,
 				},
 			}, nil
 		}
@@ -410,30 +688,47 @@ func (s *Subnet) Delete(ctx context.Context, request *resource.DeleteRequest) (*
 				Operation:       resource.OperationDelete,
 				OperationStatus: resource.OperationStatusFailure,
 				ErrorCode:       errCode,
-				StatusMessage:   fmt.Sprintf("failed to delete subnet: %v", err),
+				StatusMessage:   fmt.Sprintf("failed to delete subnet: %s", resources.OpenStackErrorMessage(err)),
 			},
 		}, nil
 	}
 
-	// Return success
+	// The subnet may linger while still referenced; wait until it is gone
+	_, err = subnets.Get(ctx, netClient, id).Extract()
 	return &resource.DeleteResult{
-		ProgressResult: &resource.ProgressResult{
-			Operation:       resource.OperationDelete,
-			OperationStatus: resource.OperationStatusSuccess,
-			NativeID:        id,
-		},
+		ProgressResult: resources.DeletionProgress(resource.OperationDelete, ResourceTypeSubnet, request.NativeID, err),
 	}, nil
 }
 
-// Status checks the status of a long-running operation (subnets are synchronous, so not used)
+// Status waits for a deleted subnet to disappear; create and update are synchronous
 func (s *Subnet) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
-	return nil, fmt.Errorf("not implemented")
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.StatusResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCheckStatus, ResourceTypeSubnet, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	_, err = subnets.Get(ctx, netClient, id).Extract()
+	result := resources.DeletionProgress(resource.OperationCheckStatus, ResourceTypeSubnet, request.NativeID, err)
+	result.RequestID = request.RequestID
+	return &resource.StatusResult{
+		ProgressResult: result,
+	}, nil
 }
 
 // List discovers subnets
 func (s *Subnet) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
 	// List all subnets using pagination
-	allPages, err := subnets.List(s.Client.NetworkClient, subnets.ListOpts{}).AllPages(ctx)
+	allPages, err := subnets.List(s.Client.NetworkClient, subnets.ListOpts{Tags: s.Config.ManagedByTag}).AllPages(ctx)
 	if err != nil {
 		return &resource.ListResult{}, fmt.Errorf("failed to list subnets: %w", err)
 	}
@@ -454,3 +749,9 @@ func (s *Subnet) List(ctx context.Context, request *resource.ListRequest) (*reso
 		NativeIDs: nativeIDs,
 	}, nil
 }
+
+// ResolveName returns the IDs of the subnets called name
+func (s *Subnet) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	return idsByName(ctx, subnets.List(s.Client.NetworkClient, subnets.ListOpts{Name: name}), "subnets", subnets.ExtractSubnets,
+		func(subnet subnets.Subnet) string { return subnet.ID })
+}
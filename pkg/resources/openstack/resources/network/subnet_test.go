@@ -0,0 +1,262 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeNeutron serves extension, subnet create, get and tag calls, storing
+// the created subnet the way Neutron echoes it back
+func newFakeNeutron(t *testing.T) *gophercloud.ServiceClient {
+	t.Helper()
+	var subnet map[string]interface{}
+	tags := []interface{}{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/extensions":
+			json.NewEncoder(w).Encode(map[string]interface{}{"extensions": []interface{}{
+				map[string]interface{}{"alias": "standard-attr-tag"},
+			}})
+		case r.Method == "POST" && r.URL.Path == "/subnets":
+			var body struct {
+				Subnet map[string]interface{} `json:"subnet"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			subnet = body.Subnet
+			subnet["id"] = "sub-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"subnet": subnet})
+		case r.Method == "PUT" && r.URL.Path == "/subnets/sub-1/tags":
+			var body struct {
+				Tags []interface{} `json:"tags"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			tags = body.Tags
+			json.NewEncoder(w).Encode(map[string]interface{}{"tags": tags})
+		case r.Method == "GET" && r.URL.Path == "/subnets/sub-1/tags":
+			json.NewEncoder(w).Encode(map[string]interface{}{"tags": tags})
+		case r.Method == "GET" && r.URL.Path == "/subnets/sub-1":
+			json.NewEncoder(w).Encode(map[string]interface{}{"subnet": subnet})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+}
+
+func TestSubnet_CreateThenReadHasNoDrift(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired string
+	}{
+		{
+			name: "fully specified",
+			desired: `{
+				"name": "app",
+				"description": "application tier",
+				"network_id": "net-1",
+				"cidr": "10.0.0.0/24",
+				"ip_version": 4,
+				"gateway_ip": "10.0.0.1",
+				"enable_dhcp": true,
+				"dns_nameservers": ["213.186.33.99"],
+				"allocation_pools": [{"start": "10.0.0.10", "end": "10.0.0.200"}],
+				"host_routes": [{"destination": "10.1.0.0/16", "nexthop": "10.0.0.254"}],
+				"tags": ["app"]
+			}`,
+		},
+		{
+			name: "gatewayless",
+			desired: `{
+				"name": "isolated",
+				"network_id": "net-1",
+				"cidr": "10.0.1.0/24",
+				"ip_version": 4,
+				"no_gateway": true,
+				"enable_dhcp": false,
+				"dns_nameservers": [],
+				"allocation_pools": [{"start": "10.0.1.2", "end": "10.0.1.254"}],
+				"host_routes": []
+			}`,
+		},
+		{
+			name: "ipv6 slaac",
+			desired: `{
+				"name": "v6",
+				"network_id": "net-1",
+				"cidr": "2001:db8::/64",
+				"ip_version": 6,
+				"ipv6_ra_mode": "slaac",
+				"ipv6_address_mode": "slaac",
+				"gateway_ip": "2001:db8::1",
+				"enable_dhcp": true,
+				"dns_nameservers": [],
+				"allocation_pools": [{"start": "2001:db8::2", "end": "2001:db8::ffff:ffff:ffff:ffff"}],
+				"host_routes": []
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subnet{
+				Client: &openstack.Client{NetworkClient: newFakeNeutron(t)},
+				Config: &openstack.Config{},
+			}
+			ctx := context.Background()
+
+			created, err := s.Create(ctx, &resource.CreateRequest{
+				ResourceType: ResourceTypeSubnet,
+				Properties:   json.RawMessage(tt.desired),
+			})
+			require.NoError(t, err)
+			require.Equal(t, resource.OperationStatusSuccess, created.ProgressResult.OperationStatus, created.ProgressResult.StatusMessage)
+
+			read, err := s.Read(ctx, &resource.ReadRequest{NativeID: created.ProgressResult.NativeID})
+			require.NoError(t, err)
+			require.Empty(t, read.ErrorCode)
+
+			var desired, createdProps, readProps map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.desired), &desired))
+			require.NoError(t, json.Unmarshal(created.ProgressResult.ResourceProperties, &createdProps))
+			require.NoError(t, json.Unmarshal([]byte(read.Properties), &readProps))
+
+			for key, want := range desired {
+				assert.Equal(t, want, readProps[key], "property %s drifted", key)
+			}
+			assert.Equal(t, createdProps, readProps, "Create and Read report different properties")
+
+			if _, ok := desired["no_gateway"]; ok {
+				assert.NotContains(t, readProps, "gateway_ip")
+			} else {
+				assert.NotContains(t, readProps, "no_gateway")
+			}
+		})
+	}
+}
+
+func TestSubnetIPv6Modes(t *testing.T) {
+	raMode, addressMode, err := subnetIPv6Modes(map[string]interface{}{"ipv6_address_mode": "dhcpv6-stateless"}, gophercloud.IPv6)
+	require.NoError(t, err)
+	assert.Empty(t, raMode)
+	assert.Equal(t, "dhcpv6-stateless", addressMode)
+
+	_, _, err = subnetIPv6Modes(map[string]interface{}{"ipv6_ra_mode": "slaac"}, gophercloud.IPv4)
+	assert.ErrorContains(t, err, "ip_version 6")
+
+	_, _, err = subnetIPv6Modes(map[string]interface{}{"ipv6_ra_mode": "radvd"}, gophercloud.IPv6)
+	assert.ErrorContains(t, err, "ipv6_ra_mode must be")
+
+	_, _, err = subnetIPv6Modes(map[string]interface{}{"ipv6_ra_mode": "slaac", "ipv6_address_mode": "dhcpv6-stateful"}, gophercloud.IPv6)
+	assert.ErrorContains(t, err, "must match")
+}
+
+func TestSubnet_UpdateAllocationPools(t *testing.T) {
+	subnet := map[string]interface{}{
+		"id":               "sub-1",
+		"network_id":       "net-1",
+		"cidr":             "10.0.0.0/24",
+		"ip_version":       4,
+		"gateway_ip":       "10.0.0.1",
+		"allocation_pools": []interface{}{map[string]interface{}{"start": "10.0.0.10", "end": "10.0.0.100"}},
+	}
+	var updates []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/extensions":
+			json.NewEncoder(w).Encode(map[string]interface{}{"extensions": []interface{}{}})
+		case r.Method == "GET" && r.URL.Path == "/subnets/sub-1":
+			json.NewEncoder(w).Encode(map[string]interface{}{"subnet": subnet})
+		case r.Method == "GET" && r.URL.Path == "/ports":
+			assert.Equal(t, "subnet_id=sub-1", r.URL.Query().Get("fixed_ips"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"ports": []interface{}{
+				map[string]interface{}{"id": "router-port", "fixed_ips": []interface{}{
+					map[string]interface{}{"subnet_id": "sub-1", "ip_address": "10.0.0.1"},
+				}},
+				map[string]interface{}{"id": "vm-port", "fixed_ips": []interface{}{
+					map[string]interface{}{"subnet_id": "sub-1", "ip_address": "10.0.0.50"},
+				}},
+			}})
+		case r.Method == "PUT" && r.URL.Path == "/subnets/sub-1":
+			var body struct {
+				Subnet map[string]interface{} `json:"subnet"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			updates = append(updates, body.Subnet)
+			for k, v := range body.Subnet {
+				subnet[k] = v
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"subnet": subnet})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	s := &Subnet{
+		Client: &openstack.Client{NetworkClient: &gophercloud.ServiceClient{
+			ProviderClient: &gophercloud.ProviderClient{},
+			Endpoint:       server.URL + "/",
+		}},
+		Config: &openstack.Config{},
+	}
+	update := func(pools string) *resource.ProgressResult {
+		result, err := s.Update(context.Background(), &resource.UpdateRequest{
+			NativeID:          "sub-1",
+			DesiredProperties: json.RawMessage(`{"name": "app", "allocation_pools": ` + pools + `}`),
+		})
+		require.NoError(t, err)
+		return result.ProgressResult
+	}
+
+	// Shrinking the pool past an allocated address is rejected before any change
+	result := update(`[{"start": "10.0.0.60", "end": "10.0.0.200"}]`)
+	assert.Equal(t, resource.OperationErrorCodeInvalidRequest, result.ErrorCode)
+	assert.Contains(t, result.StatusMessage, "outside every pool: 10.0.0.50;")
+	assert.Empty(t, updates)
+
+	// Expanding it keeps every allocated address in a pool
+	result = update(`[{"start": "10.0.0.10", "end": "10.0.0.200"}]`)
+	require.Equal(t, resource.OperationStatusSuccess, result.OperationStatus, result.StatusMessage)
+	require.Len(t, updates, 1)
+	assert.Equal(t, []interface{}{map[string]interface{}{"start": "10.0.0.10", "end": "10.0.0.200"}}, updates[0]["allocation_pools"])
+
+	// Unchanged pools are not sent again
+	update(`[{"start": "10.0.0.10", "end": "10.0.0.200"}]`)
+	require.Len(t, updates, 2)
+	assert.NotContains(t, updates[1], "allocation_pools")
+}
+
+func TestStrandedAddresses(t *testing.T) {
+	current := []subnets.AllocationPool{{Start: "10.0.0.10", End: "10.0.0.100"}}
+	desired := []subnets.AllocationPool{{Start: "10.0.0.10", End: "10.0.0.40"}, {Start: "10.0.0.90", End: "10.0.0.120"}}
+
+	assert.Equal(t, []string{"10.0.0.50", "10.0.0.60"},
+		strandedAddresses([]string{"10.0.0.60", "10.0.0.1", "10.0.0.20", "10.0.0.50", "10.0.0.95", "2001:db8::1"}, current, desired))
+	assert.Empty(t, strandedAddresses([]string{"10.0.0.20"}, current, desired))
+
+	assert.True(t, sameAllocationPools(desired, []subnets.AllocationPool{desired[1], desired[0]}))
+	assert.False(t, sameAllocationPools(current, desired))
+}
@@ -0,0 +1,350 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeSubnetpool = "OVH::Network::Subnetpool"
+)
+
+// Subnetpool provisioner. Subnets created with a subnetpool_id are given a
+// CIDR from the pool's prefixes that does not overlap any other subnet of it.
+type Subnetpool struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// subnetpoolToProperties converts an OpenStack subnet pool to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+func subnetpoolToProperties(pool *subnetpools.SubnetPool) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":                pool.ID,
+		"name":              pool.Name,
+		"prefixes":          pool.Prefixes,
+		"default_prefixlen": pool.DefaultPrefixLen,
+		"min_prefixlen":     pool.MinPrefixLen,
+		"max_prefixlen":     pool.MaxPrefixLen,
+		"ip_version":        pool.IPversion,
+		"shared":            pool.Shared,
+	}
+
+	if pool.Description != "" {
+		props["description"] = pool.Description
+	}
+
+	if pool.AddressScopeID != "" {
+		props["address_scope_id"] = pool.AddressScopeID
+	}
+
+	if len(pool.Tags) > 0 {
+		props["tags"] = pool.Tags
+	}
+
+	return props
+}
+
+// parsePrefixes converts the prefixes property to a list of CIDRs
+func parsePrefixes(v interface{}) []string {
+	items, _ := v.([]interface{})
+	prefixes := make([]string, 0, len(items))
+	for _, item := range items {
+		if prefix, ok := item.(string); ok && prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// Register the Subnetpool resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeSubnetpool,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &Subnetpool{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates a subnet pool
+func (s *Subnetpool) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	name, _ := props["name"].(string)
+	prefixes := parsePrefixes(props["prefixes"])
+	if name == "" || len(prefixes) == 0 {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, "", "name and prefixes are required"),
+		}, nil
+	}
+
+	createOpts := subnetpools.CreateOpts{
+		Name:     name,
+		Prefixes: prefixes,
+	}
+	createOpts.Description, _ = props["description"].(string)
+	createOpts.Shared, _ = props["shared"].(bool)
+	createOpts.AddressScopeID, _ = props["address_scope_id"].(string)
+	if v, ok := props["default_prefixlen"].(float64); ok {
+		createOpts.DefaultPrefixLen = int(v)
+	}
+	if v, ok := props["min_prefixlen"].(float64); ok {
+		createOpts.MinPrefixLen = int(v)
+	}
+	if v, ok := props["max_prefixlen"].(float64); ok {
+		createOpts.MaxPrefixLen = int(v)
+	}
+
+	pool, err := subnetpools.Create(ctx, netClient, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnetpool, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create subnet pool: %v", err)),
+		}, nil
+	}
+
+	// Set tags if provided (must be done after creation via attributestags API)
+	tags := resources.ParseTags(props["tags"])
+	if len(tags) > 0 && tagsSupported(ctx, s.Client, netClient) {
+		if set, ok := replaceTags(ctx, netClient, "subnetpools", "subnet pool", pool.ID, tags); ok {
+			pool.Tags = set
+		}
+	}
+
+	nativeID := resources.RegionalNativeID(region, pool.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(subnetpoolToProperties(pool), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeSubnetpool, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of a subnet pool
+func (s *Subnetpool) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	pool, err := subnetpools.Get(ctx, netClient, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	if tagsSupported(ctx, s.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "subnetpools", "subnet pool", id); ok {
+			pool.Tags = tags
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(subnetpoolToProperties(pool), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update changes the name, prefixes, prefix lengths, address scope and tags
+// of a subnet pool. Neutron only lets prefixes grow: a prefix already handed out to
+// subnets cannot be removed.
+func (s *Subnetpool) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	updateOpts := subnetpools.UpdateOpts{}
+	updateOpts.Name, _ = props["name"].(string)
+	// An omitted description clears it, matching what Read reports
+	description, _ := props["description"].(string)
+	updateOpts.Description = &description
+	updateOpts.Prefixes = parsePrefixes(props["prefixes"])
+	if addressScopeID, ok := props["address_scope_id"].(string); ok && addressScopeID != "" {
+		updateOpts.AddressScopeID = &addressScopeID
+	}
+	if v, ok := props["default_prefixlen"].(float64); ok {
+		updateOpts.DefaultPrefixLen = int(v)
+	}
+	if v, ok := props["min_prefixlen"].(float64); ok {
+		updateOpts.MinPrefixLen = int(v)
+	}
+	if v, ok := props["max_prefixlen"].(float64); ok {
+		updateOpts.MaxPrefixLen = int(v)
+	}
+
+	pool, err := subnetpools.Update(ctx, netClient, id, updateOpts).Extract()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnetpool, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update subnet pool: %v", err)),
+		}, nil
+	}
+
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, s.Client, netClient) {
+		tags := resources.ParseTags(props["tags"])
+		if tags == nil {
+			tags = []string{}
+		}
+		if set, ok := replaceTags(ctx, netClient, "subnetpools", "subnet pool", id, tags); ok {
+			pool.Tags = set
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(subnetpoolToProperties(pool), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeSubnetpool, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes a subnet pool. Neutron refuses while subnets still use it.
+func (s *Subnetpool) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := s.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSubnetpool, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = subnetpools.Delete(ctx, netClient, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeSubnetpool, errCode, request.NativeID, fmt.Sprintf("failed to delete subnet pool: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (subnet pools are synchronous, so not used)
+func (s *Subnetpool) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers subnet pools
+func (s *Subnetpool) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := subnetpools.List(s.Client.NetworkClient, subnetpools.ListOpts{Tags: s.Config.ManagedByTag}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list subnet pools: %w", err)
+	}
+
+	poolList, err := subnetpools.ExtractSubnetPools(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract subnet pools: %w", err)
+	}
+
+	nativeIDs := make([]string, 0, len(poolList))
+	for _, pool := range poolList {
+		nativeIDs = append(nativeIDs, pool.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+)
+
+// Tags are optional. The helpers below set or fetch the tags of a resource
+// of the given kind ("networks", "ports", ...) and, when that fails, warn
+// and report false instead of failing the operation: the resource itself
+// was created, updated or read, and the next update sets its tags again.
+// noun names the resource in the warning.
+
+// replaceTags replaces the tags of a resource and returns the tags now set
+func replaceTags(ctx context.Context, netClient *gophercloud.ServiceClient, kind, noun, id string, tags []string) ([]string, bool) {
+	updated, err := attributestags.ReplaceAll(ctx, netClient, kind, id, attributestags.ReplaceAllOpts{
+		Tags: tags,
+	}).Extract()
+	if err != nil {
+		warnings.Warnf(ctx, "failed to set tags on %s %s: %v", noun, id, err)
+		return nil, false
+	}
+	return updated, true
+}
+
+// readTags fetches the tags of a resource, which Neutron often leaves out of
+// the resource's own GET response
+func readTags(ctx context.Context, netClient *gophercloud.ServiceClient, kind, noun, id string) ([]string, bool) {
+	tags, err := attributestags.List(ctx, netClient, kind, id).Extract()
+	if err != nil {
+		warnings.Warnf(ctx, "failed to fetch tags for %s %s: %v", noun, id, err)
+		return nil, false
+	}
+	return tags, true
+}
@@ -0,0 +1,98 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"sync"
+	"time"
+)
+
+// deletedGroupTTL is how long the rules of a deleted security group are remembered
+const deletedGroupTTL = 15 * time.Minute
+
+// seenRuleTTL is how long a rule is remembered after it was last created,
+// read or updated. The engine reads managed rules far more often than that.
+const seenRuleTTL = time.Hour
+
+// groupTeardown lets rule deletes short-circuit once their security group is
+// gone. Deleting a group removes all of its rules, so a rule managed as its
+// own resource needs no API call of its own afterwards. The engine deletes
+// rules that reference their group before the group, and those still make
+// their call; rules that name the group by a literal ID have no such order
+// and are the ones that can arrive after it.
+type groupTeardown struct {
+	mu sync.Mutex
+	// ruleGroups maps the native ID of each rule this process has seen to the
+	// native ID of its group; rules leave it when deleted or with their group
+	ruleGroups map[string]seenRule
+	// goneRules holds the rules whose group was deleted before them
+	goneRules map[string]time.Time
+	now       func() time.Time
+}
+
+// seenRule is the group of a rule and when the rule was last seen
+type seenRule struct {
+	group string
+	at    time.Time
+}
+
+func newGroupTeardown() *groupTeardown {
+	return &groupTeardown{
+		ruleGroups: make(map[string]seenRule),
+		goneRules:  make(map[string]time.Time),
+		now:        time.Now,
+	}
+}
+
+// groupTeardowns is shared by the SecurityGroup and SecurityGroupRule provisioners
+var groupTeardowns = newGroupTeardown()
+
+// ruleSeen records the group a rule belongs to
+func (t *groupTeardown) ruleSeen(ruleNativeID, groupNativeID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.prune(now)
+	t.ruleGroups[ruleNativeID] = seenRule{group: groupNativeID, at: now}
+}
+
+// groupDeleted records that a group, and with it all its rules, is gone
+func (t *groupTeardown) groupDeleted(groupNativeID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.prune(now)
+	for id, rule := range t.ruleGroups {
+		if rule.group == groupNativeID {
+			delete(t.ruleGroups, id)
+			t.goneRules[id] = now
+		}
+	}
+}
+
+// ruleDeleted forgets a rule and reports whether it went with its group
+func (t *groupTeardown) ruleDeleted(ruleNativeID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.ruleGroups, ruleNativeID)
+	at, ok := t.goneRules[ruleNativeID]
+	delete(t.goneRules, ruleNativeID)
+	return ok && t.now().Sub(at) <= deletedGroupTTL
+}
+
+// prune drops the rules not seen within seenRuleTTL and the rules of groups
+// deleted more than deletedGroupTTL ago
+func (t *groupTeardown) prune(now time.Time) {
+	for id, rule := range t.ruleGroups {
+		if now.Sub(rule.at) > seenRuleTTL {
+			delete(t.ruleGroups, id)
+		}
+	}
+	for id, at := range t.goneRules {
+		if now.Sub(at) > deletedGroupTTL {
+			delete(t.goneRules, id)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupTeardown(t *testing.T) {
+	teardown := newGroupTeardown()
+	teardown.ruleSeen("GRA7/rule-1", "GRA7/sg-1")
+	teardown.ruleSeen("GRA7/rule-2", "GRA7/sg-1")
+	teardown.ruleSeen("GRA7/rule-3", "GRA7/sg-2")
+
+	// The group is still there, so the rule has to be deleted
+	assert.False(t, teardown.ruleDeleted("GRA7/rule-1"))
+
+	teardown.groupDeleted("GRA7/sg-1")
+	assert.True(t, teardown.ruleDeleted("GRA7/rule-2"))
+	assert.False(t, teardown.ruleDeleted("GRA7/rule-3"), "rule of another group")
+	assert.False(t, teardown.ruleDeleted("GRA7/rule-4"), "rule never seen")
+
+	// A rule is only short-circuited once
+	assert.False(t, teardown.ruleDeleted("GRA7/rule-2"))
+}
+
+func TestGroupTeardown_Expires(t *testing.T) {
+	now := time.Now()
+	teardown := newGroupTeardown()
+	teardown.now = func() time.Time { return now }
+
+	teardown.ruleSeen("rule-1", "sg-1")
+	teardown.groupDeleted("sg-1")
+
+	now = now.Add(deletedGroupTTL + time.Minute)
+	assert.False(t, teardown.ruleDeleted("rule-1"))
+
+	teardown.ruleSeen("rule-2", "sg-2")
+	teardown.groupDeleted("sg-2")
+	now = now.Add(deletedGroupTTL + time.Minute)
+	teardown.ruleSeen("rule-3", "sg-3")
+	assert.Empty(t, teardown.goneRules)
+
+	now = now.Add(seenRuleTTL + time.Minute)
+	teardown.ruleSeen("rule-4", "sg-4")
+	assert.NotContains(t, teardown.ruleGroups, "rule-3")
+	assert.Contains(t, teardown.ruleGroups, "rule-4")
+}
+
+func TestGroupTeardown_ForgetsRulesOfDeletedGroup(t *testing.T) {
+	teardown := newGroupTeardown()
+	teardown.ruleSeen("rule-1", "sg-1")
+	teardown.ruleSeen("rule-2", "sg-2")
+
+	teardown.groupDeleted("sg-1")
+	assert.Equal(t, []string{"rule-2"}, slices.Collect(maps.Keys(teardown.ruleGroups)))
+
+	assert.True(t, teardown.ruleDeleted("rule-1"))
+	assert.Empty(t, teardown.goneRules)
+}
+
+func TestGroupTeardown_EngineDeleteOrder(t *testing.T) {
+	neutron, s := newRuleNeutron(t)
+	group := &SecurityGroup{Client: s.Client, Config: s.Config}
+
+	var ruleIDs []string
+	for i, protocol := range []string{"tcp", "udp", "icmp", "sctp"} {
+		created, err := s.Create(context.Background(), &resource.CreateRequest{
+			ResourceType: ResourceTypeSecurityGroupRule,
+			Label:        fmt.Sprintf("rule-%d", i),
+			Properties:   json.RawMessage(fmt.Sprintf(`{"security_group_id": "sg-teardown", "direction": "ingress", "ethertype": "IPv4", "protocol": %q}`, protocol)),
+		})
+		require.NoError(t, err)
+		ruleIDs = append(ruleIDs, created.ProgressResult.NativeID)
+	}
+	deleteRule := func(nativeID string) {
+		t.Helper()
+		result, err := s.Delete(context.Background(), &resource.DeleteRequest{NativeID: nativeID})
+		require.NoError(t, err)
+		require.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	}
+
+	// Rules that reference their group are deleted before it, each by its own call
+	deleteRule(ruleIDs[0])
+	deleteRule(ruleIDs[1])
+	assert.Equal(t, 2, neutron.deletes)
+
+	deleted, err := group.Delete(context.Background(), &resource.DeleteRequest{NativeID: "sg-teardown"})
+	require.NoError(t, err)
+	require.Equal(t, resource.OperationStatusSuccess, deleted.ProgressResult.OperationStatus)
+	for _, id := range ruleIDs {
+		assert.NotContains(t, groupTeardowns.ruleGroups, id)
+	}
+
+	// Rules that name the group by ID may come after it, and skip their call
+	deleteRule(ruleIDs[2])
+	deleteRule(ruleIDs[3])
+	assert.Equal(t, 2, neutron.deletes)
+	for _, id := range ruleIDs {
+		assert.NotContains(t, groupTeardowns.goneRules, id)
+		ruleOwners.release(id)
+	}
+}
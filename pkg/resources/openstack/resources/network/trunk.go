@@ -0,0 +1,407 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeTrunk = "OVH::Network::Trunk"
+)
+
+// Trunk provisioner. A trunk lets the parent port carry several networks,
+// one per subport, each told apart by its VLAN segmentation ID.
+type Trunk struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// trunkToProperties converts an OpenStack trunk to a properties map.
+// This is used by Create, Read, and Update to ensure consistent property marshaling.
+func trunkToProperties(trunk *trunks.Trunk) map[string]interface{} {
+	props := map[string]interface{}{
+		"id":             trunk.ID,
+		"name":           trunk.Name,
+		"description":    trunk.Description,
+		"admin_state_up": trunk.AdminStateUp,
+		"parent_port_id": trunk.PortID,
+		"status":         trunk.Status,
+	}
+
+	if len(trunk.Subports) > 0 {
+		subports := make([]map[string]interface{}, 0, len(trunk.Subports))
+		for _, sp := range trunk.Subports {
+			subports = append(subports, map[string]interface{}{
+				"port_id":           sp.PortID,
+				"segmentation_type": sp.SegmentationType,
+				"segmentation_id":   sp.SegmentationID,
+			})
+		}
+		props["sub_ports"] = subports
+	}
+
+	if len(trunk.Tags) > 0 {
+		props["tags"] = trunk.Tags
+	}
+
+	return props
+}
+
+// parseSubports reads the sub_ports property. segmentation_type defaults to vlan.
+func parseSubports(v interface{}) ([]trunks.Subport, error) {
+	raw, _ := v.([]interface{})
+	subports := make([]trunks.Subport, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sp := trunks.Subport{SegmentationType: "vlan"}
+		sp.PortID, _ = m["port_id"].(string)
+		if t, ok := m["segmentation_type"].(string); ok && t != "" {
+			sp.SegmentationType = t
+		}
+		if id, ok := m["segmentation_id"].(float64); ok {
+			sp.SegmentationID = int(id)
+		}
+		if sp.PortID == "" || sp.SegmentationID == 0 {
+			return nil, fmt.Errorf("each sub_port needs a port_id and a segmentation_id")
+		}
+		subports = append(subports, sp)
+	}
+	return subports, nil
+}
+
+// diffSubports returns the subports to add and remove to turn current into
+// desired. A port whose segmentation changed is removed and added again.
+func diffSubports(current, desired []trunks.Subport) ([]trunks.Subport, []trunks.RemoveSubport) {
+	currentByPort := make(map[string]trunks.Subport, len(current))
+	for _, sp := range current {
+		currentByPort[sp.PortID] = sp
+	}
+	desiredByPort := make(map[string]trunks.Subport, len(desired))
+	for _, sp := range desired {
+		desiredByPort[sp.PortID] = sp
+	}
+
+	var remove []trunks.RemoveSubport
+	for _, sp := range current {
+		if want, ok := desiredByPort[sp.PortID]; !ok || want != sp {
+			remove = append(remove, trunks.RemoveSubport{PortID: sp.PortID})
+		}
+	}
+	var add []trunks.Subport
+	for _, sp := range desired {
+		if have, ok := currentByPort[sp.PortID]; !ok || have != sp {
+			add = append(add, sp)
+		}
+	}
+	return add, remove
+}
+
+// Register the Trunk resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeTrunk,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &Trunk{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// Create creates a trunk on its parent port
+func (t *Trunk) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	// A region property overrides the target region for this resource
+	region, _ := props["region"].(string)
+	netClient, err := t.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	parentPortID, _ := props["parent_port_id"].(string)
+	if parentPortID == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, "", "parent_port_id is required"),
+		}, nil
+	}
+
+	subports, err := parseSubports(props["sub_ports"])
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	createOpts := trunks.CreateOpts{
+		PortID:   parentPortID,
+		Subports: subports,
+	}
+	createOpts.Name, _ = props["name"].(string)
+	createOpts.Description, _ = props["description"].(string)
+	if adminStateUp, ok := props["admin_state_up"].(bool); ok {
+		createOpts.AdminStateUp = &adminStateUp
+	}
+
+	trunk, err := trunks.Create(ctx, netClient, createOpts).Extract()
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeTrunk, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to create trunk: %v", err)),
+		}, nil
+	}
+
+	// Set tags if provided (must be done after creation via attributestags API)
+	tags := resources.ParseTags(props["tags"])
+	if len(tags) > 0 && tagsSupported(ctx, t.Client, netClient) {
+		if set, ok := replaceTags(ctx, netClient, "trunks", "trunk", trunk.ID, tags); ok {
+			trunk.Tags = set
+		}
+	}
+
+	nativeID := resources.RegionalNativeID(region, trunk.ID)
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(trunkToProperties(trunk), region))
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeTrunk, resource.OperationErrorCodeGeneralServiceException, nativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves the current state of a trunk
+func (t *Trunk) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+	if id == "" {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	netClient, err := t.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	trunk, err := trunks.Get(ctx, netClient, id).Extract()
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	if tagsSupported(ctx, t.Client, netClient) {
+		if tags, ok := readTags(ctx, netClient, "trunks", "trunk", id); ok {
+			trunk.Tags = tags
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(trunkToProperties(trunk), region))
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update changes the trunk's attributes and adds or removes subports so that
+// they match sub_ports
+func (t *Trunk) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := t.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	desired, err := parseSubports(props["sub_ports"])
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	updateOpts := trunks.UpdateOpts{}
+	name, _ := props["name"].(string)
+	updateOpts.Name = &name
+	description, _ := props["description"].(string)
+	updateOpts.Description = &description
+	if adminStateUp, ok := props["admin_state_up"].(bool); ok {
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	trunk, err := trunks.Update(ctx, netClient, id, updateOpts).Extract()
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to update trunk: %v", err)),
+		}, nil
+	}
+
+	// Removals go first so a port can be re-added with a new segmentation ID
+	add, remove := diffSubports(trunk.Subports, desired)
+	if len(remove) > 0 {
+		trunk, err = trunks.RemoveSubports(ctx, netClient, id, trunks.RemoveSubportsOpts{Subports: remove}).Extract()
+		if err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to remove subports: %v", err)),
+			}, nil
+		}
+	}
+	if len(add) > 0 {
+		trunk, err = trunks.AddSubports(ctx, netClient, id, trunks.AddSubportsOpts{Subports: add}).Extract()
+		if err != nil {
+			return &resource.UpdateResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to add subports: %v", err)),
+			}, nil
+		}
+	}
+
+	// Always replace tags so that removing the tags property clears them
+	if tagsSupported(ctx, t.Client, netClient) {
+		tags := resources.ParseTags(props["tags"])
+		if tags == nil {
+			tags = []string{}
+		}
+		if set, ok := replaceTags(ctx, netClient, "trunks", "trunk", id, tags); ok {
+			trunk.Tags = set
+		}
+	}
+
+	propsJSON, err := resources.MarshalProperties(resources.WithRegion(trunkToProperties(trunk), region))
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeTrunk, resource.OperationErrorCodeGeneralServiceException, request.NativeID, fmt.Sprintf("failed to marshal properties: %v", err)),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes a trunk. Neutron refuses while the parent port is bound to
+// an instance, so the instance must be deleted or detached first.
+func (t *Trunk) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	if err := resources.ValidateNativeID(request.NativeID); err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	region, id := resources.ParseRegionalNativeID(request.NativeID)
+
+	netClient, err := t.Client.NetworkClientFor(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeTrunk, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	err = trunks.Delete(ctx, netClient, id).ExtractErr()
+	if err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeTrunk, errCode, request.NativeID, fmt.Sprintf("failed to delete trunk: %s", resources.OpenStackErrorMessage(err))),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status checks the status of a long-running operation (trunks are synchronous, so not used)
+func (t *Trunk) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// List discovers trunks
+func (t *Trunk) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	allPages, err := trunks.List(t.Client.NetworkClient, trunks.ListOpts{Tags: t.Config.ManagedByTag}).AllPages(ctx)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list trunks: %w", err)
+	}
+
+	trunkList, err := trunks.ExtractTrunks(allPages)
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to extract trunks: %w", err)
+	}
+
+	nativeIDs := make([]string, 0, len(trunkList))
+	for _, trunk := range trunkList {
+		nativeIDs = append(nativeIDs, trunk.ID)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
@@ -0,0 +1,428 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/base"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/openstack/resources"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/prov"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/resources/registry"
+	openstack "github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/openstack"
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+const (
+	ResourceTypeContainerObject = "OVH::Storage::SwiftContainerObject"
+
+	// sourceMetadataKey records the local path an object was uploaded from,
+	// so Read can compare the remote ETag with the file's current hash
+	sourceMetadataKey = "Formae-Source"
+
+	// maxInlineContent bounds inline content, which Read downloads for drift
+	// detection; larger objects must be uploaded from a source file
+	maxInlineContent = 1 << 20
+)
+
+// ContainerObject provisioner. The native ID is "region/container/object";
+// Swift container names cannot contain "/" but object names can.
+type ContainerObject struct {
+	Client *openstack.Client
+	Config *openstack.Config
+}
+
+// Register the ContainerObject resource type
+func init() {
+	registry.RegisterOpenStack(
+		ResourceTypeContainerObject,
+		[]resource.Operation{
+			resource.OperationCreate,
+			resource.OperationRead,
+			resource.OperationUpdate,
+			resource.OperationDelete,
+			resource.OperationList,
+		},
+		func(client *openstack.Client, cfg *openstack.Config) prov.Provisioner {
+			return &ContainerObject{
+				Client: client,
+				Config: cfg,
+			}
+		},
+	)
+}
+
+// objectNativeID builds "region/container/object"
+func objectNativeID(region, container, name string) string {
+	return fmt.Sprintf("%s/%s/%s", region, container, name)
+}
+
+// parseObjectNativeID splits a native ID built by objectNativeID
+func parseObjectNativeID(nativeID string) (region, container, name string, err error) {
+	parts := strings.SplitN(nativeID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid object native ID %q: expected region/container/object", nativeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// objectContent returns the bytes to upload from exactly one of content or
+// source. Inline content over maxInlineContent is rejected, as Read would not
+// download it back and the object would drift forever.
+func objectContent(props map[string]interface{}) ([]byte, string, error) {
+	content, hasContent := props["content"].(string)
+	source, _ := props["source"].(string)
+
+	switch {
+	case hasContent && source != "":
+		return nil, "", fmt.Errorf("content and source are mutually exclusive")
+	case source != "":
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read source: %w", err)
+		}
+		return data, source, nil
+	case hasContent:
+		if len(content) > maxInlineContent {
+			return nil, "", fmt.Errorf("inline content is %d bytes, over the %d byte limit; upload it from source instead", len(content), maxInlineContent)
+		}
+		return []byte(content), "", nil
+	default:
+		return nil, "", fmt.Errorf("one of content or source is required")
+	}
+}
+
+// fileMD5 returns the hex MD5 of a local file, which Swift uses as the ETag
+func fileMD5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// storageClient returns the Swift client for region, defaulting to the
+// configured OpenStack region. Swift regions use the short form (GRA, not GRA7).
+func (o *ContainerObject) storageClient(region string) (*gophercloud.ServiceClient, string, error) {
+	if region == "" {
+		region = o.Config.Region
+	}
+	region = base.DeriveShortRegion(region)
+	client, err := o.Client.ObjectStorageClientFor(region)
+	return client, region, err
+}
+
+// upload PUTs data as the object, replacing any existing object of the same
+// name. source, when set, is recorded in the object's metadata.
+func upload(ctx context.Context, client *gophercloud.ServiceClient, container, name string, data []byte, source string, props map[string]interface{}) error {
+	createOpts := objects.CreateOpts{
+		Content: bytes.NewReader(data),
+	}
+	if contentType, ok := props["content_type"].(string); ok {
+		createOpts.ContentType = contentType
+	}
+	if source != "" {
+		createOpts.Metadata = map[string]string{sourceMetadataKey: source}
+	}
+
+	_, err := objects.Create(ctx, client, container, name, createOpts).Extract()
+	return err
+}
+
+// readObjectProperties reads an object's headers into a properties map.
+// For objects uploaded from a source file, source is reported only while the
+// file still matches the stored ETag, so editing the file shows up as drift.
+// Inline objects have their content downloaded for the same reason.
+func readObjectProperties(ctx context.Context, client *gophercloud.ServiceClient, region, container, name string) (map[string]interface{}, error) {
+	result := objects.Get(ctx, client, container, name, nil)
+	header, err := result.Extract()
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	etag := strings.Trim(header.ETag, `"`)
+	props := map[string]interface{}{
+		"container":      container,
+		"name":           name,
+		"region":         region,
+		"content_type":   header.ContentType,
+		"content_length": header.ContentLength,
+		"etag":           etag,
+	}
+
+	if source := metadata[sourceMetadataKey]; source != "" {
+		if sum, err := fileMD5(source); err == nil && sum == etag {
+			props["source"] = source
+		}
+		return props, nil
+	}
+
+	if header.ContentLength <= maxInlineContent {
+		download := objects.Download(ctx, client, container, name, nil)
+		content, err := download.ExtractContent()
+		if err != nil {
+			return nil, err
+		}
+		props["content"] = string(content)
+	}
+	return props, nil
+}
+
+// Create uploads an object into a container
+func (o *ContainerObject) Create(ctx context.Context, request *resource.CreateRequest) (*resource.CreateResult, error) {
+	props, err := resources.ParseProperties(request.Properties)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	container, _ := props["container"].(string)
+	name, _ := props["name"].(string)
+	if container == "" || name == "" {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, "", "container and name are required"),
+		}, nil
+	}
+
+	data, source, err := objectContent(props)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	regionProp, _ := props["region"].(string)
+	client, region, err := o.storageClient(regionProp)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, "", err.Error()),
+		}, nil
+	}
+
+	if err := upload(ctx, client, container, name, data, source, props); err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resources.MapOpenStackErrorToOperationErrorCode(err), "", fmt.Sprintf("failed to upload object: %v", err)),
+		}, nil
+	}
+
+	nativeID := objectNativeID(region, container, name)
+	objectProps, err := readObjectProperties(ctx, client, region, container, name)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resources.MapOpenStackErrorToOperationErrorCode(err), nativeID, fmt.Sprintf("failed to read uploaded object: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(objectProps)
+	if err != nil {
+		return &resource.CreateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationCreate, ResourceTypeContainerObject, resource.OperationErrorCodeGeneralServiceException, nativeID, err.Error()),
+		}, nil
+	}
+
+	return &resource.CreateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationCreate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           nativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Read retrieves an object's headers and checks it for drift
+func (o *ContainerObject) Read(ctx context.Context, request *resource.ReadRequest) (*resource.ReadResult, error) {
+	region, container, name, err := parseObjectNativeID(request.NativeID)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	client, _, err := o.storageClient(region)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeInvalidRequest,
+		}, nil
+	}
+
+	props, err := readObjectProperties(ctx, client, region, container, name)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resources.MapOpenStackErrorToOperationErrorCode(err),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(props)
+	if err != nil {
+		return &resource.ReadResult{
+			ErrorCode: resource.OperationErrorCodeGeneralServiceException,
+		}, nil
+	}
+
+	return &resource.ReadResult{
+		Properties: propsJSON,
+	}, nil
+}
+
+// Update uploads the object again; container, name and region are create-only
+func (o *ContainerObject) Update(ctx context.Context, request *resource.UpdateRequest) (*resource.UpdateResult, error) {
+	region, container, name, err := parseObjectNativeID(request.NativeID)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	props, err := resources.ParseProperties(request.DesiredProperties)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	data, source, err := objectContent(props)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	client, _, err := o.storageClient(region)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	if err := upload(ctx, client, container, name, data, source, props); err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to upload object: %v", err)),
+		}, nil
+	}
+
+	objectProps, err := readObjectProperties(ctx, client, region, container, name)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resources.MapOpenStackErrorToOperationErrorCode(err), request.NativeID, fmt.Sprintf("failed to read uploaded object: %v", err)),
+		}, nil
+	}
+
+	propsJSON, err := resources.MarshalProperties(objectProps)
+	if err != nil {
+		return &resource.UpdateResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationUpdate, ResourceTypeContainerObject, resource.OperationErrorCodeGeneralServiceException, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	return &resource.UpdateResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:          resource.OperationUpdate,
+			OperationStatus:    resource.OperationStatusSuccess,
+			NativeID:           request.NativeID,
+			ResourceProperties: []byte(propsJSON),
+		},
+	}, nil
+}
+
+// Delete removes the object
+func (o *ContainerObject) Delete(ctx context.Context, request *resource.DeleteRequest) (*resource.DeleteResult, error) {
+	region, container, name, err := parseObjectNativeID(request.NativeID)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	client, _, err := o.storageClient(region)
+	if err != nil {
+		return &resource.DeleteResult{
+			ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeContainerObject, resource.OperationErrorCodeInvalidRequest, request.NativeID, err.Error()),
+		}, nil
+	}
+
+	if _, err := objects.Delete(ctx, client, container, name, nil).Extract(); err != nil {
+		// Check if the error is NotFound - if so, consider it a success (idempotent delete)
+		errCode := resources.MapOpenStackErrorToOperationErrorCode(err)
+		if errCode != resource.OperationErrorCodeNotFound {
+			return &resource.DeleteResult{
+				ProgressResult: resources.NewFailureResultWithMessage(resource.OperationDelete, ResourceTypeContainerObject, errCode, request.NativeID, fmt.Sprintf("failed to delete object: %v", err)),
+			}, nil
+		}
+	}
+
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// Status returns success immediately; uploads are synchronous
+func (o *ContainerObject) Status(ctx context.Context, request *resource.StatusRequest) (*resource.StatusResult, error) {
+	return &resource.StatusResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationCheckStatus,
+			OperationStatus: resource.OperationStatusSuccess,
+			RequestID:       request.RequestID,
+			NativeID:        request.NativeID,
+		},
+	}, nil
+}
+
+// List discovers the objects of one container, given as the "container"
+// additional property; there is no project-wide object listing
+func (o *ContainerObject) List(ctx context.Context, request *resource.ListRequest) (*resource.ListResult, error) {
+	container := request.AdditionalProperties["container"]
+	if container == "" {
+		return &resource.ListResult{}, nil
+	}
+
+	client, region, err := o.storageClient(request.AdditionalProperties["region"])
+	if err != nil {
+		return &resource.ListResult{}, err
+	}
+
+	var nativeIDs []string
+	err = objects.List(client, container, objects.ListOpts{}).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		names, err := objects.ExtractNames(page)
+		if err != nil {
+			return false, err
+		}
+		for _, name := range names {
+			nativeIDs = append(nativeIDs, objectNativeID(region, container, name))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &resource.ListResult{}, fmt.Errorf("failed to list objects in %s: %w", container, err)
+	}
+
+	return &resource.ListResult{
+		NativeIDs: nativeIDs,
+	}, nil
+}
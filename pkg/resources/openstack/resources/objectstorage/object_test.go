@@ -0,0 +1,59 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package objectstorage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectContent(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "index.html")
+	require.NoError(t, os.WriteFile(source, []byte("<html/>"), 0o600))
+
+	tests := []struct {
+		name       string
+		props      map[string]interface{}
+		wantData   string
+		wantSource string
+		wantErr    bool
+	}{
+		{name: "inline", props: map[string]interface{}{"content": "hello"}, wantData: "hello"},
+		{name: "empty inline", props: map[string]interface{}{"content": ""}, wantData: ""},
+		{name: "source", props: map[string]interface{}{"source": source}, wantData: "<html/>", wantSource: source},
+		{name: "both", props: map[string]interface{}{"content": "hello", "source": source}, wantErr: true},
+		{name: "neither", props: map[string]interface{}{}, wantErr: true},
+		{name: "missing source", props: map[string]interface{}{"source": filepath.Join(t.TempDir(), "missing")}, wantErr: true},
+		{name: "inline over limit", props: map[string]interface{}{"content": strings.Repeat("a", maxInlineContent+1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, gotSource, err := objectContent(tt.props)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantData, string(data))
+			assert.Equal(t, tt.wantSource, gotSource)
+		})
+	}
+}
+
+func TestParseObjectNativeID(t *testing.T) {
+	region, container, name, err := parseObjectNativeID(objectNativeID("GRA", "site", "assets/css/main.css"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GRA", "site", "assets/css/main.css"}, []string{region, container, name})
+
+	for _, nativeID := range []string{"GRA/site", "GRA//index.html", "/site/index.html", "GRA/site/"} {
+		_, _, _, err := parseObjectNativeID(nativeID)
+		assert.Error(t, err, nativeID)
+	}
+}
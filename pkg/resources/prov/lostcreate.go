@@ -0,0 +1,86 @@
+package prov
+
+import (
+	"sync"
+	"time"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// lostCreateExpiry is how long a Create whose response was lost waits for
+// its retry before it is forgotten
+const lostCreateExpiry = time.Hour
+
+// AdoptClockMargin allows for the API clock being behind the local one when
+// comparing creation times with a lost attempt
+const AdoptClockMargin = 5 * time.Minute
+
+// LostCreates records the Creates whose request may have created the
+// resource without the response reaching the plugin: timeouts, dropped
+// connections and server errors. Neither the OVH API nor Neutron takes an
+// idempotency key, and OpenStack tags are only set once the create returns,
+// so this record is the retry signal: only the retried Create of the same
+// resource, by type and label, looks for a resource to adopt, and only among
+// those created since the lost attempt. Two identical resources in a stack
+// are therefore never merged. The record lives in the plugin process; if it
+// restarts in between, the retry creates a new resource.
+var LostCreates = &CreateAttempts{attempts: make(map[string]time.Time), now: time.Now}
+
+// CreateAttempts tracks lost Create attempts by CreateAttemptKey
+type CreateAttempts struct {
+	mu       sync.Mutex
+	attempts map[string]time.Time
+	now      func() time.Time
+}
+
+// CreateAttemptKey identifies the resource a Create is for within scope,
+// e.g. its collection URL or region. Resources without a label get no key
+// and are never adopted.
+func CreateAttemptKey(request *resource.CreateRequest, scope string) string {
+	if request.Label == "" {
+		return ""
+	}
+	return request.ResourceType + "|" + request.Label + "|" + scope
+}
+
+// Record remembers that the Create for key may have created its resource,
+// dropping attempts whose retry never came
+func (a *CreateAttempts) Record(key string) {
+	if key == "" {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	for k, attempted := range a.attempts {
+		if now.Sub(attempted) > lostCreateExpiry {
+			delete(a.attempts, k)
+		}
+	}
+	if _, ok := a.attempts[key]; !ok {
+		a.attempts[key] = now
+	}
+}
+
+// Since returns when the first lost attempt for key was made
+func (a *CreateAttempts) Since(key string) (time.Time, bool) {
+	if key == "" {
+		return time.Time{}, false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	attempted, ok := a.attempts[key]
+	if !ok || a.now().Sub(attempted) > lostCreateExpiry {
+		return time.Time{}, false
+	}
+	return attempted, true
+}
+
+// Clear forgets the attempts for key once its Create has succeeded
+func (a *CreateAttempts) Clear(key string) {
+	a.mu.Lock()
+	delete(a.attempts, key)
+	a.mu.Unlock()
+}
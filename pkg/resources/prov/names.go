@@ -0,0 +1,36 @@
+package prov
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// ImportNameProperty is the List additional property naming the resource to
+// import, for users who know an existing resource by name, not native ID
+const ImportNameProperty = "name"
+
+// NameResolver is implemented by provisioners that can find resources by
+// name. ResolveName returns the native IDs of every resource called name.
+type NameResolver interface {
+	ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error)
+}
+
+// ResolveByName lists the native ID of the resource called name. Names are
+// not unique for most resource types, so several matches are an error that
+// lists them, leaving the user to import one by native ID.
+func ResolveByName(ctx context.Context, r NameResolver, request *resource.ListRequest, name string) (*resource.ListResult, error) {
+	nativeIDs, err := r.ResolveName(ctx, request, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(nativeIDs) > 1 {
+		sort.Strings(nativeIDs)
+		return nil, fmt.Errorf("name %q matches %d resources, import one by native ID: %s",
+			name, len(nativeIDs), strings.Join(nativeIDs, ", "))
+	}
+	return &resource.ListResult{NativeIDs: nativeIDs}, nil
+}
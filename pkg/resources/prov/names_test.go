@@ -0,0 +1,36 @@
+package prov
+
+import (
+	"context"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNameResolver map[string][]string
+
+func (f fakeNameResolver) ResolveName(ctx context.Context, request *resource.ListRequest, name string) ([]string, error) {
+	return f[name], nil
+}
+
+func TestResolveByName(t *testing.T) {
+	resolver := fakeNameResolver{
+		"web": {"id-1"},
+		"db":  {"id-3", "id-2"},
+	}
+	ctx := context.Background()
+
+	result, err := ResolveByName(ctx, resolver, &resource.ListRequest{}, "web")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id-1"}, result.NativeIDs)
+
+	result, err = ResolveByName(ctx, resolver, &resource.ListRequest{}, "cache")
+	require.NoError(t, err)
+	assert.Empty(t, result.NativeIDs)
+
+	_, err = ResolveByName(ctx, resolver, &resource.ListRequest{}, "db")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "id-2, id-3")
+}
@@ -0,0 +1,32 @@
+package prov
+
+import "encoding/json"
+
+// RemoveSensitive returns properties without the given secret fields.
+// Properties that are not a JSON object are returned unchanged.
+func RemoveSensitive(properties string, fields []string) string {
+	if len(fields) == 0 || properties == "" {
+		return properties
+	}
+	var props map[string]interface{}
+	if err := json.Unmarshal([]byte(properties), &props); err != nil {
+		return properties
+	}
+
+	removed := false
+	for _, field := range fields {
+		if _, ok := props[field]; ok {
+			delete(props, field)
+			removed = true
+		}
+	}
+	if !removed {
+		return properties
+	}
+
+	redacted, err := json.Marshal(props)
+	if err != nil {
+		return properties
+	}
+	return string(redacted)
+}
@@ -0,0 +1,16 @@
+package prov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveSensitive(t *testing.T) {
+	props := `{"access":"AK","secret":"SK","userId":"42"}`
+
+	assert.JSONEq(t, `{"access":"AK","userId":"42"}`, RemoveSensitive(props, []string{"secret"}))
+	assert.Equal(t, props, RemoveSensitive(props, []string{"password"}))
+	assert.Equal(t, props, RemoveSensitive(props, nil))
+	assert.Equal(t, "not json", RemoveSensitive("not json", []string{"secret"}))
+}
@@ -0,0 +1,113 @@
+package prov
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// ValidationError is one problem found in a resource's properties
+type ValidationError struct {
+	Property string `json:"property,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	if e.Property == "" {
+		return e.Message
+	}
+	return e.Property + ": " + e.Message
+}
+
+// Validator is implemented by provisioners that can check a create request
+// without mutating anything. Validate may read from the API to check that
+// referenced resources exist; the error is non-nil only when validation
+// itself could not run.
+type Validator interface {
+	Validate(ctx context.Context, request *resource.CreateRequest) ([]ValidationError, error)
+}
+
+// dryRunProperties is the structured result returned by a failed dry run
+type dryRunProperties struct {
+	ValidationErrors []ValidationError `json:"validationErrors"`
+}
+
+// DryRunCreate validates a create request instead of performing it.
+// Provisioners that do not implement Validator only get their properties
+// checked for well-formed JSON.
+func DryRunCreate(ctx context.Context, p Provisioner, request *resource.CreateRequest) *resource.CreateResult {
+	result := dryRun(ctx, p, resource.OperationCreate, request)
+	if result.OperationStatus == resource.OperationStatusSuccess {
+		result.NativeID = "dry-run/" + request.Label
+		result.StatusMessage = "dry run: properties are valid, nothing was created"
+	}
+	return &resource.CreateResult{ProgressResult: result}
+}
+
+// DryRunUpdate validates the desired properties of an update instead of applying them.
+func DryRunUpdate(ctx context.Context, p Provisioner, request *resource.UpdateRequest) *resource.UpdateResult {
+	result := dryRun(ctx, p, resource.OperationUpdate, &resource.CreateRequest{
+		ResourceType: request.ResourceType,
+		Label:        request.Label,
+		Properties:   request.DesiredProperties,
+		TargetConfig: request.TargetConfig,
+	})
+	result.NativeID = request.NativeID
+	if result.OperationStatus == resource.OperationStatusSuccess {
+		result.StatusMessage = "dry run: properties are valid, nothing was updated"
+	}
+	return &resource.UpdateResult{ProgressResult: result}
+}
+
+// DryRunDelete reports success without deleting anything.
+func DryRunDelete(request *resource.DeleteRequest) *resource.DeleteResult {
+	return &resource.DeleteResult{
+		ProgressResult: &resource.ProgressResult{
+			Operation:       resource.OperationDelete,
+			OperationStatus: resource.OperationStatusSuccess,
+			NativeID:        request.NativeID,
+			StatusMessage:   "dry run: nothing was deleted",
+		},
+	}
+}
+
+func dryRun(ctx context.Context, p Provisioner, operation resource.Operation, request *resource.CreateRequest) *resource.ProgressResult {
+	var errs []ValidationError
+	if !json.Valid(request.Properties) {
+		errs = append(errs, ValidationError{Message: "properties are not valid JSON"})
+	} else if validator, ok := p.(Validator); ok {
+		var err error
+		errs, err = validator.Validate(ctx, request)
+		if err != nil {
+			return &resource.ProgressResult{
+				Operation:       operation,
+				OperationStatus: resource.OperationStatusFailure,
+				ErrorCode:       resource.OperationErrorCodeServiceInternalError,
+				StatusMessage:   "dry run: validation failed: " + err.Error(),
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return &resource.ProgressResult{
+			Operation:          operation,
+			OperationStatus:    resource.OperationStatusSuccess,
+			ResourceProperties: request.Properties,
+		}
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	props, _ := json.Marshal(dryRunProperties{ValidationErrors: errs})
+	return &resource.ProgressResult{
+		Operation:          operation,
+		OperationStatus:    resource.OperationStatusFailure,
+		ErrorCode:          resource.OperationErrorCodeInvalidRequest,
+		StatusMessage:      "dry run: " + strings.Join(messages, "; "),
+		ResourceProperties: props,
+	}
+}
@@ -0,0 +1,56 @@
+package prov
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvisioner fails every call so tests notice a dry run reaching the API
+type fakeProvisioner struct {
+	Provisioner
+	errs []ValidationError
+}
+
+func (f *fakeProvisioner) Validate(ctx context.Context, request *resource.CreateRequest) ([]ValidationError, error) {
+	return f.errs, nil
+}
+
+func TestDryRunCreate(t *testing.T) {
+	request := &resource.CreateRequest{Label: "web", Properties: json.RawMessage(`{"name":"web"}`)}
+
+	result := DryRunCreate(context.Background(), &fakeProvisioner{}, request)
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "dry-run/web", result.ProgressResult.NativeID)
+
+	result = DryRunCreate(context.Background(), &fakeProvisioner{errs: []ValidationError{
+		{Property: "flavorId", Message: "does not exist"},
+	}}, request)
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+	assert.Equal(t, resource.OperationErrorCodeInvalidRequest, result.ProgressResult.ErrorCode)
+	assert.Equal(t, "dry run: flavorId: does not exist", result.ProgressResult.StatusMessage)
+
+	var props dryRunProperties
+	require.NoError(t, json.Unmarshal(result.ProgressResult.ResourceProperties, &props))
+	assert.Equal(t, []ValidationError{{Property: "flavorId", Message: "does not exist"}}, props.ValidationErrors)
+}
+
+func TestDryRunCreate_InvalidJSON(t *testing.T) {
+	result := DryRunCreate(context.Background(), &fakeProvisioner{}, &resource.CreateRequest{Properties: json.RawMessage(`{`)})
+	assert.Equal(t, resource.OperationStatusFailure, result.ProgressResult.OperationStatus)
+	assert.Contains(t, result.ProgressResult.StatusMessage, "not valid JSON")
+}
+
+func TestDryRunUpdate(t *testing.T) {
+	result := DryRunUpdate(context.Background(), &fakeProvisioner{}, &resource.UpdateRequest{
+		NativeID:          "p1/i1",
+		DesiredProperties: json.RawMessage(`{"name":"web"}`),
+	})
+	assert.Equal(t, resource.OperationStatusSuccess, result.ProgressResult.OperationStatus)
+	assert.Equal(t, "p1/i1", result.ProgressResult.NativeID)
+	assert.Equal(t, resource.OperationUpdate, result.ProgressResult.Operation)
+}
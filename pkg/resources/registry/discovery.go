@@ -0,0 +1,82 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package registry
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
+)
+
+// DefaultDiscoveryWorkers bounds concurrent List calls during discovery.
+// Kept low so parallel discovery stays within the OVH API rate limit.
+const DefaultDiscoveryWorkers = 4
+
+// ListFunc lists the native IDs of one resource type
+type ListFunc func(ctx context.Context, resourceType string) ([]string, error)
+
+// DiscoveryResult is the outcome of listing one resource type
+type DiscoveryResult struct {
+	ResourceType string
+	NativeIDs    []string
+	Err          error
+}
+
+// ListableResourceTypes returns the sorted resource types that support List
+func ListableResourceTypes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	types := make([]string, 0, len(registrations))
+	for t, reg := range registrations {
+		for _, op := range reg.operations {
+			if op == resource.OperationList {
+				types = append(types, t)
+				break
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ListAll calls list for every resource type with at most workers calls in
+// flight, and returns one result per type in the order given. A failing type
+// does not stop the others; its error is reported in its result.
+// list must be safe to call from several goroutines.
+func ListAll(ctx context.Context, resourceTypes []string, workers int, list ListFunc) []DiscoveryResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]DiscoveryResult, len(resourceTypes))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(resourceTypes); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				resourceType := resourceTypes[i]
+				if err := ctx.Err(); err != nil {
+					results[i] = DiscoveryResult{ResourceType: resourceType, Err: err}
+					continue
+				}
+				nativeIDs, err := list(ctx, resourceType)
+				results[i] = DiscoveryResult{ResourceType: resourceType, NativeIDs: nativeIDs, Err: err}
+			}
+		}()
+	}
+
+	for i := range resourceTypes {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,62 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAll_BoundsConcurrencyAndKeepsOrder(t *testing.T) {
+	types := []string{"A", "B", "C", "D", "E", "F", "G", "H"}
+
+	var inFlight, peak int32
+	results := ListAll(context.Background(), types, 3, func(ctx context.Context, resourceType string) ([]string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if resourceType == "C" {
+			return nil, errors.New("boom")
+		}
+		return []string{resourceType + "-1"}, nil
+	})
+
+	require.Len(t, results, len(types))
+	for i, result := range results {
+		assert.Equal(t, types[i], result.ResourceType)
+	}
+	assert.EqualError(t, results[2].Err, "boom")
+	assert.Equal(t, []string{"H-1"}, results[7].NativeIDs)
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+	assert.Greater(t, atomic.LoadInt32(&peak), int32(1))
+}
+
+func TestListAll_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	results := ListAll(ctx, []string{"A", "B"}, 2, func(ctx context.Context, resourceType string) ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, nil
+	})
+
+	assert.Zero(t, atomic.LoadInt32(&calls))
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
@@ -35,8 +35,9 @@ type registration struct {
 }
 
 var (
-	mu            sync.RWMutex
-	registrations = make(map[string]*registration)
+	mu              sync.RWMutex
+	registrations   = make(map[string]*registration)
+	sensitiveFields = make(map[string][]string)
 )
 
 // Register registers a resource type with an OVH provisioner factory
@@ -61,6 +62,21 @@ func RegisterOpenStack(resourceType string, operations []resource.Operation, fac
 	}
 }
 
+// RegisterSensitive marks properties of a resource type as secrets. They match
+// the fields the schema hints with sensitive = true.
+func RegisterSensitive(resourceType string, fields ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+	sensitiveFields[resourceType] = append(sensitiveFields[resourceType], fields...)
+}
+
+// SensitiveFields returns the secret properties of a resource type
+func SensitiveFields(resourceType string) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return sensitiveFields[resourceType]
+}
+
 // GetTransportType returns the transport type for a resource
 func GetTransportType(resourceType string) TransportType {
 	mu.RLock()
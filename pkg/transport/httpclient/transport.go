@@ -0,0 +1,80 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+// Package httpclient provides the HTTP transport shared by the OVH and
+// OpenStack clients. Provisioners are created per operation, so a transport
+// per client would open a new connection, and TLS handshake, for most calls.
+package httpclient
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Connection pool defaults, sized for applies that run many operations
+// against the same few API endpoints at once
+const (
+	DefaultMaxIdleConns        = 100
+	DefaultMaxIdleConnsPerHost = 32
+	DefaultIdleConnTimeout     = 90 * time.Second
+)
+
+// PoolConfig tunes connection reuse. Zero or negative fields use the defaults.
+type PoolConfig struct {
+	// MaxIdleConns caps idle connections across all hosts
+	MaxIdleConns int
+	// MaxIdleConnsPerHost caps idle connections kept open to one host.
+	// net/http keeps only 2 by default, which a parallel apply exceeds at once.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout closes connections idle for longer than this
+	IdleConnTimeout time.Duration
+}
+
+// withDefaults fills unset fields with the defaults
+func (c PoolConfig) withDefaults() PoolConfig {
+	if c.MaxIdleConns <= 0 {
+		c.MaxIdleConns = DefaultMaxIdleConns
+	}
+	if c.MaxIdleConnsPerHost <= 0 {
+		c.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if c.IdleConnTimeout <= 0 {
+		c.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	return c
+}
+
+var (
+	transportsMu sync.Mutex
+	transports   = make(map[PoolConfig]*http.Transport)
+)
+
+// SharedTransport returns the transport for cfg. Clients built with the same
+// pool settings share one transport, and so one pool of open connections.
+func SharedTransport(cfg PoolConfig) *http.Transport {
+	cfg = cfg.withDefaults()
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	if transport, ok := transports[cfg]; ok {
+		return transport
+	}
+	transport := NewTransport(cfg)
+	transports[cfg] = transport
+	return transport
+}
+
+// NewTransport returns a transport with the proxy, dial and TLS settings of
+// http.DefaultTransport and the pool settings of cfg
+func NewTransport(cfg PoolConfig) *http.Transport {
+	cfg = cfg.withDefaults()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	return transport
+}
@@ -0,0 +1,122 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransport_Defaults(t *testing.T) {
+	transport := NewTransport(PoolConfig{})
+	assert.Equal(t, DefaultMaxIdleConns, transport.MaxIdleConns)
+	assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, DefaultIdleConnTimeout, transport.IdleConnTimeout)
+	// Proxy and dial settings come from the default transport
+	assert.NotNil(t, transport.Proxy)
+
+	transport = NewTransport(PoolConfig{MaxIdleConns: 10, MaxIdleConnsPerHost: 5, IdleConnTimeout: time.Second})
+	assert.Equal(t, 10, transport.MaxIdleConns)
+	assert.Equal(t, 5, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Second, transport.IdleConnTimeout)
+}
+
+func TestSharedTransport(t *testing.T) {
+	assert.Same(t, SharedTransport(PoolConfig{}), SharedTransport(PoolConfig{MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost}))
+	assert.NotSame(t, SharedTransport(PoolConfig{}), SharedTransport(PoolConfig{MaxIdleConnsPerHost: 4}))
+}
+
+// newCountingServer returns a TLS server and a counter of the connections
+// opened to it, i.e. TLS handshakes
+func newCountingServer(t testing.TB) (*httptest.Server, *int64) {
+	var conns int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulated API latency keeps requests in flight concurrently
+		time.Sleep(time.Millisecond)
+		w.Write([]byte(`{}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&conns, 1)
+		}
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server, &conns
+}
+
+// trusting points transport at the test server's certificate
+func trusting(server *httptest.Server, transport *http.Transport) *http.Transport {
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+	}
+	return transport
+}
+
+func TestSharedTransport_ReusesConnections(t *testing.T) {
+	server, conns := newCountingServer(t)
+	client := &http.Client{Transport: trusting(server, NewTransport(PoolConfig{}))}
+
+	for i := 0; i < 10; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(conns))
+}
+
+// BenchmarkTransport sends bursts of parallel requests, as an apply does
+// when it runs a wave of operations, through net/http's default pool
+// (2 idle connections per host) and the shared pool. Between bursts the
+// default pool closes all but 2 connections, so each burst opens most of
+// them again; handshakes/op counts the TLS connections opened per burst.
+func BenchmarkTransport(b *testing.B) {
+	const burst = 16
+
+	benchmarks := []struct {
+		name      string
+		transport func() *http.Transport
+	}{
+		{name: "default", transport: func() *http.Transport { return http.DefaultTransport.(*http.Transport).Clone() }},
+		{name: "pooled", transport: func() *http.Transport { return NewTransport(PoolConfig{}) }},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			server, conns := newCountingServer(b)
+			client := &http.Client{Transport: trusting(server, bm.transport())}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				for j := 0; j < burst; j++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						resp, err := client.Get(server.URL)
+						if err != nil {
+							b.Error(err)
+							return
+						}
+						io.Copy(io.Discard, resp.Body)
+						resp.Body.Close()
+					}()
+				}
+				wg.Wait()
+			}
+			b.ReportMetric(float64(atomic.LoadInt64(conns))/float64(b.N), "handshakes/op")
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+)
+
+// tokenExpiryMargin is how long before token expiry a cached provider is
+// reauthenticated, so in-flight operations don't start with a token about to lapse.
+const tokenExpiryMargin = 5 * time.Minute
+
+var (
+	providersMu sync.Mutex
+	providers   = make(map[string]*cachedProvider)
+
+	// now, newProvider, reauthenticate and newServiceClients are replaceable in tests
+	now               = time.Now
+	newProvider       = authenticate
+	newServiceClients = serviceClients
+	reauthenticate    = func(ctx context.Context, provider *gophercloud.ProviderClient) error {
+		return provider.Reauthenticate(ctx, provider.Token())
+	}
+)
+
+// cachedProvider holds the authenticated provider for one set of
+// credentials and the clients built on it, one per region and service
+// settings. Its own lock serializes authentication for those credentials
+// only, so a slow Keystone call for one project does not hold up the others.
+type cachedProvider struct {
+	mu       sync.Mutex
+	provider *gophercloud.ProviderClient
+	clients  map[string]*Client
+}
+
+// SharedClient returns an authenticated client for cfg. Every client built
+// for the same credentials shares one provider, and with it one token, one
+// service catalog and one HTTP transport, whatever region or microversions
+// it was asked for. Provisioners are created per operation, so without this
+// every Create/Read/Update/Delete would authenticate to Keystone again.
+//
+// A token nearing expiry is refreshed on the shared provider rather than by
+// building a new one, so clients already handed out, including the regional
+// and lazily built service clients, pick up the new token. A token revoked
+// early is still refreshed on 401 through AllowReauth.
+func SharedClient(ctx context.Context, cfg *Config) (*Client, error) {
+	key := providerCacheKey(cfg)
+
+	providersMu.Lock()
+	entry, ok := providers[key]
+	if !ok {
+		entry = &cachedProvider{}
+		providers[key] = entry
+	}
+	providersMu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.provider != nil && tokenExpiring(entry.provider) {
+		if err := reauthenticate(ctx, entry.provider); err != nil {
+			// Authenticate from scratch below; clients on the old provider are dropped
+			entry.provider = nil
+		}
+	}
+	if entry.provider == nil {
+		provider, err := newProvider(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		entry.provider = provider
+		entry.clients = nil
+	}
+
+	serviceKey := serviceCacheKey(cfg)
+	if c, ok := entry.clients[serviceKey]; ok {
+		return c, nil
+	}
+	c, err := newServiceClients(entry.provider, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if entry.clients == nil {
+		entry.clients = make(map[string]*Client)
+	}
+	entry.clients[serviceKey] = c
+	return c, nil
+}
+
+// tokenExpiring reports whether the provider's token expires within
+// tokenExpiryMargin. Tokens with no known expiry are kept and left to reauth.
+func tokenExpiring(provider *gophercloud.ProviderClient) bool {
+	expiresAt := tokenExpiry(provider)
+	if expiresAt.IsZero() {
+		return false
+	}
+	return now().Add(tokenExpiryMargin).After(expiresAt)
+}
+
+// tokenExpiry returns the expiry of the provider's current Keystone v3 token.
+// It reads the latest auth result, so it follows tokens refreshed by reauth.
+func tokenExpiry(provider *gophercloud.ProviderClient) time.Time {
+	if provider == nil {
+		return time.Time{}
+	}
+	result, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return time.Time{}
+	}
+	token, err := result.ExtractToken()
+	if err != nil {
+		return time.Time{}
+	}
+	return token.ExpiresAt
+}
+
+// providerCacheKey identifies the credentials and transport settings a
+// provider was authenticated with. The fields are hashed so secrets are not
+// kept as plain map keys.
+func providerCacheKey(cfg *Config) string {
+	h := sha256.Sum256([]byte(strings.Join([]string{
+		cfg.AuthURL,
+		cfg.Username,
+		cfg.Password,
+		cfg.ProjectID,
+		cfg.UserDomainName,
+		cfg.ProjectDomainID,
+		cfg.ApplicationCredentialID,
+		cfg.ApplicationCredentialName,
+		cfg.ApplicationCredentialSecret,
+		cfg.UserAgent,
+		fmt.Sprint(cfg.Pool),
+	}, "\x00")))
+	return hex.EncodeToString(h[:])
+}
+
+// serviceCacheKey identifies the region and service settings a client was
+// built for on a shared provider
+func serviceCacheKey(cfg *Config) string {
+	return strings.Join([]string{
+		cfg.Region,
+		cfg.EndpointType,
+		cfg.ComputeMicroversion,
+		cfg.BlockStorageMicroversion,
+	}, "\x00")
+}
@@ -0,0 +1,211 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func providerWithToken(t *testing.T, expiresAt string) *gophercloud.ProviderClient {
+	t.Helper()
+
+	var result tokens.CreateResult
+	result.Header = http.Header{"X-Subject-Token": []string{"token-id"}}
+	result.Body = map[string]interface{}{
+		"token": map[string]interface{}{"expires_at": expiresAt},
+	}
+
+	provider := &gophercloud.ProviderClient{}
+	require.NoError(t, provider.SetTokenAndAuthResult(result))
+	return provider
+}
+
+func TestTokenExpiring(t *testing.T) {
+	fixed := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	defer func() { now = time.Now }()
+
+	assert.False(t, tokenExpiring(providerWithToken(t, "2025-06-01T13:00:00.000000Z")))
+	assert.True(t, tokenExpiring(providerWithToken(t, "2025-06-01T12:03:00.000000Z")))
+	assert.True(t, tokenExpiring(providerWithToken(t, "2025-06-01T11:00:00.000000Z")))
+}
+
+func TestTokenExpiring_UnknownExpiry(t *testing.T) {
+	assert.False(t, tokenExpiring(&gophercloud.ProviderClient{}))
+	assert.False(t, tokenExpiring(nil))
+}
+
+func TestProviderCacheKey(t *testing.T) {
+	base := Config{AuthURL: "https://auth", Username: "user", Password: "pass", Region: "GRA11"}
+
+	same := base
+	same.ManagedByTag = "managed-by=formae"
+	same.Region = "DE1"
+	same.ComputeMicroversion = "2.26"
+	assert.Equal(t, providerCacheKey(&base), providerCacheKey(&same), "regions and service settings should share a provider")
+
+	otherAgent := base
+	otherAgent.UserAgent = "formae-plugin-ovh/dev ci"
+	assert.NotEqual(t, providerCacheKey(&base), providerCacheKey(&otherAgent))
+
+	otherPassword := base
+	otherPassword.Password = "rotated"
+	assert.NotEqual(t, providerCacheKey(&base), providerCacheKey(&otherPassword))
+}
+
+func TestServiceCacheKey(t *testing.T) {
+	base := Config{AuthURL: "https://auth", Username: "user", Password: "pass", Region: "GRA11"}
+
+	same := base
+	same.ManagedByTag = "managed-by=formae"
+	assert.Equal(t, serviceCacheKey(&base), serviceCacheKey(&same), "discovery filters should not split the cache")
+
+	otherRegion := base
+	otherRegion.Region = "DE1"
+	assert.NotEqual(t, serviceCacheKey(&base), serviceCacheKey(&otherRegion))
+
+	otherMicroversion := base
+	otherMicroversion.ComputeMicroversion = "2.26"
+	assert.NotEqual(t, serviceCacheKey(&base), serviceCacheKey(&otherMicroversion))
+}
+
+// fakeProviders replaces authentication with counters for the length of a test
+func fakeProviders(t *testing.T) (authentications, reauthentications *int) {
+	t.Helper()
+	authentications, reauthentications = new(int), new(int)
+	newProvider = func(ctx context.Context, cfg *Config) (*gophercloud.ProviderClient, error) {
+		*authentications++
+		return &gophercloud.ProviderClient{}, nil
+	}
+	reauthenticate = func(ctx context.Context, provider *gophercloud.ProviderClient) error {
+		*reauthentications++
+		return nil
+	}
+	newServiceClients = func(provider *gophercloud.ProviderClient, cfg *Config) (*Client, error) {
+		return &Client{Provider: provider, region: cfg.Region}, nil
+	}
+	t.Cleanup(func() {
+		newProvider = authenticate
+		newServiceClients = serviceClients
+		reauthenticate = func(ctx context.Context, provider *gophercloud.ProviderClient) error {
+			return provider.Reauthenticate(ctx, provider.Token())
+		}
+		providersMu.Lock()
+		providers = make(map[string]*cachedProvider)
+		providersMu.Unlock()
+	})
+	return authentications, reauthentications
+}
+
+func TestSharedClient_SharesProviderAcrossRegions(t *testing.T) {
+	authentications, _ := fakeProviders(t)
+
+	gra, err := SharedClient(context.Background(), &Config{AuthURL: "https://auth", Username: "user", Region: "GRA11"})
+	require.NoError(t, err)
+	de, err := SharedClient(context.Background(), &Config{AuthURL: "https://auth", Username: "user", Region: "DE1"})
+	require.NoError(t, err)
+	again, err := SharedClient(context.Background(), &Config{AuthURL: "https://auth", Username: "user", Region: "GRA11"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, *authentications)
+	assert.Same(t, gra.Provider, de.Provider)
+	assert.Equal(t, "DE1", de.region)
+	assert.Same(t, gra, again)
+}
+
+func TestSharedClient_ReauthenticatesExpiringProviderInPlace(t *testing.T) {
+	authentications, reauthentications := fakeProviders(t)
+	fixed := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	defer func() { now = time.Now }()
+	newProvider = func(ctx context.Context, cfg *Config) (*gophercloud.ProviderClient, error) {
+		*authentications++
+		return providerWithToken(t, "2025-06-01T12:03:00.000000Z"), nil
+	}
+
+	cfg := &Config{AuthURL: "https://auth", Username: "user", Region: "GRA11"}
+	first, err := SharedClient(context.Background(), cfg)
+	require.NoError(t, err)
+	second, err := SharedClient(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, *authentications)
+	assert.Equal(t, 1, *reauthentications)
+	assert.Same(t, first, second, "clients on a refreshed provider are kept")
+}
+
+func TestSharedClient_AuthenticatesAgainWhenReauthFails(t *testing.T) {
+	authentications, _ := fakeProviders(t)
+	fixed := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	defer func() { now = time.Now }()
+	newProvider = func(ctx context.Context, cfg *Config) (*gophercloud.ProviderClient, error) {
+		*authentications++
+		return providerWithToken(t, "2025-06-01T12:03:00.000000Z"), nil
+	}
+	reauthenticate = func(ctx context.Context, provider *gophercloud.ProviderClient) error {
+		return errors.New("keystone unavailable")
+	}
+
+	cfg := &Config{AuthURL: "https://auth", Username: "user", Region: "GRA11"}
+	first, err := SharedClient(context.Background(), cfg)
+	require.NoError(t, err)
+	second, err := SharedClient(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, *authentications)
+	assert.NotSame(t, first.Provider, second.Provider)
+}
+
+func TestSharedClient_AuthenticatesPerKey(t *testing.T) {
+	fakeProviders(t)
+	release := make(chan struct{})
+	newProvider = func(ctx context.Context, cfg *Config) (*gophercloud.ProviderClient, error) {
+		if cfg.Username == "slow" {
+			<-release
+		}
+		return &gophercloud.ProviderClient{}, nil
+	}
+
+	slow := &Config{AuthURL: "https://auth", Username: "slow", Region: "GRA11"}
+	fast := &Config{AuthURL: "https://auth", Username: "fast", Region: "DE1"}
+
+	slowDone := make(chan struct{})
+	go func() {
+		defer close(slowDone)
+		_, _ = SharedClient(context.Background(), slow)
+	}()
+
+	// A hanging authentication for one set of credentials must not block another
+	done := make(chan *Client)
+	go func() {
+		c, _ := SharedClient(context.Background(), fast)
+		done <- c
+	}()
+	select {
+	case c := <-done:
+		assert.Equal(t, "DE1", c.region)
+	case <-time.After(time.Second):
+		t.Fatal("SharedClient for fast waited on the slow authentication")
+	}
+
+	close(release)
+	<-slowDone
+
+	first, err := SharedClient(context.Background(), fast)
+	require.NoError(t, err)
+	second, err := SharedClient(context.Background(), fast)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
@@ -7,10 +7,18 @@ package openstack
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/httpclient"
 )
 
 // Client wraps gophercloud clients for OpenStack services
@@ -18,6 +26,25 @@ type Client struct {
 	Provider      *gophercloud.ProviderClient
 	NetworkClient *gophercloud.ServiceClient
 	ComputeClient *gophercloud.ServiceClient
+
+	// region is the default region the service clients above are bound to
+	region string
+	// availability is the endpoint interface used for every service client
+	availability gophercloud.Availability
+	// blockStorageMicroversion is set on the block storage client when built
+	blockStorageMicroversion string
+
+	mu              sync.Mutex
+	regionalNetwork map[string]*gophercloud.ServiceClient
+	regionalCompute map[string]*gophercloud.ServiceClient
+	objectStorage   map[string]*gophercloud.ServiceClient
+	image           *gophercloud.ServiceClient
+	blockStorage    *gophercloud.ServiceClient
+	keyManager      *gophercloud.ServiceClient
+
+	// networkExtensions caches the extension aliases of each Neutron endpoint
+	extensionsMu      sync.Mutex
+	networkExtensions map[string]map[string]bool
 }
 
 // Config holds OpenStack authentication configuration
@@ -29,6 +56,30 @@ type Config struct {
 	UserDomainName  string
 	ProjectDomainID string
 	Region          string
+
+	// EndpointType selects the catalog interface: public (default), internal or admin
+	EndpointType string
+
+	// Application credentials replace Username/Password when set.
+	// They are already scoped to a project, so ProjectID is not sent with them.
+	ApplicationCredentialID     string
+	ApplicationCredentialName   string
+	ApplicationCredentialSecret string
+
+	// ManagedByTag, when set, restricts List discovery to resources carrying this tag
+	ManagedByTag string
+
+	// UserAgent is prepended to gophercloud's User-Agent on every request
+	UserAgent string
+
+	// ComputeMicroversion and BlockStorageMicroversion pin the Nova and Cinder
+	// API microversions ("2.26", "3.50" or "latest"). Empty, or a bare major
+	// version as set by some RC files, keeps the service default.
+	ComputeMicroversion      string
+	BlockStorageMicroversion string
+
+	// Pool tunes connection reuse on the transport shared with other clients
+	Pool httpclient.PoolConfig
 }
 
 // ConfigFromEnv creates a Config from environment variables
@@ -41,6 +92,15 @@ func ConfigFromEnv() *Config {
 		UserDomainName:  getEnvOrDefault("OS_USER_DOMAIN_NAME", "Default"),
 		ProjectDomainID: getEnvOrDefault("OS_PROJECT_DOMAIN_ID", "default"),
 		Region:          os.Getenv("OS_REGION_NAME"),
+		EndpointType:    getEnvOrDefault("OS_INTERFACE", os.Getenv("OS_ENDPOINT_TYPE")),
+		ManagedByTag:    os.Getenv("OVH_MANAGED_BY_TAG"),
+
+		ComputeMicroversion:      os.Getenv("OS_COMPUTE_API_VERSION"),
+		BlockStorageMicroversion: os.Getenv("OS_VOLUME_API_VERSION"),
+
+		ApplicationCredentialID:     os.Getenv("OS_APPLICATION_CREDENTIAL_ID"),
+		ApplicationCredentialName:   os.Getenv("OS_APPLICATION_CREDENTIAL_NAME"),
+		ApplicationCredentialSecret: os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"),
 	}
 }
 
@@ -51,27 +111,153 @@ func getEnvOrDefault(key, defaultVal string) string {
 	return defaultVal
 }
 
-// NewClient creates a new OpenStack client from config
-func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
-	if cfg == nil {
-		return nil, fmt.Errorf("config is nil")
+// Availability returns the gophercloud endpoint interface for EndpointType.
+// The "publicURL"-style names used by older RC files are accepted as well.
+func (c *Config) Availability() (gophercloud.Availability, error) {
+	switch strings.TrimSuffix(strings.ToLower(c.EndpointType), "url") {
+	case "", "public":
+		return gophercloud.AvailabilityPublic, nil
+	case "internal":
+		return gophercloud.AvailabilityInternal, nil
+	case "admin":
+		return gophercloud.AvailabilityAdmin, nil
+	default:
+		return "", fmt.Errorf("OS_INTERFACE must be public, internal or admin, got %q", c.EndpointType)
+	}
+}
+
+// UsesApplicationCredential reports whether the config authenticates with
+// an application credential rather than a username and password.
+func (c *Config) UsesApplicationCredential() bool {
+	return c.ApplicationCredentialID != "" || c.ApplicationCredentialName != ""
+}
+
+// Validate checks that the fields needed to authenticate are set and names
+// the environment variable to fix when one is missing.
+func (c *Config) Validate() error {
+	if c.AuthURL == "" {
+		return fmt.Errorf("OS_AUTH_URL environment variable is required")
+	}
+	if c.UsesApplicationCredential() {
+		if c.ApplicationCredentialSecret == "" {
+			return fmt.Errorf("OS_APPLICATION_CREDENTIAL_SECRET environment variable is required with an application credential")
+		}
+		if c.ApplicationCredentialID == "" && c.Username == "" {
+			return fmt.Errorf("OS_USERNAME environment variable is required with OS_APPLICATION_CREDENTIAL_NAME")
+		}
+	} else {
+		if c.Username == "" {
+			return fmt.Errorf("OS_USERNAME environment variable is required (or set OS_APPLICATION_CREDENTIAL_ID)")
+		}
+		if c.Password == "" {
+			return fmt.Errorf("OS_PASSWORD environment variable is required")
+		}
+		if c.ProjectID == "" {
+			return fmt.Errorf("OS_PROJECT_ID environment variable is required")
+		}
 	}
+	if c.Region == "" {
+		return fmt.Errorf("OS_REGION_NAME environment variable is required")
+	}
+	if _, err := c.Availability(); err != nil {
+		return err
+	}
+	if _, err := microversion(c.ComputeMicroversion); err != nil {
+		return fmt.Errorf("OS_COMPUTE_API_VERSION: %w", err)
+	}
+	if _, err := microversion(c.BlockStorageMicroversion); err != nil {
+		return fmt.Errorf("OS_VOLUME_API_VERSION: %w", err)
+	}
+	return nil
+}
+
+// microversionPattern matches a "major.minor" API microversion
+var microversionPattern = regexp.MustCompile(`^\d+\.\d+$`)
 
-	opts := gophercloud.AuthOptions{
+// microversion returns the microversion to set on a service client for a
+// configured API version. A bare major version selects no microversion.
+func microversion(version string) (string, error) {
+	version = strings.TrimSpace(version)
+	switch {
+	case version == "", version == "latest", microversionPattern.MatchString(version):
+		return version, nil
+	case strings.Trim(version, "0123456789") == "":
+		return "", nil
+	default:
+		return "", fmt.Errorf("microversion must look like 2.26 or be latest, got %q", version)
+	}
+}
+
+// authOptions builds the Keystone v3 auth options for the config
+func authOptions(cfg *Config) gophercloud.AuthOptions {
+	if cfg.UsesApplicationCredential() {
+		opts := gophercloud.AuthOptions{
+			IdentityEndpoint:            cfg.AuthURL,
+			AllowReauth:                 true,
+			ApplicationCredentialID:     cfg.ApplicationCredentialID,
+			ApplicationCredentialName:   cfg.ApplicationCredentialName,
+			ApplicationCredentialSecret: cfg.ApplicationCredentialSecret,
+		}
+		// A credential looked up by name needs its owning user to be identified
+		if cfg.ApplicationCredentialID == "" {
+			opts.Username = cfg.Username
+			opts.DomainName = cfg.UserDomainName
+		}
+		return opts
+	}
+
+	return gophercloud.AuthOptions{
 		IdentityEndpoint: cfg.AuthURL,
 		Username:         cfg.Username,
 		Password:         cfg.Password,
 		TenantID:         cfg.ProjectID,
 		DomainName:       cfg.UserDomainName,
+		AllowReauth:      true,
+	}
+}
+
+// NewClient creates a new OpenStack client from config
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("config is nil")
 	}
 
-	provider, err := openstack.AuthenticatedClient(ctx, opts)
+	provider, err := authenticate(ctx, cfg)
 	if err != nil {
+		return nil, err
+	}
+	return serviceClients(provider, cfg)
+}
+
+// authenticate creates a provider client for cfg and authenticates it. The
+// provider holds the token and HTTP transport every service client shares.
+func authenticate(ctx context.Context, cfg *Config) (*gophercloud.ProviderClient, error) {
+	provider, err := openstack.NewClient(cfg.AuthURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provider client: %w", err)
+	}
+	provider.HTTPClient = http.Client{Transport: httpclient.SharedTransport(cfg.Pool)}
+	// Set before authenticating so the token request is identified as well
+	if cfg.UserAgent != "" {
+		provider.UserAgent.Prepend(cfg.UserAgent)
+	}
+	if err := openstack.Authenticate(ctx, provider, authOptions(cfg)); err != nil {
 		return nil, fmt.Errorf("failed to authenticate: %w", err)
 	}
+	return provider, nil
+}
+
+// serviceClients builds the network and compute clients for cfg's region on
+// an authenticated provider. It makes no requests.
+func serviceClients(provider *gophercloud.ProviderClient, cfg *Config) (*Client, error) {
+	availability, err := cfg.Availability()
+	if err != nil {
+		return nil, err
+	}
 
 	endpointOpts := gophercloud.EndpointOpts{
-		Region: cfg.Region,
+		Region:       cfg.Region,
+		Availability: availability,
 	}
 
 	networkClient, err := openstack.NewNetworkV2(provider, endpointOpts)
@@ -84,9 +270,172 @@ func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
 		return nil, fmt.Errorf("failed to create compute client: %w", err)
 	}
 
+	if computeClient.Microversion, err = microversion(cfg.ComputeMicroversion); err != nil {
+		return nil, fmt.Errorf("OS_COMPUTE_API_VERSION: %w", err)
+	}
+	blockStorageMicroversion, err := microversion(cfg.BlockStorageMicroversion)
+	if err != nil {
+		return nil, fmt.Errorf("OS_VOLUME_API_VERSION: %w", err)
+	}
+
 	return &Client{
-		Provider:      provider,
-		NetworkClient: networkClient,
-		ComputeClient: computeClient,
+		Provider:                 provider,
+		NetworkClient:            networkClient,
+		ComputeClient:            computeClient,
+		region:                   cfg.Region,
+		availability:             availability,
+		blockStorageMicroversion: blockStorageMicroversion,
 	}, nil
 }
+
+// ImageClient returns the Glance client for the default region, built on
+// first use so regions without an image endpoint only fail image resources.
+func (c *Client) ImageClient() (*gophercloud.ServiceClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.image != nil {
+		return c.image, nil
+	}
+
+	client, err := openstack.NewImageV2(c.Provider, c.endpointOpts(c.region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image client: %w", err)
+	}
+	c.image = client
+	return client, nil
+}
+
+// BlockStorageClient returns the Cinder client for the default region, built
+// on first use so regions without a volume endpoint only fail volume resources.
+func (c *Client) BlockStorageClient() (*gophercloud.ServiceClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.blockStorage != nil {
+		return c.blockStorage, nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(c.Provider, c.endpointOpts(c.region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create block storage client: %w", err)
+	}
+	client.Microversion = c.blockStorageMicroversion
+	c.blockStorage = client
+	return client, nil
+}
+
+// KeyManagerClient returns the Barbican client for the default region, built
+// on first use so regions without a key-manager endpoint only fail secrets.
+func (c *Client) KeyManagerClient() (*gophercloud.ServiceClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keyManager != nil {
+		return c.keyManager, nil
+	}
+
+	client, err := openstack.NewKeyManagerV1(c.Provider, c.endpointOpts(c.region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key manager client: %w", err)
+	}
+	c.keyManager = client
+	return client, nil
+}
+
+// endpointOpts selects region's endpoint on the client's configured interface
+func (c *Client) endpointOpts(region string) gophercloud.EndpointOpts {
+	return gophercloud.EndpointOpts{Region: region, Availability: c.availability}
+}
+
+// NetworkClientFor returns a network client bound to region.
+// An empty region, or the client's default region, returns NetworkClient.
+// Clients for other regions reuse the authenticated provider and are built
+// once per region, so a single credential can serve multi-region stacks.
+func (c *Client) NetworkClientFor(region string) (*gophercloud.ServiceClient, error) {
+	if region == "" || region == c.region {
+		return c.NetworkClient, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.regionalNetwork[region]; ok {
+		return client, nil
+	}
+
+	client, err := openstack.NewNetworkV2(c.Provider, c.endpointOpts(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network client for region %s: %w", region, err)
+	}
+
+	if c.regionalNetwork == nil {
+		c.regionalNetwork = make(map[string]*gophercloud.ServiceClient)
+	}
+	c.regionalNetwork[region] = client
+	return client, nil
+}
+
+// ComputeClientFor returns a compute client bound to region, with the same
+// microversion as ComputeClient. An empty region, or the client's default
+// region, returns ComputeClient.
+func (c *Client) ComputeClientFor(region string) (*gophercloud.ServiceClient, error) {
+	if region == "" || region == c.region {
+		return c.ComputeClient, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.regionalCompute[region]; ok {
+		return client, nil
+	}
+
+	client, err := openstack.NewComputeV2(c.Provider, c.endpointOpts(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compute client for region %s: %w", region, err)
+	}
+	if c.ComputeClient != nil {
+		client.Microversion = c.ComputeClient.Microversion
+	}
+
+	if c.regionalCompute == nil {
+		c.regionalCompute = make(map[string]*gophercloud.ServiceClient)
+	}
+	c.regionalCompute[region] = client
+	return client, nil
+}
+
+// ObjectStorageClientFor returns a Swift client bound to region, built on
+// first use since not every catalog has an object-store endpoint in every
+// region. OVH names object-store regions by their short form (GRA, DE), not
+// the compute region (GRA7, DE1).
+func (c *Client) ObjectStorageClientFor(region string) (*gophercloud.ServiceClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.objectStorage[region]; ok {
+		return client, nil
+	}
+
+	client, err := openstack.NewObjectStorageV1(c.Provider, c.endpointOpts(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object storage client for region %s: %w", region, err)
+	}
+
+	if c.objectStorage == nil {
+		c.objectStorage = make(map[string]*gophercloud.ServiceClient)
+	}
+	c.objectStorage[region] = client
+	return client, nil
+}
+
+// Ping checks that the token is accepted by the default region's network
+// endpoint by fetching a single network. It returns the round-trip latency.
+func (c *Client) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	err := networks.List(c.NetworkClient, networks.ListOpts{Limit: 1}).EachPage(ctx, func(context.Context, pagination.Page) (bool, error) {
+		return false, nil
+	})
+	return time.Since(start), err
+}
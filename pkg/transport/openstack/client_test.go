@@ -0,0 +1,167 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthOptions_Password(t *testing.T) {
+	opts := authOptions(&Config{
+		AuthURL:        "https://auth.cloud.ovh.net/v3",
+		Username:       "user",
+		Password:       "pass",
+		ProjectID:      "project",
+		UserDomainName: "Default",
+	})
+
+	assert.Equal(t, "user", opts.Username)
+	assert.Equal(t, "pass", opts.Password)
+	assert.Equal(t, "project", opts.TenantID)
+	assert.Empty(t, opts.ApplicationCredentialID)
+}
+
+func TestAuthOptions_ApplicationCredentialID(t *testing.T) {
+	opts := authOptions(&Config{
+		AuthURL:                     "https://auth.cloud.ovh.net/v3",
+		Username:                    "user",
+		Password:                    "pass",
+		ProjectID:                   "project",
+		ApplicationCredentialID:     "cred-id",
+		ApplicationCredentialSecret: "cred-secret",
+	})
+
+	assert.Equal(t, "cred-id", opts.ApplicationCredentialID)
+	assert.Equal(t, "cred-secret", opts.ApplicationCredentialSecret)
+	// The credential carries its own scope and identity
+	assert.Empty(t, opts.Username)
+	assert.Empty(t, opts.Password)
+	assert.Empty(t, opts.TenantID)
+}
+
+func TestAuthOptions_ApplicationCredentialName(t *testing.T) {
+	opts := authOptions(&Config{
+		Username:                    "user",
+		Password:                    "pass",
+		UserDomainName:              "Default",
+		ApplicationCredentialName:   "ci",
+		ApplicationCredentialSecret: "cred-secret",
+	})
+
+	assert.Equal(t, "ci", opts.ApplicationCredentialName)
+	assert.Equal(t, "user", opts.Username)
+	assert.Equal(t, "Default", opts.DomainName)
+	assert.Empty(t, opts.Password)
+}
+
+func TestNetworkClientFor_DefaultRegion(t *testing.T) {
+	networkClient := &gophercloud.ServiceClient{}
+	client := &Client{NetworkClient: networkClient, region: "GRA11"}
+
+	for _, region := range []string{"", "GRA11"} {
+		got, err := client.NetworkClientFor(region)
+		assert.NoError(t, err)
+		assert.Same(t, networkClient, got, "region %q should use the default client", region)
+	}
+}
+
+func TestComputeClientFor(t *testing.T) {
+	computeClient := &gophercloud.ServiceClient{Microversion: "2.26"}
+	provider := &gophercloud.ProviderClient{
+		EndpointLocator: func(opts gophercloud.EndpointOpts) (string, error) {
+			return "https://compute." + opts.Region + ".example/v2.1/", nil
+		},
+	}
+	client := &Client{Provider: provider, ComputeClient: computeClient, region: "GRA11"}
+
+	for _, region := range []string{"", "GRA11"} {
+		got, err := client.ComputeClientFor(region)
+		assert.NoError(t, err)
+		assert.Same(t, computeClient, got, "region %q should use the default client", region)
+	}
+
+	regional, err := client.ComputeClientFor("BHS5")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://compute.BHS5.example/v2.1/", regional.Endpoint)
+	assert.Equal(t, "2.26", regional.Microversion)
+
+	again, err := client.ComputeClientFor("BHS5")
+	assert.NoError(t, err)
+	assert.Same(t, regional, again)
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := Config{
+		AuthURL:   "https://auth.cloud.ovh.net/v3",
+		Username:  "user",
+		Password:  "pass",
+		ProjectID: "project",
+		Region:    "GRA7",
+	}
+	assert.NoError(t, valid.Validate())
+
+	missingProject := valid
+	missingProject.ProjectID = ""
+	assert.ErrorContains(t, missingProject.Validate(), "OS_PROJECT_ID")
+
+	missingRegion := valid
+	missingRegion.Region = ""
+	assert.ErrorContains(t, missingRegion.Validate(), "OS_REGION_NAME")
+
+	appCred := Config{
+		AuthURL:                     "https://auth.cloud.ovh.net/v3",
+		ApplicationCredentialID:     "cred-id",
+		ApplicationCredentialSecret: "secret",
+		Region:                      "GRA7",
+	}
+	assert.NoError(t, appCred.Validate())
+
+	appCred.ApplicationCredentialSecret = ""
+	assert.ErrorContains(t, appCred.Validate(), "OS_APPLICATION_CREDENTIAL_SECRET")
+
+	badInterface := valid
+	badInterface.EndpointType = "private"
+	assert.ErrorContains(t, badInterface.Validate(), "OS_INTERFACE")
+
+	badMicroversion := valid
+	badMicroversion.BlockStorageMicroversion = "v3.50"
+	assert.ErrorContains(t, badMicroversion.Validate(), "OS_VOLUME_API_VERSION")
+}
+
+func TestMicroversion(t *testing.T) {
+	tests := map[string]string{
+		"":       "",
+		"2.26":   "2.26",
+		" 3.50 ": "3.50",
+		"latest": "latest",
+		"2":      "",
+	}
+	for version, want := range tests {
+		got, err := microversion(version)
+		assert.NoError(t, err, "version %q", version)
+		assert.Equal(t, want, got, "version %q", version)
+	}
+
+	_, err := microversion("2.x")
+	assert.Error(t, err)
+}
+
+func TestConfigAvailability(t *testing.T) {
+	tests := map[string]gophercloud.Availability{
+		"":            gophercloud.AvailabilityPublic,
+		"public":      gophercloud.AvailabilityPublic,
+		"internal":    gophercloud.AvailabilityInternal,
+		"internalURL": gophercloud.AvailabilityInternal,
+		"admin":       gophercloud.AvailabilityAdmin,
+	}
+	for endpointType, want := range tests {
+		got, err := (&Config{EndpointType: endpointType}).Availability()
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, "endpoint type %q", endpointType)
+	}
+}
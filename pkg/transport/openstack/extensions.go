@@ -0,0 +1,60 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/warnings"
+)
+
+// NetworkTagExtension is the Neutron extension that adds tags to networks,
+// subnets, ports, routers and the other standard resources
+const NetworkTagExtension = "standard-attr-tag"
+
+// HasNetworkExtension reports whether the Neutron endpoint behind netClient
+// offers the extension alias. The extension list is fetched once per
+// endpoint, so private OpenStack deployments without an extension skip the
+// calls needing it instead of failing on every operation. If the list cannot
+// be fetched the extension is assumed present and fetched again next time.
+func (c *Client) HasNetworkExtension(ctx context.Context, netClient *gophercloud.ServiceClient, alias string) bool {
+	c.extensionsMu.Lock()
+	defer c.extensionsMu.Unlock()
+
+	aliases, ok := c.networkExtensions[netClient.Endpoint]
+	if !ok {
+		var err error
+		aliases, err = listNetworkExtensions(ctx, netClient)
+		if err != nil {
+			warnings.Warnf(ctx, "failed to list network extensions at %s: %v", netClient.Endpoint, err)
+			return true
+		}
+		if c.networkExtensions == nil {
+			c.networkExtensions = make(map[string]map[string]bool)
+		}
+		c.networkExtensions[netClient.Endpoint] = aliases
+	}
+	return aliases[alias]
+}
+
+// listNetworkExtensions returns the aliases of the extensions netClient offers
+func listNetworkExtensions(ctx context.Context, netClient *gophercloud.ServiceClient) (map[string]bool, error) {
+	allPages, err := extensions.List(netClient).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := extensions.ExtractExtensions(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[string]bool, len(list))
+	for _, extension := range list {
+		aliases[extension.Alias] = true
+	}
+	return aliases, nil
+}
@@ -0,0 +1,55 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newExtensionsServer(t *testing.T, status int, body string) (*gophercloud.ServiceClient, *int) {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}, &calls
+}
+
+func TestHasNetworkExtension_ListsOncePerEndpoint(t *testing.T) {
+	netClient, calls := newExtensionsServer(t, http.StatusOK, `{"extensions": [{"alias": "standard-attr-tag", "name": "Tag support"}, {"alias": "qos"}]}`)
+	client := &Client{}
+	ctx := context.Background()
+
+	assert.True(t, client.HasNetworkExtension(ctx, netClient, NetworkTagExtension))
+	assert.True(t, client.HasNetworkExtension(ctx, netClient, "qos"))
+	assert.False(t, client.HasNetworkExtension(ctx, netClient, "trunk"))
+	assert.Equal(t, 1, *calls)
+}
+
+func TestHasNetworkExtension_AssumesPresentWhenListFails(t *testing.T) {
+	netClient, calls := newExtensionsServer(t, http.StatusInternalServerError, `{}`)
+	client := &Client{}
+	ctx := context.Background()
+
+	assert.True(t, client.HasNetworkExtension(ctx, netClient, NetworkTagExtension))
+	// A failed list is not cached
+	assert.True(t, client.HasNetworkExtension(ctx, netClient, NetworkTagExtension))
+	assert.Equal(t, 2, *calls)
+}
@@ -0,0 +1,87 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// serverTagsMicroversion is the first Nova microversion with server tags
+const serverTagsMicroversion = "2.26"
+
+// ServerTagsClient returns a copy of computeClient that speaks a Nova
+// microversion with server tags, unless one is already pinned
+func ServerTagsClient(computeClient *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	client := *computeClient
+	if client.Microversion == "" {
+		client.Microversion = serverTagsMicroversion
+	}
+	return &client
+}
+
+// ManagedServerIDs returns the IDs of the servers in the default region
+// carrying tag. OVH instance IDs are Nova server IDs, so the result filters
+// instances listed through the OVH API.
+func (c *Client) ManagedServerIDs(ctx context.Context, tag string) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	err := servers.List(ServerTagsClient(c.ComputeClient), servers.ListOpts{Tags: tag}).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		list, err := servers.ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		for _, server := range list {
+			ids[server.ID] = true
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers tagged %s: %w", tag, err)
+	}
+	return ids, nil
+}
+
+// ManagedVolumeIDs returns the IDs of the volumes in the default region whose
+// metadata holds tag as "key=value", or holds the key at all when tag has no
+// value. Cinder volumes have no tags, so the managed-by tag is matched against
+// metadata instead.
+func (c *Client) ManagedVolumeIDs(ctx context.Context, tag string) (map[string]bool, error) {
+	client, err := c.BlockStorageClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Cinder only filters on exact key and value, so a bare key is matched
+	// on the listed metadata instead
+	key, value, hasValue := strings.Cut(tag, "=")
+	opts := volumes.ListOpts{}
+	if hasValue {
+		opts.Metadata = map[string]string{key: value}
+	}
+
+	ids := make(map[string]bool)
+	err = volumes.List(client, opts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		list, err := volumes.ExtractVolumes(page)
+		if err != nil {
+			return false, err
+		}
+		for _, volume := range list {
+			if _, ok := volume.Metadata[key]; ok || hasValue {
+				ids[volume.ID] = true
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes with metadata %s: %w", tag, err)
+	}
+	return ids, nil
+}
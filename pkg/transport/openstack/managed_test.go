@@ -0,0 +1,57 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeCinder serves a volume list and records each list query
+func newFakeCinder(t *testing.T, queries *[]string) *gophercloud.ServiceClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"volumes": [
+			{"id": "managed", "metadata": {"formae": "true"}},
+			{"id": "bare", "metadata": {"formae": ""}},
+			{"id": "unmanaged", "metadata": {"team": "data"}}
+		]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+}
+
+func TestManagedVolumeIDs_BareKeyMatchesKeyPresence(t *testing.T) {
+	var queries []string
+	c := &Client{blockStorage: newFakeCinder(t, &queries)}
+
+	ids, err := c.ManagedVolumeIDs(context.Background(), "formae")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"managed": true, "bare": true}, ids)
+	assert.NotContains(t, queries[0], "metadata")
+}
+
+func TestManagedVolumeIDs_KeyValueFiltersInCinder(t *testing.T) {
+	var queries []string
+	c := &Client{blockStorage: newFakeCinder(t, &queries)}
+
+	_, err := c.ManagedVolumeIDs(context.Background(), "formae=true")
+	require.NoError(t, err)
+	assert.Contains(t, queries[0], "metadata=")
+}
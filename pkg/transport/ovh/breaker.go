@@ -0,0 +1,112 @@
+// pkg/transport/ovh/breaker.go
+package ovh
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultCircuitBreakerCooldown is how long calls are refused once the
+// breaker opens, used when none is configured
+const DefaultCircuitBreakerCooldown = 30 * time.Second
+
+// circuitBreakerWindow is the longest gap between two server errors for them
+// to count as consecutive. Isolated errors further apart never open the breaker.
+const circuitBreakerWindow = time.Minute
+
+// circuitBreaker stops calling an API that keeps answering with server
+// errors. After threshold consecutive 5xx responses it opens and refuses
+// every call for cooldown, so a large apply fails fast during an OVH
+// incident instead of each resource retrying against a degraded API. When
+// the cooldown ends one error is enough to open it again, while any other
+// response closes it.
+type circuitBreaker struct {
+	mu          sync.Mutex
+	threshold   int
+	cooldown    time.Duration
+	failures    int
+	lastFailure time.Time
+	openUntil   time.Time
+	now         func() time.Time
+}
+
+// newCircuitBreaker opens after threshold consecutive server errors
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if cooldown <= 0 {
+		cooldown = DefaultCircuitBreakerCooldown
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow returns an error while the breaker is open
+func (b *circuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	remaining := b.openUntil.Sub(b.now())
+	if remaining <= 0 {
+		return nil
+	}
+	return &Error{
+		Code: ErrorCodeServiceUnavailable,
+		Message: fmt.Sprintf("OVH API unavailable: %d consecutive server errors, calls paused for another %s",
+			b.failures, remaining.Round(time.Second)),
+	}
+}
+
+// Record counts the outcome of a call by its HTTP status; zero means no
+// response was received, which says nothing about the API's health
+func (b *circuitBreaker) Record(status int) {
+	if status == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if status < 500 {
+		b.failures = 0
+		b.openUntil = time.Time{}
+		return
+	}
+
+	now := b.now()
+	if b.failures > 0 && now.Sub(b.lastFailure) > circuitBreakerWindow {
+		b.failures = 0
+	}
+	b.failures++
+	b.lastFailure = now
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = make(map[string]*circuitBreaker)
+)
+
+// sharedCircuitBreaker returns the breaker for an endpoint and application
+// key, or nil when threshold is not positive. Like the rate limiter, it must
+// outlive a single Client to see the errors of a whole stack apply.
+func sharedCircuitBreaker(endpoint, applicationKey string, threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	key := endpoint + "/" + applicationKey
+	b := newCircuitBreaker(threshold, cooldown)
+	if existing, ok := breakers[key]; ok && existing.threshold == b.threshold && existing.cooldown == b.cooldown {
+		return existing
+	}
+	breakers[key] = b
+	return b
+}
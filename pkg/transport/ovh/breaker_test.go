@@ -0,0 +1,105 @@
+// pkg/transport/ovh/breaker_test.go
+package ovh
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable time source for the breaker
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestBreaker(threshold int, cooldown time.Duration) (*circuitBreaker, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b := newCircuitBreaker(threshold, cooldown)
+	b.now = clock.now
+	return b, clock
+}
+
+func TestCircuitBreaker_OpensAfterConsecutiveServerErrors(t *testing.T) {
+	b, clock := newTestBreaker(3, 30*time.Second)
+
+	b.Record(500)
+	b.Record(503)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() error = %v after 2 of 3 server errors", err)
+	}
+
+	b.Record(502)
+	err := b.Allow()
+	var transportErr *Error
+	if !errors.As(err, &transportErr) || transportErr.Code != ErrorCodeServiceUnavailable {
+		t.Fatalf("Allow() error = %v, want %s", err, ErrorCodeServiceUnavailable)
+	}
+	if !strings.Contains(transportErr.Message, "OVH API unavailable") {
+		t.Errorf("Allow() message = %q, want it to say the API is unavailable", transportErr.Message)
+	}
+
+	// After the cooldown calls go through again, but one error reopens it
+	clock.t = clock.t.Add(31 * time.Second)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() error = %v after cooldown", err)
+	}
+	b.Record(500)
+	if err := b.Allow(); err == nil {
+		t.Error("Allow() = nil, want the breaker to reopen on a server error after cooldown")
+	}
+}
+
+func TestCircuitBreaker_ResetBySuccessOrClientError(t *testing.T) {
+	b, _ := newTestBreaker(2, 0)
+
+	b.Record(500)
+	b.Record(404)
+	b.Record(500)
+	if err := b.Allow(); err != nil {
+		t.Errorf("Allow() error = %v, want errors separated by a response to not count as consecutive", err)
+	}
+
+	// Calls that got no response neither count nor reset
+	b.Record(0)
+	b.Record(500)
+	if err := b.Allow(); err == nil {
+		t.Error("Allow() = nil, want open after 2 consecutive server errors")
+	}
+
+	b.Record(200)
+	if err := b.Allow(); err != nil {
+		t.Errorf("Allow() error = %v after a successful call", err)
+	}
+	if b.cooldown != DefaultCircuitBreakerCooldown {
+		t.Errorf("cooldown = %v, want default %v", b.cooldown, DefaultCircuitBreakerCooldown)
+	}
+}
+
+func TestCircuitBreaker_ErrorsOutsideWindowDoNotAccumulate(t *testing.T) {
+	b, clock := newTestBreaker(2, time.Minute)
+
+	b.Record(500)
+	clock.t = clock.t.Add(circuitBreakerWindow + time.Second)
+	b.Record(500)
+	if err := b.Allow(); err != nil {
+		t.Errorf("Allow() error = %v, want errors further apart than the window to not open the breaker", err)
+	}
+}
+
+func TestSharedCircuitBreaker(t *testing.T) {
+	if b := sharedCircuitBreaker("ovh-eu", "breaker-key", 0, 0); b != nil {
+		t.Error("sharedCircuitBreaker() returned a breaker with the threshold unset")
+	}
+
+	a := sharedCircuitBreaker("ovh-eu", "breaker-key", 5, time.Minute)
+	b := sharedCircuitBreaker("ovh-eu", "breaker-key", 5, time.Minute)
+	if a != b {
+		t.Error("sharedCircuitBreaker() returned different breakers for the same application")
+	}
+
+	c := sharedCircuitBreaker("ovh-eu", "other-breaker-key", 5, time.Minute)
+	if a == c {
+		t.Error("sharedCircuitBreaker() shared a breaker across applications")
+	}
+}
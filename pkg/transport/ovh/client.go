@@ -5,13 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/ovh/go-ovh/ovh"
+	"github.com/platform-engineering-labs/formae-plugin-ovh/pkg/transport/httpclient"
 )
 
 // Client wraps go-ovh for the REST architecture
 type Client struct {
-	ovh *ovh.Client
+	ovh     *ovh.Client
+	limiter *rateLimiter
+	logger  *requestLogger
+	cache   *readCache      // nil unless ReadCache is set
+	breaker *circuitBreaker // nil unless CircuitBreakerThreshold is set
+
+	requestTimeout      time.Duration
+	autoActivateRegions bool
 }
 
 // RequestOptions defines options for an API request
@@ -19,6 +28,10 @@ type RequestOptions struct {
 	Method string
 	Path   string
 	Body   interface{} // Can be map[string]interface{} or []interface{} for array bodies
+
+	// Timeout bounds this call alone, overriding the client's RequestTimeout.
+	// The caller's context deadline still applies if it is sooner.
+	Timeout time.Duration
 }
 
 // Response represents an API response
@@ -34,8 +47,50 @@ type OVHConfig struct {
 	ApplicationKey    string
 	ApplicationSecret string
 	ConsumerKey       string
+
+	// RequestsPerSecond caps the client-side request rate.
+	// Defaults to DefaultRequestsPerSecond when zero or negative.
+	RequestsPerSecond float64
+
+	// LogLevel enables request logging to stderr. Defaults to LogLevelOff.
+	LogLevel LogLevel
+
+	// RequestTimeout bounds each HTTP call, independently of the overall
+	// operation deadline. Defaults to DefaultRequestTimeout when zero or negative.
+	RequestTimeout time.Duration
+
+	// UserAgent identifies the plugin in the User-Agent header
+	UserAgent string
+
+	// AutoActivateRegions activates a region on the project, then retries,
+	// when a create fails because the region is not activated yet.
+	AutoActivateRegions bool
+
+	// Pool tunes connection reuse on the transport shared with other clients
+	Pool httpclient.PoolConfig
+
+	// ReadCache answers repeated catalog lookups (flavors, images, regions,
+	// private networks) from memory for the lifetime of the client
+	ReadCache bool
+
+	// ClockSkew is how far the API clock is ahead of the local one, used to
+	// timestamp signed requests. When nil it is measured through /auth/time
+	// once per endpoint and reused.
+	ClockSkew *time.Duration
+
+	// CircuitBreakerThreshold is how many consecutive server errors pause
+	// all calls to the endpoint for CircuitBreakerCooldown. Disabled when
+	// zero or negative.
+	CircuitBreakerThreshold int
+
+	// CircuitBreakerCooldown is how long calls are paused once the breaker
+	// opens. Defaults to DefaultCircuitBreakerCooldown when zero or negative.
+	CircuitBreakerCooldown time.Duration
 }
 
+// DefaultRequestTimeout is the per-call timeout used when none is configured
+const DefaultRequestTimeout = 60 * time.Second
+
 // NewClient creates a new OVH API client from config
 func NewClient(cfg *OVHConfig) (*Client, error) {
 	if cfg == nil {
@@ -51,14 +106,85 @@ func NewClient(cfg *OVHConfig) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OVH client: %w", err)
 	}
-	return &Client{ovh: ovhClient}, nil
+	ovhClient.UserAgent = cfg.UserAgent
+	ovhClient.Client.Transport = newClockTransport(httpclient.SharedTransport(cfg.Pool), cfg.ClockSkew)
+
+	rps := cfg.RequestsPerSecond
+	if rps <= 0 {
+		rps = DefaultRequestsPerSecond
+	}
+
+	timeout := cfg.RequestTimeout
+	if timeout <= 0 {
+		timeout = DefaultRequestTimeout
+	}
+
+	var cache *readCache
+	if cfg.ReadCache {
+		cache = newReadCache()
+	}
+
+	return &Client{
+		ovh:                 ovhClient,
+		limiter:             sharedRateLimiter(endpoint, cfg.ApplicationKey, rps),
+		logger:              newRequestLogger(cfg.LogLevel, nil),
+		cache:               cache,
+		breaker:             sharedCircuitBreaker(endpoint, cfg.ApplicationKey, cfg.CircuitBreakerThreshold, cfg.CircuitBreakerCooldown),
+		requestTimeout:      timeout,
+		autoActivateRegions: cfg.AutoActivateRegions,
+	}, nil
 }
 
 // Do executes an API request
 func (c *Client) Do(ctx context.Context, opts RequestOptions) (*Response, error) {
+	resp, err := c.do(ctx, opts)
+	if err != nil && c.autoActivateRegions {
+		if project, region, ok := regionToActivate(opts, err); ok {
+			return c.activateRegionAndRetry(ctx, opts, project, region)
+		}
+	}
+	return resp, err
+}
+
+// do executes a single API request
+func (c *Client) do(ctx context.Context, opts RequestOptions) (*Response, error) {
+	cacheable := c.cache != nil && opts.Method == "GET" && isCacheable(opts.Path)
+	if cacheable {
+		if raw, ok := c.cache.get(opts.Path); ok {
+			return c.parseResponse(raw)
+		}
+	} else if c.cache != nil && opts.Method != "GET" {
+		c.cache.invalidate()
+	}
+
+	if c.breaker != nil {
+		if err := c.breaker.Allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, c.classifyError(err)
+		}
+	}
+
+	// Bound the HTTP call itself so a wedged connection can't stall the
+	// whole operation. Rate limiting above is not part of the budget.
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = c.requestTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	var result json.RawMessage
 	var err error
 
+	start := time.Now()
 	switch opts.Method {
 	case "GET":
 		err = c.ovh.GetWithContext(ctx, opts.Path, &result)
@@ -66,19 +192,53 @@ func (c *Client) Do(ctx context.Context, opts RequestOptions) (*Response, error)
 		err = c.ovh.PostWithContext(ctx, opts.Path, opts.Body, &result)
 	case "PUT":
 		err = c.ovh.PutWithContext(ctx, opts.Path, opts.Body, &result)
+	case "PATCH":
+		err = c.ovh.CallAPIWithContext(ctx, "PATCH", opts.Path, opts.Body, &result, true)
 	case "DELETE":
 		err = c.ovh.DeleteWithContext(ctx, opts.Path, &result)
 	default:
 		return nil, fmt.Errorf("unsupported method: %s", opts.Method)
 	}
 
+	if c.logger != nil {
+		c.logger.logCall(TraceIDFromContext(ctx), opts, responseStatus(err), time.Since(start), result, err)
+	}
+	if c.breaker != nil {
+		c.breaker.Record(responseStatus(err))
+	}
+
 	if err != nil {
 		return nil, c.classifyError(err)
 	}
 
+	if cacheable {
+		c.cache.put(opts.Path, result)
+	}
+
 	return c.parseResponse(result)
 }
 
+// Ping verifies the credentials with a signed GET /auth/currentCredential,
+// the cheapest call that fails when any of the three keys is wrong or the
+// consumer key has expired. It returns the round-trip latency.
+func (c *Client) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	_, err := c.Do(ctx, RequestOptions{Method: "GET", Path: "/auth/currentCredential"})
+	return time.Since(start), err
+}
+
+// responseStatus returns the HTTP status for a completed call.
+// go-ovh only exposes the status code for API errors; zero means no response.
+func responseStatus(err error) int {
+	if err == nil {
+		return 200
+	}
+	if apiErr, ok := err.(*ovh.APIError); ok {
+		return apiErr.Code
+	}
+	return 0
+}
+
 // parseResponse converts raw JSON to Response
 func (c *Client) parseResponse(raw json.RawMessage) (*Response, error) {
 	if len(raw) == 0 {
@@ -112,11 +272,16 @@ func (c *Client) classifyError(err error) error {
 
 	// go-ovh returns APIError for HTTP errors
 	if apiErr, ok := err.(*ovh.APIError); ok {
-		code := ClassifyHTTPStatus(apiErr.Code)
+		code, hint := ClassifyOVHError(apiErr.Class, apiErr.Code)
+		message := apiErr.Message
+		if hint != "" {
+			message = fmt.Sprintf("%s (%s)", message, hint)
+		}
 		return &Error{
 			Code:       code,
-			Message:    apiErr.Message,
+			Message:    message,
 			HTTPCode:   apiErr.Code,
+			Class:      apiErr.Class,
 			Underlying: err,
 		}
 	}
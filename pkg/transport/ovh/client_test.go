@@ -2,8 +2,14 @@
 package ovh
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -55,3 +61,145 @@ func TestResponseStructure(t *testing.T) {
 		t.Errorf("Body[name] = %v, want test", resp.Body["name"])
 	}
 }
+
+// newSlowServer serves /auth/time immediately and stalls every other call
+func newSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		select {
+		case <-time.After(delay):
+			w.Write([]byte("{}"))
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDo_RequestTimeout(t *testing.T) {
+	server := newSlowServer(t, 5*time.Second)
+
+	client, err := NewClient(&OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+		RequestTimeout:    50 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	start := time.Now()
+	_, err = client.Do(context.Background(), RequestOptions{Method: "GET", Path: "/me"})
+	if err == nil {
+		t.Fatal("Do() expected timeout error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Do() took %v, expected the request timeout to cut it short", elapsed)
+	}
+}
+
+func TestDo_PerCallTimeoutOverridesClient(t *testing.T) {
+	server := newSlowServer(t, 100*time.Millisecond)
+
+	client, err := NewClient(&OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+		RequestTimeout:    20 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = client.Do(context.Background(), RequestOptions{Method: "GET", Path: "/me", Timeout: 5 * time.Second})
+	if err != nil {
+		t.Errorf("Do() error = %v, expected the per-call timeout to allow the slow response", err)
+	}
+}
+
+func TestPing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/auth/time":
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+		case r.URL.Path == "/auth/currentCredential" && r.Header.Get("X-Ovh-Consumer") == "valid":
+			w.Write([]byte(`{"credentialId":1,"status":"validated"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"class":"Client::Forbidden::InvalidCredential","message":"Invalid credential"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	newClient := func(consumerKey string) *Client {
+		client, err := NewClient(&OVHConfig{
+			Endpoint:          server.URL,
+			ApplicationKey:    "key",
+			ApplicationSecret: "secret",
+			ConsumerKey:       consumerKey,
+			RequestsPerSecond: 1000,
+		})
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		return client
+	}
+
+	latency, err := newClient("valid").Ping(context.Background())
+	if err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if latency <= 0 {
+		t.Errorf("Ping() latency = %v, want > 0", latency)
+	}
+
+	_, err = newClient("revoked").Ping(context.Background())
+	transportErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Ping() error = %v, want *Error", err)
+	}
+	if transportErr.Code != ErrorCodeInvalidCredential {
+		t.Errorf("Ping() error code = %v, want %v", transportErr.Code, ErrorCodeInvalidCredential)
+	}
+}
+
+func TestDo_UserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&OVHConfig{
+		Endpoint:          server.URL,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+		UserAgent:         "formae-plugin-ovh/1.2.3 ci",
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.Do(context.Background(), RequestOptions{Method: "GET", Path: "/me"}); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if !strings.Contains(userAgent, "formae-plugin-ovh/1.2.3 ci") {
+		t.Errorf("User-Agent = %q, want it to contain the configured user agent", userAgent)
+	}
+}
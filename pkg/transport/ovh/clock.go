@@ -0,0 +1,98 @@
+// pkg/transport/ovh/clock.go
+package ovh
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clockSkewTTL is how long a measured clock skew is reused before the API
+// clock is asked again
+const clockSkewTTL = time.Hour
+
+// measuredSkew is the API clock minus the local clock, as measured for one
+// endpoint
+type measuredSkew struct {
+	skew     time.Duration
+	measured time.Time
+}
+
+var (
+	clockSkewsMu sync.Mutex
+	clockSkews   = make(map[string]measuredSkew)
+)
+
+// clockTransport answers the GET /auth/time call go-ovh makes before it
+// signs its first request. go-ovh subtracts the local clock from that time
+// to timestamp X-Ovh-Timestamp, so a drifting local clock (common on CI
+// runners) does not invalidate signatures. Clients are built per operation,
+// so without this every operation would ask the API clock again. The skew
+// is measured once per endpoint and reused, or taken from override.
+type clockTransport struct {
+	base     http.RoundTripper
+	override *time.Duration
+	now      func() time.Time
+}
+
+func newClockTransport(base http.RoundTripper, override *time.Duration) *clockTransport {
+	return &clockTransport{base: base, override: override, now: time.Now}
+}
+
+func (t *clockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Path, "/auth/time") {
+		return t.base.RoundTrip(req)
+	}
+
+	if t.override != nil {
+		return t.timeResponse(req, *t.override), nil
+	}
+
+	key := req.URL.Host + req.URL.Path
+	clockSkewsMu.Lock()
+	cached, ok := clockSkews[key]
+	clockSkewsMu.Unlock()
+	if ok && t.now().Sub(cached.measured) < clockSkewTTL {
+		return t.timeResponse(req, cached.skew), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if serverTime, err := strconv.ParseInt(strings.TrimSpace(string(body)), 10, 64); err == nil {
+		now := t.now()
+		clockSkewsMu.Lock()
+		clockSkews[key] = measuredSkew{skew: time.Unix(serverTime, 0).Sub(now), measured: now}
+		clockSkewsMu.Unlock()
+	}
+	return resp, nil
+}
+
+// timeResponse is the /auth/time reply for the local clock shifted by skew
+func (t *clockTransport) timeResponse(req *http.Request, skew time.Duration) *http.Response {
+	body := fmt.Sprintf("%d", t.now().Add(skew).Unix())
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,108 @@
+// pkg/transport/ovh/clock_test.go
+package ovh
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func authTime(t *testing.T, transport http.RoundTripper, url string) int64 {
+	t.Helper()
+	resp, err := (&http.Client{Transport: transport}).Get(url + "/1.0/auth/time")
+	if err != nil {
+		t.Fatalf("GET /auth/time error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	serverTime, err := strconv.ParseInt(string(body), 10, 64)
+	if err != nil {
+		t.Fatalf("GET /auth/time body %q: %v", body, err)
+	}
+	return serverTime
+}
+
+func TestClockTransport_MeasuresSkewOnce(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		fmt.Fprintf(w, "%d", time.Now().Add(-time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	// Each operation builds a new client, and so a new transport
+	for i := 0; i < 3; i++ {
+		serverTime := authTime(t, newClockTransport(http.DefaultTransport, nil), server.URL)
+		want := time.Now().Add(-time.Hour).Unix()
+		if serverTime < want-2 || serverTime > want+2 {
+			t.Errorf("call %d: server time = %d, want about %d", i, serverTime, want)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("/auth/time reached the API %d times, want 1", got)
+	}
+}
+
+func TestClockTransport_Override(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		fmt.Fprintf(w, "%d", time.Now().Unix())
+	}))
+	defer server.Close()
+
+	skew := 30 * time.Second
+	serverTime := authTime(t, newClockTransport(http.DefaultTransport, &skew), server.URL)
+	if want := time.Now().Add(skew).Unix(); serverTime < want-2 || serverTime > want+2 {
+		t.Errorf("server time = %d, want about %d", serverTime, want)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("/auth/time reached the API %d times with an override, want 0", got)
+	}
+}
+
+func TestClockTransport_RemeasuresAfterTTL(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		fmt.Fprintf(w, "%d", time.Now().Unix())
+	}))
+	defer server.Close()
+
+	authTime(t, newClockTransport(http.DefaultTransport, nil), server.URL)
+
+	later := newClockTransport(http.DefaultTransport, nil)
+	later.now = func() time.Time { return time.Now().Add(clockSkewTTL + time.Minute) }
+	authTime(t, later, server.URL)
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("/auth/time reached the API %d times, want 2", got)
+	}
+}
+
+func TestClockTransport_PassesOtherRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	skew := time.Minute
+	client := &http.Client{Transport: newClockTransport(http.DefaultTransport, &skew)}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/1.0/me")
+		if err != nil {
+			t.Fatalf("GET /me error = %v", err)
+		}
+		resp.Body.Close()
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("server saw %d requests, want 2", got)
+	}
+}
@@ -3,6 +3,7 @@ package ovh
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 )
@@ -11,14 +12,18 @@ import (
 type ErrorCode string
 
 const (
-	ErrorCodeNone              ErrorCode = "NONE"
-	ErrorCodeInvalidInput      ErrorCode = "INVALID_INPUT"
-	ErrorCodeUnauthorized      ErrorCode = "UNAUTHORIZED"
-	ErrorCodeResourceNotFound  ErrorCode = "RESOURCE_NOT_FOUND"
-	ErrorCodeAlreadyExists     ErrorCode = "ALREADY_EXISTS"
-	ErrorCodeThrottling        ErrorCode = "THROTTLING"
-	ErrorCodeInternalError     ErrorCode = "INTERNAL_ERROR"
-	ErrorCodeUnknown           ErrorCode = "UNKNOWN"
+	ErrorCodeNone               ErrorCode = "NONE"
+	ErrorCodeInvalidInput       ErrorCode = "INVALID_INPUT"
+	ErrorCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
+	ErrorCodeResourceNotFound   ErrorCode = "RESOURCE_NOT_FOUND"
+	ErrorCodeAlreadyExists      ErrorCode = "ALREADY_EXISTS"
+	ErrorCodeThrottling         ErrorCode = "THROTTLING"
+	ErrorCodeInternalError      ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeQuotaExceeded      ErrorCode = "QUOTA_EXCEEDED"
+	ErrorCodeRegionNotEnabled   ErrorCode = "REGION_NOT_ENABLED"
+	ErrorCodeInvalidCredential  ErrorCode = "INVALID_CREDENTIAL"
+	ErrorCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrorCodeUnknown            ErrorCode = "UNKNOWN"
 )
 
 // Error represents a transport layer error with classification
@@ -26,6 +31,7 @@ type Error struct {
 	Code       ErrorCode
 	Message    string
 	HTTPCode   int
+	Class      string // OVH error class, e.g. "Client::Forbidden::RegionNotEnabled"
 	Underlying error
 }
 
@@ -62,6 +68,58 @@ func ClassifyHTTPStatus(statusCode int) ErrorCode {
 	}
 }
 
+// classRule maps OVH error classes to a more precise error code than the
+// HTTP status alone, with a hint on how to resolve the error.
+type classRule struct {
+	keywords []string // matched case-insensitively against the last class segment
+	code     ErrorCode
+	hint     string
+}
+
+var classRules = []classRule{
+	{
+		keywords: []string{"quota"},
+		code:     ErrorCodeQuotaExceeded,
+		hint:     "project quota exceeded; free up resources or request a quota increase",
+	},
+	{
+		keywords: []string{"regionnotenabled", "regionnotactivated", "regionnotavailable"},
+		code:     ErrorCodeRegionNotEnabled,
+		hint:     "region is not activated for this project; activate it in the OVH control panel or set AutoActivateRegions in the target config",
+	},
+	{
+		keywords: []string{"invalidcredential", "invalidkey", "notcredential", "invalidsignature"},
+		code:     ErrorCodeInvalidCredential,
+		hint:     "OVH API credentials were rejected; check the application key, secret and consumer key",
+	},
+}
+
+// ClassifyOVHError maps an OVH error class to an error code, falling back to
+// the HTTP status when the class is empty or unknown. It also returns a hint
+// for known classes, or "" otherwise.
+func ClassifyOVHError(class string, statusCode int) (ErrorCode, string) {
+	if class != "" {
+		segment := strings.ToLower(classSegment(class))
+		for _, rule := range classRules {
+			for _, keyword := range rule.keywords {
+				if strings.Contains(segment, keyword) {
+					return rule.code, rule.hint
+				}
+			}
+		}
+	}
+	return ClassifyHTTPStatus(statusCode), ""
+}
+
+// classSegment returns the last "::" separated segment of an OVH error class,
+// e.g. "NotFound" for "Client::NotFound"
+func classSegment(class string) string {
+	if i := strings.LastIndex(class, "::"); i >= 0 {
+		return class[i+len("::"):]
+	}
+	return class
+}
+
 // ToResourceErrorCode converts transport error code to formae resource error code
 func ToResourceErrorCode(code ErrorCode) resource.OperationErrorCode {
 	switch code {
@@ -77,6 +135,16 @@ func ToResourceErrorCode(code ErrorCode) resource.OperationErrorCode {
 		return resource.OperationErrorCodeThrottling
 	case ErrorCodeInternalError:
 		return resource.OperationErrorCodeServiceInternalError
+	case ErrorCodeQuotaExceeded:
+		return resource.OperationErrorCodeServiceLimitExceeded
+	case ErrorCodeRegionNotEnabled:
+		return resource.OperationErrorCodeInvalidRequest
+	case ErrorCodeInvalidCredential:
+		return resource.OperationErrorCodeInvalidCredentials
+	case ErrorCodeServiceUnavailable:
+		// Not recoverable, so an open circuit breaker fails the apply
+		// instead of formae retrying into it
+		return resource.OperationErrorCodeGeneralServiceException
 	default:
 		return resource.OperationErrorCodeServiceInternalError
 	}
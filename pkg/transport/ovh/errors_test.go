@@ -2,8 +2,10 @@
 package ovh
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/platform-engineering-labs/formae/pkg/plugin/resource"
 )
 
@@ -39,6 +41,7 @@ func TestToResourceErrorCode(t *testing.T) {
 		{ErrorCodeUnauthorized, resource.OperationErrorCodeAccessDenied},
 		{ErrorCodeResourceNotFound, resource.OperationErrorCodeNotFound},
 		{ErrorCodeAlreadyExists, resource.OperationErrorCodeAlreadyExists},
+		{ErrorCodeServiceUnavailable, resource.OperationErrorCodeGeneralServiceException},
 	}
 
 	for _, tt := range tests {
@@ -48,3 +51,68 @@ func TestToResourceErrorCode(t *testing.T) {
 		}
 	}
 }
+
+func TestClassifyOVHError(t *testing.T) {
+	tests := []struct {
+		class      string
+		statusCode int
+		want       ErrorCode
+		wantHint   bool
+	}{
+		{"Client::Forbidden::RegionNotEnabled", 403, ErrorCodeRegionNotEnabled, true},
+		{"Client::BadRequest::QuotaExceeded", 400, ErrorCodeQuotaExceeded, true},
+		{"Client::Forbidden::InvalidCredential", 403, ErrorCodeInvalidCredential, true},
+		{"Client::Forbidden::InvalidKey", 403, ErrorCodeInvalidCredential, true},
+		{"Client::NotFound", 404, ErrorCodeResourceNotFound, false},
+		{"QuotaExceeded", 400, ErrorCodeQuotaExceeded, true},
+		{"", 409, ErrorCodeAlreadyExists, false},
+	}
+
+	for _, tt := range tests {
+		got, hint := ClassifyOVHError(tt.class, tt.statusCode)
+		if got != tt.want {
+			t.Errorf("ClassifyOVHError(%q, %d) = %v, want %v", tt.class, tt.statusCode, got, tt.want)
+		}
+		if (hint != "") != tt.wantHint {
+			t.Errorf("ClassifyOVHError(%q, %d) hint = %q, wantHint %v", tt.class, tt.statusCode, hint, tt.wantHint)
+		}
+	}
+}
+
+func TestClassSegment(t *testing.T) {
+	tests := map[string]string{
+		"Client::NotFound":                    "NotFound",
+		"Client::Forbidden::RegionNotEnabled": "RegionNotEnabled",
+		"QuotaExceeded":                       "QuotaExceeded",
+		"":                                    "",
+	}
+
+	for class, want := range tests {
+		if got := classSegment(class); got != want {
+			t.Errorf("classSegment(%q) = %q, want %q", class, got, want)
+		}
+	}
+}
+
+func TestClassifyError_UsesOVHClass(t *testing.T) {
+	c := &Client{}
+	err := c.classifyError(&ovh.APIError{
+		Class:   "Client::Forbidden::RegionNotEnabled",
+		Message: "Region BHS5 is not enabled",
+		Code:    403,
+	})
+
+	transportErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("classifyError returned %T, want *Error", err)
+	}
+	if transportErr.Code != ErrorCodeRegionNotEnabled {
+		t.Errorf("Code = %v, want %v", transportErr.Code, ErrorCodeRegionNotEnabled)
+	}
+	if !strings.HasPrefix(transportErr.Message, "Region BHS5 is not enabled (") {
+		t.Errorf("Message = %q, want original message followed by a hint", transportErr.Message)
+	}
+	if ToResourceErrorCode(transportErr.Code) != resource.OperationErrorCodeInvalidRequest {
+		t.Errorf("ToResourceErrorCode(%v) = %v", transportErr.Code, ToResourceErrorCode(transportErr.Code))
+	}
+}
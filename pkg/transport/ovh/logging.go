@@ -0,0 +1,153 @@
+// pkg/transport/ovh/logging.go
+package ovh
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// LogLevel controls how much of each API call the client logs.
+type LogLevel string
+
+const (
+	// LogLevelOff disables request logging (the default)
+	LogLevelOff LogLevel = "off"
+	// LogLevelDebug logs method, path, status and duration of every call
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelTrace additionally logs request and response bodies, with
+	// sensitive fields redacted
+	LogLevelTrace LogLevel = "trace"
+)
+
+// redactedValue replaces the value of sensitive fields in logged bodies
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys are matched case-insensitively against body field names.
+// A field is redacted when its name contains any of them.
+var sensitiveKeys = []string{"password", "secret", "token", "privatekey"}
+
+// ParseLogLevel converts a config string to a LogLevel.
+// An empty string means LogLevelOff.
+func ParseLogLevel(s string) (LogLevel, error) {
+	switch LogLevel(strings.ToLower(strings.TrimSpace(s))) {
+	case "", LogLevelOff:
+		return LogLevelOff, nil
+	case LogLevelDebug:
+		return LogLevelDebug, nil
+	case LogLevelTrace:
+		return LogLevelTrace, nil
+	default:
+		return LogLevelOff, fmt.Errorf("unknown log level %q (expected off, debug or trace)", s)
+	}
+}
+
+// requestLogger writes one line per API call
+type requestLogger struct {
+	level  LogLevel
+	logger *log.Logger
+}
+
+// newRequestLogger returns nil when logging is off, so callers can skip
+// all logging work with a nil check.
+func newRequestLogger(level LogLevel, w io.Writer) *requestLogger {
+	if level == "" || level == LogLevelOff {
+		return nil
+	}
+	if w == nil {
+		w = os.Stderr
+	}
+	return &requestLogger{
+		level:  level,
+		logger: log.New(w, "[ovh] ", log.LstdFlags),
+	}
+}
+
+// logCall records a completed API call. status is the HTTP status code, or
+// zero when the request failed before a response was received. traceID, when
+// set, prefixes the line so calls for one resource operation can be grepped.
+func (l *requestLogger) logCall(traceID string, opts RequestOptions, status int, duration time.Duration, response json.RawMessage, err error) {
+	line := fmt.Sprintf("%s %s status=%d duration=%s", opts.Method, opts.Path, status, duration.Round(time.Millisecond))
+	if traceID != "" {
+		line = fmt.Sprintf("trace=%s %s", traceID, line)
+	}
+	if err != nil {
+		line += fmt.Sprintf(" error=%q", err.Error())
+	}
+
+	if l.level == LogLevelTrace {
+		if opts.Body != nil {
+			line += " request=" + redactJSON(opts.Body)
+		}
+		if len(response) > 0 {
+			line += " response=" + redactJSON(response)
+		}
+	}
+
+	l.logger.Print(line)
+}
+
+// redactJSON renders v as JSON with sensitive fields replaced.
+// Values that cannot be decoded as JSON are not logged at all.
+func redactJSON(v interface{}) string {
+	var raw []byte
+	switch body := v.(type) {
+	case json.RawMessage:
+		raw = body
+	default:
+		var err error
+		raw, err = json.Marshal(body)
+		if err != nil {
+			return "<unencodable body>"
+		}
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return "<non-JSON body>"
+	}
+
+	out, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return "<unencodable body>"
+	}
+	return string(out)
+}
+
+// redactValue walks a decoded JSON value, replacing sensitive fields
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if isSensitiveKey(k) {
+				redacted[k] = redactedValue
+				continue
+			}
+			redacted[k] = redactValue(item)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(val))
+		for i, item := range val {
+			redacted[i] = redactValue(item)
+		}
+		return redacted
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+	return false
+}
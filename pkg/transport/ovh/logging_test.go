@@ -0,0 +1,119 @@
+// pkg/transport/ovh/logging_test.go
+package ovh
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    LogLevel
+		wantErr bool
+	}{
+		{"", LogLevelOff, false},
+		{"off", LogLevelOff, false},
+		{"DEBUG", LogLevelDebug, false},
+		{" trace ", LogLevelTrace, false},
+		{"verbose", LogLevelOff, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLogLevel(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLogLevel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseLogLevel(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewRequestLogger_Off(t *testing.T) {
+	if l := newRequestLogger(LogLevelOff, &bytes.Buffer{}); l != nil {
+		t.Errorf("newRequestLogger(off) = %v, want nil", l)
+	}
+	if l := newRequestLogger("", &bytes.Buffer{}); l != nil {
+		t.Errorf("newRequestLogger(\"\") = %v, want nil", l)
+	}
+}
+
+func TestRequestLogger_DebugOmitsBodies(t *testing.T) {
+	var buf bytes.Buffer
+	l := newRequestLogger(LogLevelDebug, &buf)
+
+	l.logCall("", RequestOptions{
+		Method: "POST",
+		Path:   "/cloud/project/p1/user",
+		Body:   map[string]interface{}{"description": "ci"},
+	}, 200, 42*time.Millisecond, json.RawMessage(`{"password":"hunter2"}`), nil)
+
+	out := buf.String()
+	for _, want := range []string{"POST /cloud/project/p1/user", "status=200", "duration=42ms"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output %q missing %q", out, want)
+		}
+	}
+	if strings.Contains(out, "request=") || strings.Contains(out, "response=") {
+		t.Errorf("debug log output should not include bodies: %q", out)
+	}
+}
+
+func TestRequestLogger_TraceRedactsBodies(t *testing.T) {
+	var buf bytes.Buffer
+	l := newRequestLogger(LogLevelTrace, &buf)
+
+	l.logCall("", RequestOptions{
+		Method: "POST",
+		Path:   "/cloud/project/p1/sshkey",
+		Body: map[string]interface{}{
+			"name":       "deploy",
+			"privateKey": "-----BEGIN KEY-----",
+		},
+	}, 200, time.Millisecond, json.RawMessage(`{
+		"username": "user-abc",
+		"password": "hunter2",
+		"credentials": [{"access": "AK", "secret": "s3cret"}],
+		"auth": {"X-Auth-Token": "tok"}
+	}`), nil)
+
+	out := buf.String()
+	for _, leaked := range []string{"hunter2", "s3cret", "-----BEGIN KEY-----", `"tok"`} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("log output leaked %q: %q", leaked, out)
+		}
+	}
+	for _, want := range []string{`"name":"deploy"`, `"username":"user-abc"`, `"access":"AK"`, redactedValue} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output %q missing %q", out, want)
+		}
+	}
+}
+
+func TestRequestLogger_LogsErrors(t *testing.T) {
+	var buf bytes.Buffer
+	l := newRequestLogger(LogLevelDebug, &buf)
+
+	l.logCall("", RequestOptions{Method: "GET", Path: "/me"}, 0, time.Millisecond, nil, errors.New("connection refused"))
+
+	out := buf.String()
+	if !strings.Contains(out, "status=0") || !strings.Contains(out, `error="connection refused"`) {
+		t.Errorf("log output %q missing status or error", out)
+	}
+}
+
+func TestRequestLogger_TraceID(t *testing.T) {
+	var buf bytes.Buffer
+	l := newRequestLogger(LogLevelDebug, &buf)
+
+	l.logCall("abc123", RequestOptions{Method: "GET", Path: "/me"}, 200, time.Millisecond, nil, nil)
+
+	if out := buf.String(); !strings.Contains(out, "trace=abc123 GET /me") {
+		t.Errorf("log output %q missing trace ID", out)
+	}
+}
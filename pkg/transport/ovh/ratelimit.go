@@ -0,0 +1,89 @@
+// pkg/transport/ovh/ratelimit.go
+package ovh
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the client-side request ceiling used when none is configured.
+// It stays below the default per-application quota enforced by the OVH API.
+const DefaultRequestsPerSecond = 10.0
+
+// rateLimiter is a token bucket that smooths bursts of API calls.
+// Tokens refill continuously at rate per second up to burst.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newRateLimiter creates a token bucket allowing rps requests per second.
+// The burst size is the rate rounded up, with a minimum of one request.
+func newRateLimiter(rps float64) *rateLimiter {
+	burst := math.Max(1, math.Ceil(rps))
+	return &rateLimiter{
+		rate:   rps,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a token is available or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := l.now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Reserve the token up front so concurrent callers queue behind each other
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give the reserved token back
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = make(map[string]*rateLimiter)
+)
+
+// sharedRateLimiter returns the limiter for an endpoint and application key.
+// Clients are created per request, so the bucket must outlive a single Client
+// for the limit to apply across a whole stack apply.
+func sharedRateLimiter(endpoint, applicationKey string, rps float64) *rateLimiter {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+
+	key := endpoint + "/" + applicationKey
+	if l, ok := limiters[key]; ok && l.rate == rps {
+		return l
+	}
+	l := newRateLimiter(rps)
+	limiters[key] = l
+	return l
+}
@@ -0,0 +1,69 @@
+// pkg/transport/ovh/ratelimit_test.go
+package ovh
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_SpacesCallsAfterBurst(t *testing.T) {
+	const rps = 20.0
+	limiter := newRateLimiter(rps)
+	ctx := context.Background()
+
+	// The initial burst is allowed through immediately
+	for i := 0; i < int(limiter.burst); i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+
+	interval := time.Duration(float64(time.Second) / rps)
+	const calls = 5
+	start := time.Now()
+	for i := 0; i < calls; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	// Allow some slack for timer granularity
+	minExpected := time.Duration(calls)*interval - interval/2
+	if elapsed < minExpected {
+		t.Errorf("%d calls took %v, want at least %v", calls, elapsed, minExpected)
+	}
+}
+
+func TestRateLimiter_ContextCancelled(t *testing.T) {
+	limiter := newRateLimiter(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	cancel()
+	if err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	a := sharedRateLimiter("ovh-eu", "test-key", 5)
+	b := sharedRateLimiter("ovh-eu", "test-key", 5)
+	if a != b {
+		t.Error("sharedRateLimiter() returned different limiters for the same application")
+	}
+
+	c := sharedRateLimiter("ovh-eu", "other-key", 5)
+	if a == c {
+		t.Error("sharedRateLimiter() shared a limiter across applications")
+	}
+
+	d := sharedRateLimiter("ovh-eu", "test-key", 50)
+	if d.rate != 50 {
+		t.Errorf("rate = %v, want 50", d.rate)
+	}
+}
@@ -0,0 +1,66 @@
+// pkg/transport/ovh/readcache.go
+package ovh
+
+import (
+	"encoding/json"
+	"regexp"
+	"sync"
+)
+
+// cacheablePaths match the read-only lookups an operation repeats: the
+// flavor and image catalogs, region details (availability zones and
+// product availability) and private networks. Nothing the plugin manages
+// is cached, so reads of managed resources always reach the API.
+var cacheablePaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/cloud/project/[^/]+/(flavor|image)(/[^/?]+)?(\?.*)?$`),
+	regexp.MustCompile(`^/cloud/project/[^/]+/region/[^/?]+$`),
+	regexp.MustCompile(`^/cloud/project/[^/]+/region/[^/]+/network(/[^/?]+)?$`),
+	regexp.MustCompile(`^/cloud/project/[^/]+/network/private(/[^/?]+)?$`),
+	regexp.MustCompile(`^/cloud/project/[^/]+/capabilities/productAvailability(\?.*)?$`),
+}
+
+// isCacheable reports whether a GET of path may be answered from the read cache
+func isCacheable(path string) bool {
+	for _, re := range cacheablePaths {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// readCache holds the raw bodies of cacheable GETs for the lifetime of a
+// client. The plugin builds a client per operation, so entries never outlive
+// the operation that fetched them. Paths carry the project and region, so
+// lookups in different regions never share an entry.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]json.RawMessage
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: make(map[string]json.RawMessage)}
+}
+
+// get returns the body cached for path
+func (c *readCache) get(path string) (json.RawMessage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	raw, ok := c.entries[path]
+	return raw, ok
+}
+
+// put caches the body of a successful GET of path
+func (c *readCache) put(path string, raw json.RawMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[path] = raw
+}
+
+// invalidate drops every entry. Any write may change a lookup, e.g. creating
+// a private network, so writes clear the cache rather than risk a stale read.
+func (c *readCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]json.RawMessage)
+}
@@ -0,0 +1,145 @@
+// pkg/transport/ovh/readcache_test.go
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newCountingServer answers every call with an object and counts calls per path
+func newCountingServer(t *testing.T) (*httptest.Server, func(path string) int) {
+	t.Helper()
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		mu.Lock()
+		calls[r.URL.Path]++
+		mu.Unlock()
+		w.Write([]byte(`{"id":"x","availabilityZones":["eu-west-par-a"]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, func(path string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[path]
+	}
+}
+
+func newCachingClient(t *testing.T, endpoint string, readCache bool) *Client {
+	t.Helper()
+	client, err := NewClient(&OVHConfig{
+		Endpoint:          endpoint,
+		ApplicationKey:    "key",
+		ApplicationSecret: "secret",
+		ConsumerKey:       "consumer",
+		RequestsPerSecond: 1000,
+		ReadCache:         readCache,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestIsCacheable(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/cloud/project/p/flavor?region=GRA11", true},
+		{"/cloud/project/p/flavor/f-1", true},
+		{"/cloud/project/p/image/i-1", true},
+		{"/cloud/project/p/region/GRA11", true},
+		{"/cloud/project/p/region/GRA11/network/n-1", true},
+		{"/cloud/project/p/network/private", true},
+		{"/cloud/project/p/capabilities/productAvailability?addonFamily=volume", true},
+		{"/cloud/project/p/instance/i-1", false},
+		{"/cloud/project/p/region/GRA11/quota", false},
+		{"/cloud/project/p/network/private/n-1/subnet", false},
+	}
+	for _, tt := range tests {
+		if got := isCacheable(tt.path); got != tt.want {
+			t.Errorf("isCacheable(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestDo_ReadCacheServesRepeatedLookups(t *testing.T) {
+	server, calls := newCountingServer(t)
+	client := newCachingClient(t, server.URL, true)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Do(ctx, RequestOptions{Method: "GET", Path: "/cloud/project/p/region/GRA11"})
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		// Callers may modify a response, so each gets its own copy
+		resp.Body["id"] = "modified"
+	}
+	if got := calls("/cloud/project/p/region/GRA11"); got != 1 {
+		t.Errorf("region fetched %d times, want 1", got)
+	}
+
+	resp, err := client.Do(ctx, RequestOptions{Method: "GET", Path: "/cloud/project/p/region/GRA11"})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if resp.Body["id"] != "x" {
+		t.Errorf("cached Body[id] = %v, want x", resp.Body["id"])
+	}
+
+	// Managed resources are never cached
+	for i := 0; i < 2; i++ {
+		if _, err := client.Do(ctx, RequestOptions{Method: "GET", Path: "/cloud/project/p/instance/i-1"}); err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+	}
+	if got := calls("/cloud/project/p/instance/i-1"); got != 2 {
+		t.Errorf("instance fetched %d times, want 2", got)
+	}
+}
+
+func TestDo_ReadCacheInvalidatedByWrites(t *testing.T) {
+	server, calls := newCountingServer(t)
+	client := newCachingClient(t, server.URL, true)
+	ctx := context.Background()
+
+	path := "/cloud/project/p/network/private"
+	if _, err := client.Do(ctx, RequestOptions{Method: "GET", Path: path}); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if _, err := client.Do(ctx, RequestOptions{Method: "POST", Path: path, Body: map[string]interface{}{"name": "n"}}); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if _, err := client.Do(ctx, RequestOptions{Method: "GET", Path: path}); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	// Two GETs and the POST all reached the API
+	if got := calls(path); got != 3 {
+		t.Errorf("network list called %d times, want 3", got)
+	}
+}
+
+func TestDo_ReadCacheDisabled(t *testing.T) {
+	server, calls := newCountingServer(t)
+	client := newCachingClient(t, server.URL, false)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Do(context.Background(), RequestOptions{Method: "GET", Path: "/cloud/project/p/flavor/f-1"}); err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+	}
+	if got := calls("/cloud/project/p/flavor/f-1"); got != 2 {
+		t.Errorf("flavor fetched %d times, want 2", got)
+	}
+}
@@ -0,0 +1,66 @@
+// pkg/transport/ovh/region.go
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// regionToActivate returns the project and region of a create that failed
+// because the region is not activated on the project. Only POSTs under
+// /cloud/project/{serviceName} with a region in the body qualify.
+func regionToActivate(opts RequestOptions, err error) (project, region string, ok bool) {
+	var transportErr *Error
+	if !errors.As(err, &transportErr) || transportErr.Code != ErrorCodeRegionNotEnabled {
+		return "", "", false
+	}
+	if opts.Method != "POST" || !strings.HasPrefix(opts.Path, "/cloud/project/") {
+		return "", "", false
+	}
+
+	rest := strings.TrimPrefix(opts.Path, "/cloud/project/")
+	project, subPath, _ := strings.Cut(rest, "/")
+	// Never retry the activation call itself
+	if project == "" || subPath == "region" {
+		return "", "", false
+	}
+
+	body, _ := opts.Body.(map[string]interface{})
+	region, _ = body["region"].(string)
+	return project, region, region != ""
+}
+
+// activateRegionAndRetry activates region on project and retries opts once.
+// Activation can take a few minutes, so a retry that still fails reports that
+// the activation is under way rather than the original error.
+// Path: POST /cloud/project/{serviceName}/region
+func (c *Client) activateRegionAndRetry(ctx context.Context, opts RequestOptions, project, region string) (*Response, error) {
+	_, err := c.do(ctx, RequestOptions{
+		Method: "POST",
+		Path:   fmt.Sprintf("/cloud/project/%s/region", project),
+		Body:   map[string]interface{}{"region": region},
+	})
+	if err != nil {
+		return nil, regionError(err, fmt.Sprintf("region %s is not activated for this project and activating it failed", region))
+	}
+
+	resp, err := c.do(ctx, opts)
+	if _, _, pending := regionToActivate(opts, err); pending {
+		return nil, regionError(err, fmt.Sprintf("activation of region %s was requested and is still in progress; retry in a few minutes", region))
+	}
+	return resp, err
+}
+
+// regionError wraps err with a region activation message
+func regionError(err error, message string) *Error {
+	result := &Error{Code: ErrorCodeRegionNotEnabled, Message: message, Underlying: err}
+	var transportErr *Error
+	if errors.As(err, &transportErr) {
+		result.Message = fmt.Sprintf("%s: %s", message, transportErr.Message)
+		result.HTTPCode = transportErr.HTTPCode
+		result.Class = transportErr.Class
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+// pkg/transport/ovh/region_test.go
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newRegionServer fails instance creates with RegionNotEnabled until the
+// region is activated, which takes effect after activationCalls creates
+func newRegionServer(t *testing.T, activationFails bool, activationCalls int) (*httptest.Server, *[]string) {
+	t.Helper()
+	var calls []string
+	activated := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/cloud/project/p1/region":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if activationFails || body["region"] != "BHS5" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"class":"Client::BadRequest","message":"Region unavailable"}`))
+				return
+			}
+			activated = true
+			w.Write([]byte(`{"name":"BHS5","status":"enabling"}`))
+		case "/cloud/project/p1/instance":
+			if !activated || activationCalls > 0 {
+				activationCalls--
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"class":"Client::Forbidden::RegionNotEnabled","message":"Region BHS5 is not enabled"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"inst-1"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func newRegionTestClient(t *testing.T, endpoint string, autoActivate bool) *Client {
+	t.Helper()
+	client, err := NewClient(&OVHConfig{
+		Endpoint:            endpoint,
+		ApplicationKey:      "key",
+		ApplicationSecret:   "secret",
+		ConsumerKey:         "consumer",
+		RequestsPerSecond:   1000,
+		AutoActivateRegions: autoActivate,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+var createInstance = RequestOptions{
+	Method: "POST",
+	Path:   "/cloud/project/p1/instance",
+	Body:   map[string]interface{}{"name": "web-1", "region": "BHS5"},
+}
+
+func TestDo_AutoActivatesRegion(t *testing.T) {
+	server, calls := newRegionServer(t, false, 1)
+	client := newRegionTestClient(t, server.URL, true)
+
+	resp, err := client.Do(context.Background(), createInstance)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if resp.Body["id"] != "inst-1" {
+		t.Errorf("Body = %v, want the created instance", resp.Body)
+	}
+	want := []string{"POST /cloud/project/p1/instance", "POST /cloud/project/p1/region", "POST /cloud/project/p1/instance"}
+	if strings.Join(*calls, ",") != strings.Join(want, ",") {
+		t.Errorf("calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestDo_AutoActivateStillPending(t *testing.T) {
+	server, _ := newRegionServer(t, false, 2)
+	client := newRegionTestClient(t, server.URL, true)
+
+	_, err := client.Do(context.Background(), createInstance)
+	var transportErr *Error
+	if !errors.As(err, &transportErr) || transportErr.Code != ErrorCodeRegionNotEnabled {
+		t.Fatalf("Do() error = %v, want a RegionNotEnabled error", err)
+	}
+	if !strings.Contains(transportErr.Message, "activation of region BHS5 was requested") {
+		t.Errorf("Message = %q, want it to report the pending activation", transportErr.Message)
+	}
+}
+
+func TestDo_AutoActivateFails(t *testing.T) {
+	server, _ := newRegionServer(t, true, 0)
+	client := newRegionTestClient(t, server.URL, true)
+
+	_, err := client.Do(context.Background(), createInstance)
+	var transportErr *Error
+	if !errors.As(err, &transportErr) || !strings.Contains(transportErr.Message, "activating it failed: Region unavailable") {
+		t.Fatalf("Do() error = %v, want the activation failure", err)
+	}
+}
+
+func TestDo_RegionNotActivatedWithoutAutoActivate(t *testing.T) {
+	server, calls := newRegionServer(t, false, 0)
+	client := newRegionTestClient(t, server.URL, false)
+
+	_, err := client.Do(context.Background(), createInstance)
+	var transportErr *Error
+	if !errors.As(err, &transportErr) || transportErr.Code != ErrorCodeRegionNotEnabled {
+		t.Fatalf("Do() error = %v, want a RegionNotEnabled error", err)
+	}
+	if len(*calls) != 1 {
+		t.Errorf("calls = %v, want only the failed create", *calls)
+	}
+}
+
+func TestRegionToActivate(t *testing.T) {
+	regionErr := &Error{Code: ErrorCodeRegionNotEnabled}
+	tests := []struct {
+		name string
+		opts RequestOptions
+		err  error
+		want bool
+	}{
+		{"create", createInstance, regionErr, true},
+		{"other error", createInstance, &Error{Code: ErrorCodeQuotaExceeded}, false},
+		{"read", RequestOptions{Method: "GET", Path: "/cloud/project/p1/instance"}, regionErr, false},
+		{"activation call", RequestOptions{Method: "POST", Path: "/cloud/project/p1/region", Body: map[string]interface{}{"region": "BHS5"}}, regionErr, false},
+		{"no region", RequestOptions{Method: "POST", Path: "/cloud/project/p1/sshkey", Body: map[string]interface{}{"name": "k"}}, regionErr, false},
+		{"not a project path", RequestOptions{Method: "POST", Path: "/domain/zone", Body: map[string]interface{}{"region": "BHS5"}}, regionErr, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, ok := regionToActivate(tt.opts, tt.err)
+			if ok != tt.want {
+				t.Errorf("regionToActivate() ok = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+}
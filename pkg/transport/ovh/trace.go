@@ -0,0 +1,35 @@
+// pkg/transport/ovh/trace.go
+package ovh
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// traceIDKey is the context key holding a trace ID
+type traceIDKey struct{}
+
+// WithTraceID returns a context whose API calls are logged with traceID, so
+// every call made for one resource operation can be correlated.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	if traceID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID set by WithTraceID, or ""
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey{}).(string)
+	return traceID
+}
+
+// NewTraceID returns a random 16-character hex trace ID
+func NewTraceID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
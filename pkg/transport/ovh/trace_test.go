@@ -0,0 +1,33 @@
+// pkg/transport/ovh/trace_test.go
+package ovh
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTraceIDFromContext(t *testing.T) {
+	if got := TraceIDFromContext(context.Background()); got != "" {
+		t.Errorf("TraceIDFromContext(background) = %q, want empty", got)
+	}
+
+	ctx := WithTraceID(context.Background(), "abc123")
+	if got := TraceIDFromContext(ctx); got != "abc123" {
+		t.Errorf("TraceIDFromContext() = %q, want abc123", got)
+	}
+
+	// An empty trace ID leaves the context unchanged
+	if got := TraceIDFromContext(WithTraceID(ctx, "")); got != "abc123" {
+		t.Errorf("TraceIDFromContext() = %q, want abc123", got)
+	}
+}
+
+func TestNewTraceID(t *testing.T) {
+	a, b := NewTraceID(), NewTraceID()
+	if len(a) != 16 {
+		t.Errorf("NewTraceID() = %q, want 16 hex characters", a)
+	}
+	if a == b {
+		t.Errorf("NewTraceID() returned %q twice", a)
+	}
+}
@@ -0,0 +1,69 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+// Package warnings reports problems that do not fail an operation, such as
+// tags that could not be set on a resource that was created. Warnings are
+// logged to stderr, keeping the plugin's stdout clean, and are collected
+// for the operation's result when the caller asked for them.
+package warnings
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+var logger = log.New(os.Stderr, "[ovh] ", log.LstdFlags)
+
+// SetOutput redirects the warning log, mainly for tests
+func SetOutput(w io.Writer) {
+	logger.SetOutput(w)
+}
+
+type contextKey struct{}
+
+// collector holds the warnings of one operation
+type collector struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// Collect returns a context whose warnings are kept, and a function
+// returning those reported so far
+func Collect(ctx context.Context) (context.Context, func() []string) {
+	c := &collector{}
+	return context.WithValue(ctx, contextKey{}, c), func() []string {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return append([]string(nil), c.messages...)
+	}
+}
+
+// Warnf logs a warning and records it on ctx if the operation collects them
+func Warnf(ctx context.Context, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	logger.Print("warning: " + message)
+
+	if c, ok := ctx.Value(contextKey{}).(*collector); ok {
+		c.mu.Lock()
+		c.messages = append(c.messages, message)
+		c.mu.Unlock()
+	}
+}
+
+// Join appends warnings to a status message, one "warning: ..." each
+func Join(statusMessage string, messages []string) string {
+	parts := make([]string, 0, len(messages)+1)
+	if statusMessage != "" {
+		parts = append(parts, statusMessage)
+	}
+	for _, message := range messages {
+		parts = append(parts, "warning: "+message)
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,39 @@
+// © 2025 Platform Engineering Labs Inc.
+//
+// SPDX-License-Identifier: FSL-1.1-ALv2
+
+package warnings
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarnf(t *testing.T) {
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	t.Cleanup(func() { SetOutput(os.Stderr) })
+
+	// Without a collector the warning is only logged
+	Warnf(context.Background(), "failed to set tags on network %s", "net-1")
+	assert.Contains(t, buf.String(), "warning: failed to set tags on network net-1")
+
+	ctx, collected := Collect(context.Background())
+	Warnf(ctx, "failed to set tags on port %s", "port-1")
+	Warnf(ctx, "failed to fetch tags for port %s", "port-1")
+	assert.Equal(t, []string{
+		"failed to set tags on port port-1",
+		"failed to fetch tags for port port-1",
+	}, collected())
+}
+
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "", Join("", nil))
+	assert.Equal(t, "created", Join("created", nil))
+	assert.Equal(t, "warning: a; warning: b", Join("", []string{"a", "b"}))
+	assert.Equal(t, "created; warning: a", Join("created", []string{"a"}))
+}